@@ -10,6 +10,7 @@ import (
 // NOTE:
 //
 //	Compatible with standard package strconv.
+//	The digits of base > 62 are in StdDigitTable, and underscores are not accepted.
 func ParseUint(s string, base int, bitSize int) (uint64, error) {
 	// Ignore letter case
 	if base <= 36 {
@@ -19,7 +20,7 @@ func ParseUint(s string, base int, bitSize int) (uint64, error) {
 	const fnParseUint = "ParseUint"
 
 	if base > 62 {
-		return 0, baseError(fnParseUint, s, base)
+		return StdDigitTable.parseUint(fnParseUint, s, base, bitSize)
 	}
 
 	if s == "" || !underscoreOK(s) {
@@ -75,14 +76,14 @@ func ParseUint(s string, base int, bitSize int) (uint64, error) {
 	return n, nil
 }
 
-// ParseInt interprets a string s in the given base (0, 2 to 62) and
+// ParseInt interprets a string s in the given base (0, 2 to 95) and
 // bit size (0 to 64) and returns the corresponding value i.
 //
 // If base == 0, the base is implied by the string's prefix:
 // base 2 for "0b", base 8 for "0" or "0o", base 16 for "0x",
 // and base 10 otherwise. Also, for base == 0 only, underscore
 // characters are permitted per the Go integer literal syntax.
-// If base is below 0, is 1, or is above 62, an error is returned.
+// If base is below 0, is 1, or is above 95, an error is returned.
 //
 // The bitSize argument specifies the integer type
 // that the result must fit into. Bit sizes 0, 8, 16, 32, and 64
@@ -99,11 +100,16 @@ func ParseUint(s string, base int, bitSize int) (uint64, error) {
 // NOTE:
 //
 //	Compatible with standard package strconv.
+//	The digits of base > 62 are in StdDigitTable, where a leading '-' (base >= 63) or '+' (base >= 94) is a digit, not a sign;
+//	use a DigitTable without them for signed values of those bases.
 func ParseInt(s string, base int, bitSize int) (i int64, err error) {
 	// Ignore letter case
 	if base <= 36 {
 		return strconv.ParseInt(s, base, bitSize)
 	}
+	if base > 62 {
+		return StdDigitTable.ParseInt(s, base, bitSize)
+	}
 
 	const fnParseInt = "ParseInt"
 
@@ -522,7 +522,7 @@ var parseBaseTests = []parseErrorTest{
 	{2, noErrStub},
 	{36, noErrStub},
 	{62, noErrStub},
-	{63, baseErrStub},
+	{96, baseErrStub},
 }
 
 func equalError(a, b error) bool {
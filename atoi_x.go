@@ -1,27 +1,81 @@
 package ameda
 
 import (
-	"bytes"
 	"errors"
 	"fmt"
 	"math"
+	"math/bits"
+	"unicode/utf8"
 )
 
 // ParseUintByDict convert numStr into corresponding uint64 according to dict.
+// NOTE:
+//
+//	If a character appears more than once in the dict, the first one is used;
+//	see Codec for a validated dict that can be reused.
 func ParseUintByDict(dict []byte, numStr string) (uint64, error) {
 	if len(dict) == 0 {
-		return 0, errors.New("dict is empty")
+		return 0, errEmptyDict
 	}
-	base := float64(len(dict))
-	len := len(numStr)
-	var number float64
-	for i := 0; i < len; i++ {
-		char := numStr[i : i+1]
-		pos := bytes.IndexAny(dict, char)
+	var index [256]int16
+	for k := range index {
+		index[k] = -1
+	}
+	for k := len(dict) - 1; k >= 0; k-- {
+		index[dict[k]] = int16(k)
+	}
+	base := uint64(len(dict))
+	var number uint64
+	for i := 0; i < len(numStr); i++ {
+		pos := index[numStr[i]]
 		if pos == -1 {
-			return 0, fmt.Errorf("found a char not included in the dict: %q", char)
+			return 0, fmt.Errorf("found a char not included in the dict: %q", numStr[i:i+1])
+		}
+		hi, lo := bits.Mul64(number, base)
+		number = lo + uint64(pos)
+		if hi != 0 || number < lo {
+			return math.MaxUint64, fmt.Errorf("value out of range: %q", numStr)
+		}
+	}
+	return number, nil
+}
+
+// ParseUintByRunes convert numStr into corresponding uint64 according to the rune dict.
+// NOTE:
+//
+//	If a rune appears more than once in the dict, the first one is used;
+//	if numStr is not valid UTF-8, the error wraps ErrInvalidUTF8;
+//	see RuneCodec for a validated dict that can be reused.
+func ParseUintByRunes(dict []rune, numStr string) (uint64, error) {
+	if len(dict) == 0 {
+		return 0, errEmptyDict
+	}
+	index := make(map[rune]int, len(dict))
+	for k := len(dict) - 1; k >= 0; k-- {
+		index[dict[k]] = k
+	}
+	base := uint64(len(dict))
+	var number uint64
+	for i := 0; i < len(numStr); {
+		r, size := utf8.DecodeRuneInString(numStr[i:])
+		if r == utf8.RuneError && size <= 1 {
+			return 0, fmt.Errorf("%w at byte %d of %q", ErrInvalidUTF8, i, numStr)
+		}
+		pos, ok := index[r]
+		if !ok {
+			return 0, fmt.Errorf("found a char not included in the dict: %q", numStr[i:i+size])
 		}
-		number = math.Pow(base, float64(len-i-1))*float64(pos) + number
+		hi, lo := bits.Mul64(number, base)
+		number = lo + uint64(pos)
+		if hi != 0 || number < lo {
+			return math.MaxUint64, fmt.Errorf("value out of range: %q", numStr)
+		}
+		i += size
 	}
-	return uint64(number), nil
+	return number, nil
 }
+
+var errEmptyDict = errors.New("dict is empty")
+
+// ErrInvalidUTF8 indicates that a string or a rune dict is not valid UTF-8.
+var ErrInvalidUTF8 = errors.New("invalid UTF-8")
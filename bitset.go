@@ -0,0 +1,311 @@
+package ameda
+
+import (
+	"math/bits"
+	"strings"
+)
+
+// Bitset is a growable sequence of bits packed into 64-bit words.
+// NOTE:
+//
+//	The zero value is an empty bitset ready to use.
+//	Setting or flipping a bit beyond the length grows the bitset;
+//	testing or clearing a bit beyond the length is a no-op.
+//	It is not safe for concurrent use.
+type Bitset struct {
+	words  []uint64
+	length int
+}
+
+// NewBitset creates a bitset with length cleared bits.
+func NewBitset(length int) *Bitset {
+	if length < 0 {
+		length = 0
+	}
+	return &Bitset{
+		words:  make([]uint64, wordsNeeded(length)),
+		length: length,
+	}
+}
+
+// Len returns the number of bits in the bitset.
+func (b *Bitset) Len() int {
+	return b.length
+}
+
+// Set sets the bit i to 1, and returns the bitset itself.
+func (b *Bitset) Set(i int) *Bitset {
+	if i < 0 {
+		return b
+	}
+	b.grow(i + 1)
+	b.words[i>>6] |= 1 << uint(i&63)
+	return b
+}
+
+// Clear sets the bit i to 0, and returns the bitset itself.
+func (b *Bitset) Clear(i int) *Bitset {
+	if i >= 0 && i < b.length {
+		b.words[i>>6] &^= 1 << uint(i&63)
+	}
+	return b
+}
+
+// Flip toggles the bit i, and returns the bitset itself.
+func (b *Bitset) Flip(i int) *Bitset {
+	if i < 0 {
+		return b
+	}
+	b.grow(i + 1)
+	b.words[i>>6] ^= 1 << uint(i&63)
+	return b
+}
+
+// Test reports whether the bit i is 1.
+func (b *Bitset) Test(i int) bool {
+	if i < 0 || i >= b.length {
+		return false
+	}
+	return b.words[i>>6]&(1<<uint(i&63)) != 0
+}
+
+// SetRange sets the bits from start to end (end not included) to 1.
+func (b *Bitset) SetRange(start, end int) *Bitset {
+	if start < 0 {
+		start = 0
+	}
+	if end <= start {
+		return b
+	}
+	b.grow(end)
+	b.rangeDo(start, end, func(w *uint64, mask uint64) { *w |= mask })
+	return b
+}
+
+// ClearRange sets the bits from start to end (end not included) to 0.
+func (b *Bitset) ClearRange(start, end int) *Bitset {
+	if start < 0 {
+		start = 0
+	}
+	if end > b.length {
+		end = b.length
+	}
+	if end <= start {
+		return b
+	}
+	b.rangeDo(start, end, func(w *uint64, mask uint64) { *w &^= mask })
+	return b
+}
+
+// FlipRange toggles the bits from start to end (end not included).
+func (b *Bitset) FlipRange(start, end int) *Bitset {
+	if start < 0 {
+		start = 0
+	}
+	if end <= start {
+		return b
+	}
+	b.grow(end)
+	b.rangeDo(start, end, func(w *uint64, mask uint64) { *w ^= mask })
+	return b
+}
+
+// Count returns the number of bits set to 1 (the population count).
+func (b *Bitset) Count() int {
+	var n int
+	for _, w := range b.words {
+		n += bits.OnesCount64(w)
+	}
+	return n
+}
+
+// Any reports whether any bit is set to 1.
+func (b *Bitset) Any() bool {
+	for _, w := range b.words {
+		if w != 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// NextSet returns the index of the first bit set to 1 at or after i.
+// NOTE:
+//
+//	If not found, ok = false
+//
+// e.g. iterate over all the set bits:
+//
+//	for i, ok := b.NextSet(0); ok; i, ok = b.NextSet(i + 1) {
+//	}
+func (b *Bitset) NextSet(i int) (next int, ok bool) {
+	if i < 0 {
+		i = 0
+	}
+	if i >= b.length {
+		return -1, false
+	}
+	k := i >> 6
+	w := b.words[k] >> uint(i&63)
+	if w != 0 {
+		return i + bits.TrailingZeros64(w), true
+	}
+	for k++; k < len(b.words); k++ {
+		if b.words[k] != 0 {
+			return k<<6 + bits.TrailingZeros64(b.words[k]), true
+		}
+	}
+	return -1, false
+}
+
+// NextClear returns the index of the first bit set to 0 at or after i.
+// NOTE:
+//
+//	If not found, ok = false
+func (b *Bitset) NextClear(i int) (next int, ok bool) {
+	if i < 0 {
+		i = 0
+	}
+	if i >= b.length {
+		return -1, false
+	}
+	k := i >> 6
+	w := ^b.words[k] >> uint(i&63)
+	if w != 0 {
+		next = i + bits.TrailingZeros64(w)
+	} else {
+		next = -1
+		for k++; k < len(b.words); k++ {
+			if b.words[k] != ^uint64(0) {
+				next = k<<6 + bits.TrailingZeros64(^b.words[k])
+				break
+			}
+		}
+	}
+	if next < 0 || next >= b.length {
+		return -1, false
+	}
+	return next, true
+}
+
+// And returns a new bitset: b & other.
+func (b *Bitset) And(other *Bitset) *Bitset {
+	return b.combine(other, func(x, y uint64) uint64 { return x & y })
+}
+
+// Or returns a new bitset: b | other.
+func (b *Bitset) Or(other *Bitset) *Bitset {
+	return b.combine(other, func(x, y uint64) uint64 { return x | y })
+}
+
+// Xor returns a new bitset: b ^ other.
+func (b *Bitset) Xor(other *Bitset) *Bitset {
+	return b.combine(other, func(x, y uint64) uint64 { return x ^ y })
+}
+
+// AndNot returns a new bitset: b &^ other.
+func (b *Bitset) AndNot(other *Bitset) *Bitset {
+	return b.combine(other, func(x, y uint64) uint64 { return x &^ y })
+}
+
+// Equal reports whether the two bitsets have the same length and bits.
+func (b *Bitset) Equal(other *Bitset) bool {
+	if b.length != other.length {
+		return false
+	}
+	for k, w := range b.words {
+		if w != other.words[k] {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone creates a copy of the bitset.
+func (b *Bitset) Clone() *Bitset {
+	r := &Bitset{
+		words:  make([]uint64, len(b.words)),
+		length: b.length,
+	}
+	copy(r.words, b.words)
+	return r
+}
+
+// Bools converts the bitset to bool slice, see also BoolsToBitset.
+func (b *Bitset) Bools() []bool {
+	r := make([]bool, b.length)
+	for i, ok := b.NextSet(0); ok; i, ok = b.NextSet(i + 1) {
+		r[i] = true
+	}
+	return r
+}
+
+// String returns the bits as a string of '0' and '1', with the bit 0 first.
+func (b *Bitset) String() string {
+	var sb strings.Builder
+	sb.Grow(b.length)
+	for i := 0; i < b.length; i++ {
+		if b.Test(i) {
+			sb.WriteByte('1')
+		} else {
+			sb.WriteByte('0')
+		}
+	}
+	return sb.String()
+}
+
+func (b *Bitset) grow(length int) {
+	if length <= b.length {
+		return
+	}
+	if n := wordsNeeded(length); n > len(b.words) {
+		if n <= cap(b.words) {
+			b.words = b.words[:n]
+		} else {
+			words := make([]uint64, n, n+n/2)
+			copy(words, b.words)
+			b.words = words
+		}
+	}
+	b.length = length
+}
+
+func (b *Bitset) rangeDo(start, end int, fn func(w *uint64, mask uint64)) {
+	for start < end {
+		k := start >> 6
+		lo := uint(start & 63)
+		hi := uint(64)
+		if next := (k + 1) << 6; next > end {
+			hi = uint(end - k<<6)
+		}
+		mask := ^uint64(0) << lo
+		if hi < 64 {
+			mask &= 1<<hi - 1
+		}
+		fn(&b.words[k], mask)
+		start = (k + 1) << 6
+	}
+}
+
+func (b *Bitset) combine(other *Bitset, fn func(x, y uint64) uint64) *Bitset {
+	length := b.length
+	if other.length > length {
+		length = other.length
+	}
+	r := NewBitset(length)
+	for k := range r.words {
+		var x, y uint64
+		if k < len(b.words) {
+			x = b.words[k]
+		}
+		if k < len(other.words) {
+			y = other.words[k]
+		}
+		r.words[k] = fn(x, y)
+	}
+	return r
+}
+
+func wordsNeeded(length int) int {
+	return (length + 63) >> 6
+}
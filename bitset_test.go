@@ -0,0 +1,56 @@
+package ameda
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBitset(t *testing.T) {
+	var b Bitset
+	b.Set(1).Set(64).Set(130)
+	assert.Equal(t, 131, b.Len())
+	assert.Equal(t, 3, b.Count())
+	assert.True(t, b.Test(64))
+	assert.False(t, b.Test(65))
+	assert.False(t, b.Test(1000))
+	b.Clear(64).Clear(1000).Flip(2).Flip(1)
+	assert.Equal(t, 131, b.Len())
+	assert.Equal(t, "001", b.String()[:3])
+	assert.Equal(t, 2, b.Count())
+
+	var set []int
+	for i, ok := b.NextSet(0); ok; i, ok = b.NextSet(i + 1) {
+		set = append(set, i)
+	}
+	assert.Equal(t, []int{2, 130}, set)
+	i, ok := b.NextClear(2)
+	assert.True(t, ok)
+	assert.Equal(t, 3, i)
+	_, ok = NewBitset(64).SetRange(0, 64).NextClear(0)
+	assert.False(t, ok)
+}
+
+func TestBitsetRange(t *testing.T) {
+	b := NewBitset(10)
+	b.SetRange(3, 70)
+	assert.Equal(t, 70, b.Len())
+	assert.Equal(t, 67, b.Count())
+	b.ClearRange(60, 100)
+	assert.Equal(t, 57, b.Count())
+	b.FlipRange(0, 5)
+	assert.Equal(t, "1110011111", b.String()[:10])
+	assert.Equal(t, 58, b.Count())
+}
+
+func TestBitsetLogic(t *testing.T) {
+	x := BoolsToBitset([]bool{true, true, false, false})
+	y := BoolsToBitset([]bool{true, false, true, false, true})
+	assert.Equal(t, []bool{true, false, false, false, false}, x.And(y).Bools())
+	assert.Equal(t, []bool{true, true, true, false, true}, x.Or(y).Bools())
+	assert.Equal(t, []bool{false, true, true, false, true}, x.Xor(y).Bools())
+	assert.Equal(t, []bool{false, true, false, false, false}, x.AndNot(y).Bools())
+	assert.True(t, x.Equal(x.Clone()))
+	assert.False(t, x.Equal(y))
+	assert.False(t, NewBitset(3).Any())
+}
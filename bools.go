@@ -1,5 +1,10 @@
 package ameda
 
+import (
+	"fmt"
+	"sort"
+)
+
 // OneBool try to return the first element, otherwise return zero value.
 func OneBool(b []bool) bool {
 	if len(b) > 0 {
@@ -132,6 +137,17 @@ func BoolsToUint64s(b []bool) []uint64 {
 	return r
 }
 
+// BoolsToBitset converts bool slice to a packed bitset, see also Bitset.Bools.
+func BoolsToBitset(b []bool) *Bitset {
+	r := NewBitset(len(b))
+	for k, v := range b {
+		if v {
+			r.words[k>>6] |= 1 << uint(k&63)
+		}
+	}
+	return r
+}
+
 // BoolsCopyWithin copies part of an slice to another location in the current slice.
 // @target
 //
@@ -417,26 +433,20 @@ func BoolsSplice(b *[]bool, start, deleteCount int, items ...bool) {
 	if deleteCount < 0 {
 		deleteCount = 0
 	}
-	start, end, _ := fixRange(len(a), start, start+1+deleteCount)
-	deleteCount = end - start - 1
-	for k := 0; k < len(items); k++ {
-		if deleteCount > 0 {
-			// replace
-			a[start] = items[k]
-			deleteCount--
-			start++
-		} else {
-			// insert
-			lastSlice := BoolsCopy(a[start:])
-			items = items[k:]
-			a = append(a[:start], items...)
-			a = append(a[:start+len(items)], lastSlice...)
-			*b = a[:len(a):len(a)]
-			return
-		}
+	start = fixIndex(len(a), start, true)
+	if rest := len(a) - start; deleteCount > rest {
+		deleteCount = rest
 	}
-	if deleteCount > 0 {
-		a = append(a[:start], a[start+1+deleteCount:]...)
+	// replace
+	n := copy(a[start:start+deleteCount], items)
+	if n == len(items) {
+		// delete the rest
+		a = append(a[:start+n], a[start+deleteCount:]...)
+	} else {
+		// insert the rest
+		lastSlice := BoolsCopy(a[start+deleteCount:])
+		a = append(a[:start+deleteCount], items[n:]...)
+		a = append(a, lastSlice...)
 	}
 	*b = a[:len(a):len(a)]
 }
@@ -600,3 +610,379 @@ func boolsDistinct(src []bool, dst *[]bool) map[bool]int {
 	}
 	return m
 }
+
+// BoolsEdit is an edit run of the edit script produced by BoolsDiff.
+type BoolsEdit struct {
+	Kind   DiffKind
+	Values []bool
+}
+
+// BoolsDiff computes the shortest edit script that turns slice a into slice b, see also DiffFunc.
+// This method does not change the existing slices, but instead returns the runs of edits.
+func BoolsDiff(a, b []bool) []BoolsEdit {
+	spans := DiffFunc(len(a), len(b), func(i, j int) bool { return a[i] == b[j] })
+	r := make([]BoolsEdit, len(spans))
+	for k, span := range spans {
+		r[k].Kind = span.Kind
+		if span.Kind == DiffInsert {
+			r[k].Values = BoolsCopy(b[span.B : span.B+span.Len])
+		} else {
+			r[k].Values = BoolsCopy(a[span.A : span.A+span.Len])
+		}
+	}
+	return r
+}
+
+// BoolsApplyPatch changes the contents of an slice in place by applying the edit script produced by BoolsDiff.
+// NOTE:
+//
+//	If the slice does not match the equal and delete runs of the patch, it returns an error
+//	and the slice will not be modified.
+func BoolsApplyPatch(p *[]bool, patch []BoolsEdit) error {
+	a := *p
+	r := make([]bool, 0, len(a))
+	pos := 0
+	for _, e := range patch {
+		switch e.Kind {
+		case DiffInsert:
+			r = append(r, e.Values...)
+		case DiffEqual, DiffDelete:
+			if pos+len(e.Values) > len(a) {
+				return fmt.Errorf("patch mismatch: %s run exceeds the slice length %d", e.Kind, len(a))
+			}
+			for k, v := range e.Values {
+				if a[pos+k] != v {
+					return fmt.Errorf("patch mismatch: %s run at index %d", e.Kind, pos+k)
+				}
+			}
+			if e.Kind == DiffEqual {
+				r = append(r, e.Values...)
+			}
+			pos += len(e.Values)
+		default:
+			return fmt.Errorf("patch mismatch: unknown edit kind %d", e.Kind)
+		}
+	}
+	if pos != len(a) {
+		return fmt.Errorf("patch mismatch: the slice has %d unpatched elements", len(a)-pos)
+	}
+	n := len(r)
+	*p = r[:n:n]
+	return nil
+}
+
+// BoolsShuffle randomizes the order of elements in place.
+// @r
+//
+//	The source of random numbers, nil means the global source of package math/rand.
+func BoolsShuffle(b []bool, r Randomizer) {
+	shuffleIndex(len(b), len(b), r, func(x, y int) { b[x], b[y] = b[y], b[x] })
+}
+
+// BoolsSample returns k elements randomly selected from the slice without replacement.
+// If k is greater than the length of the slice, all the elements are returned in random order.
+// This method does not change the existing slice, but instead returns a new slice.
+// @r
+//
+//	The source of random numbers, nil means the global source of package math/rand.
+func BoolsSample(b []bool, k int, r Randomizer) []bool {
+	if k <= 0 {
+		return []bool{}
+	}
+	a := BoolsCopy(b)
+	shuffleIndex(len(a), k, r, func(x, y int) { a[x], a[y] = a[y], a[x] })
+	if k > len(a) {
+		k = len(a)
+	}
+	return a[:k:k]
+}
+
+// BoolsSampleWithReplacement returns k elements randomly selected from the slice with replacement.
+// This method does not change the existing slice, but instead returns a new slice.
+// @r
+//
+//	The source of random numbers, nil means the global source of package math/rand.
+func BoolsSampleWithReplacement(b []bool, k int, r Randomizer) []bool {
+	if k <= 0 || len(b) == 0 {
+		return []bool{}
+	}
+	r = getRandomizer(r)
+	ret := make([]bool, k)
+	for n := range ret {
+		ret[n] = b[r.Intn(len(b))]
+	}
+	return ret
+}
+
+// BoolsWeightedChoice returns the key-value of an element randomly selected
+// with the probability proportional to its weight.
+// @weights
+//
+//	The weights of the elements with the same indexes, must be finite and non-negative.
+//
+// @r
+//
+//	The source of random numbers, nil means the global source of package math/rand.
+//
+// NOTE:
+//
+//	If the slice is empty, or the weights are invalid, returns an error and k = -1
+func BoolsWeightedChoice(b []bool, weights []float64, r Randomizer) (k int, v bool, err error) {
+	k, err = weightedIndex(len(b), weights, r)
+	if err != nil {
+		return k, v, err
+	}
+	return k, b[k], nil
+}
+
+// BoolsReservoirSample returns k elements randomly selected from a stream of unknown length,
+// in a single pass and with O(k) memory.
+// @next
+//
+//	Returns the next element of the stream, or ok = false at the end of the stream.
+//
+// @r
+//
+//	The source of random numbers, nil means the global source of package math/rand.
+func BoolsReservoirSample(next func() (v bool, ok bool), k int, r Randomizer) []bool {
+	if k <= 0 {
+		return []bool{}
+	}
+	r = getRandomizer(r)
+	ret := make([]bool, 0, k)
+	for seen := 0; ; seen++ {
+		v, ok := next()
+		if !ok {
+			return ret
+		}
+		switch slot := reservoirIndex(seen, k, r); {
+		case slot == len(ret):
+			ret = append(ret, v)
+		case slot >= 0:
+			ret[slot] = v
+		}
+	}
+}
+
+// BoolsAt returns the element at the given index.
+// @index
+//
+//	Zero-based index of the element. If negative, index will be counted from the end.
+//
+// NOTE:
+//
+//	If the index is out of range, ok = false
+func BoolsAt(b []bool, index int) (v bool, ok bool) {
+	index, ok = atIndex(len(b), index)
+	if !ok {
+		return v, false
+	}
+	return b[index], true
+}
+
+// BoolsWith returns a copy of the slice with the element at the given index replaced with the value.
+// @index
+//
+//	Zero-based index of the element. If negative, index will be counted from the end.
+//
+// NOTE:
+//
+//	If the index is out of range, ok = false
+func BoolsWith(b []bool, index int, value bool) (ret []bool, ok bool) {
+	ret = BoolsCopy(b)
+	index, ok = atIndex(len(b), index)
+	if ok {
+		ret[index] = value
+	}
+	return ret, ok
+}
+
+// BoolsFindIndex returns the index of the first element in the provided slice that satisfies the provided testing function.
+// NOTE:
+//
+//	If not found, returns -1
+func BoolsFindIndex(b []bool, fn func(b []bool, k int, v bool) bool) int {
+	k, _ := BoolsFind(b, fn)
+	return k
+}
+
+// BoolsFindLast returns the key-value of the last element in the provided slice that satisfies the provided testing function.
+// NOTE:
+//
+//	If not found, k = -1
+func BoolsFindLast(b []bool, fn func(b []bool, k int, v bool) bool) (k int, v bool) {
+	for k := len(b) - 1; k >= 0; k-- {
+		if fn(b, k, b[k]) {
+			return k, b[k]
+		}
+	}
+	return -1, v
+}
+
+// BoolsFindLastIndex returns the index of the last element in the provided slice that satisfies the provided testing function.
+// NOTE:
+//
+//	If not found, returns -1
+func BoolsFindLastIndex(b []bool, fn func(b []bool, k int, v bool) bool) int {
+	k, _ := BoolsFindLast(b, fn)
+	return k
+}
+
+// BoolsFlat creates a new slice with all sub-slice elements concatenated into it.
+func BoolsFlat(b [][]bool) []bool {
+	return BoolsConcat(b...)
+}
+
+// BoolsFlatMap creates a new slice populated with the results of calling a provided function
+// on every element in the calling slice, and then flattening the results.
+func BoolsFlatMap(b []bool, fn func(b []bool, k int, v bool) []bool) []bool {
+	ret := make([]bool, 0, len(b))
+	for k, v := range b {
+		ret = append(ret, fn(b, k, v)...)
+	}
+	return ret
+}
+
+// BoolsToSorted returns a copy of the slice sorted stably.
+// @less
+//
+//	Reports whether the element a must sort before the element b. If nil, false sorts before true.
+func BoolsToSorted(b []bool, less func(a, b bool) bool) []bool {
+	ret := BoolsCopy(b)
+	if less == nil {
+		less = func(a, b bool) bool { return !a && b }
+	}
+	sort.SliceStable(ret, func(x, y int) bool { return less(ret[x], ret[y]) })
+	return ret
+}
+
+// BoolsToReversed returns a copy of the slice in reversed order.
+func BoolsToReversed(b []bool) []bool {
+	ret := BoolsCopy(b)
+	BoolsReverse(ret)
+	return ret
+}
+
+// BoolsToSpliced returns a copy of the slice with some elements removed and/or replaced at a given index,
+// see also BoolsSplice.
+func BoolsToSpliced(b []bool, start, deleteCount int, items ...bool) []bool {
+	ret := BoolsCopy(b)
+	BoolsSplice(&ret, start, deleteCount, items...)
+	return ret
+}
+
+// BoolsKeys returns an iterator of the indexes of the slice.
+// NOTE:
+//
+//	The iterator returns ok = false when it is exhausted.
+func BoolsKeys(b []bool) (next func() (k int, ok bool)) {
+	k := -1
+	return func() (int, bool) {
+		if k+1 >= len(b) {
+			return -1, false
+		}
+		k++
+		return k, true
+	}
+}
+
+// BoolsValues returns an iterator of the elements of the slice.
+// NOTE:
+//
+//	The iterator returns ok = false when it is exhausted.
+func BoolsValues(b []bool) (next func() (v bool, ok bool)) {
+	k := -1
+	return func() (v bool, ok bool) {
+		if k+1 >= len(b) {
+			return v, false
+		}
+		k++
+		return b[k], true
+	}
+}
+
+// BoolsEntries returns an iterator of the key-value pairs of the slice.
+// NOTE:
+//
+//	The iterator returns ok = false when it is exhausted.
+func BoolsEntries(b []bool) (next func() (k int, v bool, ok bool)) {
+	k := -1
+	return func() (int, bool, bool) {
+		if k+1 >= len(b) {
+			var v bool
+			return -1, v, false
+		}
+		k++
+		return k, b[k], true
+	}
+}
+
+// BoolsGroup groups the elements of the slice according to the string keys returned by the provided function.
+func BoolsGroup(b []bool, fn func(b []bool, k int, v bool) string) map[string][]bool {
+	ret := make(map[string][]bool)
+	for k, v := range b {
+		key := fn(b, k, v)
+		ret[key] = append(ret[key], v)
+	}
+	return ret
+}
+
+// BoolsGroupToMap groups the elements of the slice according to the keys returned by the provided function.
+// NOTE:
+//
+//	The keys must be comparable.
+func BoolsGroupToMap(b []bool, fn func(b []bool, k int, v bool) interface{}) map[interface{}][]bool {
+	ret := make(map[interface{}][]bool)
+	for k, v := range b {
+		key := fn(b, k, v)
+		ret[key] = append(ret[key], v)
+	}
+	return ret
+}
+
+// BoolsEqual reports whether a and b have the same length and the same elements in the same order.
+func BoolsEqual(a, b []bool) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for k, v := range a {
+		if v != b[k] {
+			return false
+		}
+	}
+	return true
+}
+
+// BoolsCompare compares a and b lexicographically, and returns -1 if a < b, 0 if a == b, or +1 if a > b.
+// NOTE:
+//
+//	A slice that is a prefix of another slice is less than it.
+//	false is less than true.
+func BoolsCompare(a, b []bool) int {
+	for k := 0; k < len(a) && k < len(b); k++ {
+		if c := compareBool(a[k], b[k]); c != 0 {
+			return c
+		}
+	}
+	return compareInt(len(a), len(b))
+}
+
+// BoolsHasPrefix reports whether the slice b begins with prefix.
+func BoolsHasPrefix(b, prefix []bool) bool {
+	return len(b) >= len(prefix) && BoolsEqual(b[:len(prefix)], prefix)
+}
+
+// BoolsHasSuffix reports whether the slice b ends with suffix.
+func BoolsHasSuffix(b, suffix []bool) bool {
+	return len(b) >= len(suffix) && BoolsEqual(b[len(b)-len(suffix):], suffix)
+}
+
+func compareBool(a, b bool) int {
+	switch {
+	case a == b:
+		return 0
+	case b:
+		return -1
+	}
+	return 1
+}
@@ -0,0 +1,260 @@
+package ameda
+
+import (
+	"sync"
+	"sync/atomic"
+)
+
+// SyncBools is a bool slice that is safe for concurrent use.
+// NOTE:
+//
+//	The zero value is an empty slice ready to use.
+//	Point reads such as Len and At use the read lock without copying the elements.
+//	Callbacks are run on a snapshot, so they can call the methods of the same SyncBools.
+type SyncBools struct {
+	mu   sync.RWMutex
+	data []bool
+	snap atomic.Value // []bool: read-only copy of data, nil after modification
+}
+
+// NewSyncBools creates a SyncBools with a copy of the elements.
+func NewSyncBools(elements ...bool) *SyncBools {
+	return &SyncBools{data: BoolsCopy(elements)}
+}
+
+// Snapshot returns a read-only copy of the current elements.
+// NOTE:
+//
+//	The result is shared with other callers until the next modification, so it must not be changed.
+//	While there is no modification, it is an atomic read without locking.
+func (s *SyncBools) Snapshot() []bool {
+	if snap, _ := s.snap.Load().([]bool); snap != nil {
+		return snap
+	}
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	snap := BoolsCopy(s.data)
+	s.snap.Store(snap)
+	return snap
+}
+
+// Slice returns a copy of the current elements that can be changed.
+func (s *SyncBools) Slice() []bool {
+	return BoolsCopy(s.Snapshot())
+}
+
+// Len returns the number of elements.
+func (s *SyncBools) Len() int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return len(s.data)
+}
+
+// At returns the element at the index, which can be negative and counted from the end.
+func (s *SyncBools) At(index int) (v bool, ok bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return BoolsAt(s.data, index)
+}
+
+// Includes determines whether the slice includes a certain element.
+func (s *SyncBools) Includes(valueToFind bool, fromIndex ...int) bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return BoolsIncludes(s.data, valueToFind, fromIndex...)
+}
+
+// IndexOf returns the first index at which a given element can be found, or -1 if it is not present.
+func (s *SyncBools) IndexOf(searchElement bool, fromIndex ...int) int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return BoolsIndexOf(s.data, searchElement, fromIndex...)
+}
+
+// LastIndexOf returns the last index at which a given element can be found, or -1 if it is not present.
+func (s *SyncBools) LastIndexOf(searchElement bool, fromIndex ...int) int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return BoolsLastIndexOf(s.data, searchElement, fromIndex...)
+}
+
+// Range calls fn for each element of a snapshot in order, until fn returns false.
+func (s *SyncBools) Range(fn func(k int, v bool) bool) {
+	for k, v := range s.Snapshot() {
+		if !fn(k, v) {
+			return
+		}
+	}
+}
+
+// Every tests whether all elements of a snapshot pass the test implemented by fn.
+func (s *SyncBools) Every(fn func(b []bool, k int, v bool) bool) bool {
+	return BoolsEvery(s.Snapshot(), fn)
+}
+
+// Some tests whether at least one element of a snapshot passes the test implemented by fn.
+func (s *SyncBools) Some(fn func(b []bool, k int, v bool) bool) bool {
+	return BoolsSome(s.Snapshot(), fn)
+}
+
+// Find returns the key-value of the first element of a snapshot that satisfies fn.
+// If no element satisfies fn, -1 is returned.
+func (s *SyncBools) Find(fn func(b []bool, k int, v bool) bool) (k int, v bool) {
+	return BoolsFind(s.Snapshot(), fn)
+}
+
+// Filter creates a new slice with the elements of a snapshot that pass the test implemented by fn.
+func (s *SyncBools) Filter(fn func(b []bool, k int, v bool) bool) []bool {
+	return BoolsFilter(s.Snapshot(), fn)
+}
+
+// Map creates a new slice with the results of calling fn on every element of a snapshot.
+func (s *SyncBools) Map(fn func(b []bool, k int, v bool) bool) []bool {
+	return BoolsMap(s.Snapshot(), fn)
+}
+
+// Reduce executes fn on each element of a snapshot, resulting in a single output value.
+func (s *SyncBools) Reduce(fn func(b []bool, k int, v, accumulator bool) bool, initialValue ...bool) bool {
+	return BoolsReduce(s.Snapshot(), fn, initialValue...)
+}
+
+// Set changes the element at the index, which can be negative and counted from the end,
+// and reports whether the index is in range.
+func (s *SyncBools) Set(index int, value bool) bool {
+	s.mu.Lock()
+	defer s.unlock()
+	k, ok := atIndex(len(s.data), index)
+	if ok {
+		s.data[k] = value
+	}
+	return ok
+}
+
+// Push adds one or more elements to the end, and returns the new length.
+func (s *SyncBools) Push(element ...bool) int {
+	s.mu.Lock()
+	defer s.unlock()
+	return BoolsPush(&s.data, element...)
+}
+
+// PushDistinct adds one or more new elements that do not exist at the end, and returns the new length.
+func (s *SyncBools) PushDistinct(element ...bool) int {
+	s.mu.Lock()
+	defer s.unlock()
+	s.data = BoolsPushDistinct(s.data, element...)
+	return len(s.data)
+}
+
+// Pop removes the last element and returns that element.
+func (s *SyncBools) Pop() (bool, bool) {
+	s.mu.Lock()
+	defer s.unlock()
+	return BoolsPop(&s.data)
+}
+
+// Shift removes the first element and returns that element.
+func (s *SyncBools) Shift() (bool, bool) {
+	s.mu.Lock()
+	defer s.unlock()
+	return BoolsShift(&s.data)
+}
+
+// Unshift adds one or more elements to the beginning, and returns the new length.
+func (s *SyncBools) Unshift(element ...bool) int {
+	s.mu.Lock()
+	defer s.unlock()
+	return BoolsUnshift(&s.data, element...)
+}
+
+// UnshiftDistinct adds one or more new elements that do not exist to the beginning, and returns the new length.
+func (s *SyncBools) UnshiftDistinct(element ...bool) int {
+	s.mu.Lock()
+	defer s.unlock()
+	return BoolsUnshiftDistinct(&s.data, element...)
+}
+
+// Splice removes deleteCount elements from start and adds the items in their place.
+func (s *SyncBools) Splice(start, deleteCount int, items ...bool) {
+	s.mu.Lock()
+	defer s.unlock()
+	BoolsSplice(&s.data, start, deleteCount, items...)
+}
+
+// RemoveFirst removes the first matched elements, and returns the new length.
+func (s *SyncBools) RemoveFirst(elements ...bool) int {
+	s.mu.Lock()
+	defer s.unlock()
+	return BoolsRemoveFirst(&s.data, elements...)
+}
+
+// RemoveEvery removes all the elements, and returns the new length.
+func (s *SyncBools) RemoveEvery(elements ...bool) int {
+	s.mu.Lock()
+	defer s.unlock()
+	return BoolsRemoveEvery(&s.data, elements...)
+}
+
+// Distinct removes the duplicate elements, and returns the count of each element.
+func (s *SyncBools) Distinct() (distinctCount map[bool]int) {
+	s.mu.Lock()
+	defer s.unlock()
+	return BoolsDistinct(&s.data, true)
+}
+
+// Fill changes all elements from start to end to a static value.
+func (s *SyncBools) Fill(value bool, start int, end ...int) {
+	s.mu.Lock()
+	defer s.unlock()
+	BoolsFill(s.data, value, start, end...)
+}
+
+// CopyWithin copies part of the slice to another location in the same slice.
+func (s *SyncBools) CopyWithin(target, start int, end ...int) {
+	s.mu.Lock()
+	defer s.unlock()
+	BoolsCopyWithin(s.data, target, start, end...)
+}
+
+// Reverse reverses the elements in place.
+func (s *SyncBools) Reverse() {
+	s.mu.Lock()
+	defer s.unlock()
+	BoolsReverse(s.data)
+}
+
+// Shuffle randomizes the order of the elements in place.
+func (s *SyncBools) Shuffle(r Randomizer) {
+	s.mu.Lock()
+	defer s.unlock()
+	BoolsShuffle(s.data, r)
+}
+
+// ApplyPatch applies the edit script to the elements.
+func (s *SyncBools) ApplyPatch(patch []BoolsEdit) error {
+	s.mu.Lock()
+	defer s.unlock()
+	return BoolsApplyPatch(&s.data, patch)
+}
+
+// Update calls fn with the pointer of the elements under the write lock.
+// NOTE:
+//
+//	fn must not call the methods of the same SyncBools, and must not retain the slice.
+func (s *SyncBools) Update(fn func(p *[]bool)) {
+	s.mu.Lock()
+	defer s.unlock()
+	fn(&s.data)
+}
+
+// Clear removes all elements.
+func (s *SyncBools) Clear() {
+	s.mu.Lock()
+	defer s.unlock()
+	s.data = nil
+}
+
+// unlock invalidates the snapshot and releases the write lock.
+func (s *SyncBools) unlock() {
+	s.snap.Store([]bool(nil))
+	s.mu.Unlock()
+}
@@ -0,0 +1,245 @@
+package ameda
+
+import (
+	"math"
+)
+
+// FormatBytes returns the string representation of the big-endian number b in the given base,
+// for 2 <= base <= 62, e.g. a short URL-safe ID of a UUID or a hash.
+// NOTE:
+//
+//	Each leading zero byte is encoded as a leading '0', so the bytes are restored exactly by ParseBytes;
+//	the digits are the same as FormatUint.
+func FormatBytes(b []byte, base int) string {
+	return string(AppendBytes(nil, b, base))
+}
+
+// AppendBytes appends the string form of the bytes b, as generated by FormatBytes,
+// to dst and returns the extended buffer.
+// NOTE:
+//
+//	It does not allocate if cap(dst)-len(dst) >= BytesFixedWidth(len(b), base).
+func AppendBytes(dst []byte, b []byte, base int) []byte {
+	checkBytesBase(base)
+	zeros := 0
+	for zeros < len(b) && b[zeros] == 0 {
+		zeros++
+	}
+	start := len(dst)
+	size := maxBytesWidth(len(b)-zeros, base)
+	dst = growBytes(dst, zeros+size)
+	buf := dst[start+zeros:]
+	n := convertRadix(buf, b[zeros:], 256, uint(base))
+	copy(buf, buf[size-n:])
+	buf = dst[start : start+zeros+n]
+	for k, d := range buf {
+		buf[k] = digits[d]
+	}
+	return dst[:start+zeros+n]
+}
+
+// FormatBytesFixed is like FormatBytes, but the result is left-padded with '0'
+// to the fixed width BytesFixedWidth(len(b), base), so all the results of the same length of bytes have the same length.
+func FormatBytesFixed(b []byte, base int) string {
+	return string(AppendBytesFixed(nil, b, base))
+}
+
+// AppendBytesFixed appends the string form of the bytes b, as generated by FormatBytesFixed,
+// to dst and returns the extended buffer.
+// NOTE:
+//
+//	It does not allocate if cap(dst)-len(dst) >= BytesFixedWidth(len(b), base).
+func AppendBytesFixed(dst []byte, b []byte, base int) []byte {
+	checkBytesBase(base)
+	start := len(dst)
+	width := BytesFixedWidth(len(b), base)
+	dst = growBytes(dst, width)
+	buf := dst[start:]
+	convertRadix(buf, b, 256, uint(base))
+	for k, d := range buf {
+		buf[k] = digits[d]
+	}
+	return dst
+}
+
+// BytesFixedWidth returns the length of the string, as generated by FormatBytesFixed, of n bytes in the given base,
+// that is the minimum number of digits that can represent every n-byte number.
+func BytesFixedWidth(n int, base int) int {
+	checkBytesBase(base)
+	return radixWidth(n, base)
+}
+
+// ParseBytes interprets a string s, as generated by FormatBytes, in the given base (2 to 62),
+// and returns the corresponding bytes.
+// NOTE:
+//
+//	Each leading '0' is decoded as a leading zero byte, and the empty string is decoded as no bytes;
+//	for base <= 36 the letters are case-insensitive, like ParseUint.
+//	The errors have concrete type *strconv.NumError.
+func ParseBytes(s string, base int) ([]byte, error) {
+	return appendParseBytes(nil, s, base, "ParseBytes")
+}
+
+// AppendParseBytes appends the bytes of s, as parsed by ParseBytes, to dst and returns the extended buffer.
+// NOTE:
+//
+//	The spare capacity of dst is used as scratch space, which takes up to 2*len(s)+1 bytes, more than the result;
+//	it does not allocate if cap(dst)-len(dst) >= 2*len(s)+1.
+func AppendParseBytes(dst []byte, s string, base int) ([]byte, error) {
+	return appendParseBytes(dst, s, base, "AppendParseBytes")
+}
+
+// ParseBytesFixed interprets a string s, as generated by FormatBytesFixed, in the given base (2 to 62),
+// and returns the corresponding n bytes.
+// NOTE:
+//
+//	The length of s must be BytesFixedWidth(n, base), otherwise err.Err = ErrSyntax;
+//	if the value does not fit into n bytes, err.Err = ErrRange.
+func ParseBytesFixed(s string, base int, n int) ([]byte, error) {
+	return appendParseBytesFixed(nil, s, base, n, "ParseBytesFixed")
+}
+
+// AppendParseBytesFixed appends the n bytes of s, as parsed by ParseBytesFixed, to dst and returns the extended buffer.
+// NOTE:
+//
+//	The spare capacity of dst is used as scratch space, which takes up to 2*len(s)+1 bytes, more than the result;
+//	it does not allocate if cap(dst)-len(dst) >= 2*len(s)+1.
+func AppendParseBytesFixed(dst []byte, s string, base int, n int) ([]byte, error) {
+	return appendParseBytesFixed(dst, s, base, n, "AppendParseBytesFixed")
+}
+
+func appendParseBytes(dst []byte, s string, base int, fn string) ([]byte, error) {
+	if base < 2 || base > len(digits) {
+		return dst, baseError(fn, s, base)
+	}
+	zeros := 0
+	for zeros < len(s) && s[zeros] == '0' {
+		zeros++
+	}
+	start := len(dst)
+	m := len(s) - zeros
+	size := maxDigitsBytes(m, base)
+	// The digit values and the converted bytes are saved after the leading zero bytes.
+	dst = growBytes(dst, zeros+m+size)
+	buf := dst[start+zeros:]
+	for k := 0; k < m; k++ {
+		d, ok := digitValue(s[zeros+k], base)
+		if !ok {
+			return dst[:start], syntaxError(fn, s)
+		}
+		buf[k] = d
+	}
+	n := convertRadix(buf[m:], buf[:m], uint(base), 256)
+	copy(buf, buf[m+size-n:])
+	return dst[:start+zeros+n], nil
+}
+
+func appendParseBytesFixed(dst []byte, s string, base int, n int, fn string) ([]byte, error) {
+	if base < 2 || base > len(digits) {
+		return dst, baseError(fn, s, base)
+	}
+	if n < 0 || len(s) != BytesFixedWidth(n, base) {
+		return dst, syntaxError(fn, s)
+	}
+	start := len(dst)
+	m := len(s)
+	size := maxDigitsBytes(m, base)
+	if size < n {
+		size = n
+	}
+	// The digit values are saved before the converted bytes.
+	dst = growBytes(dst, m+size)
+	buf := dst[start:]
+	for k := 0; k < m; k++ {
+		d, ok := digitValue(s[k], base)
+		if !ok {
+			return dst[:start], syntaxError(fn, s)
+		}
+		buf[k] = d
+	}
+	if convertRadix(buf[m:], buf[:m], uint(base), 256) > n {
+		return dst[:start], rangeError(fn, s)
+	}
+	copy(buf, buf[m+size-n:])
+	return dst[:start+n], nil
+}
+
+// convertRadix converts the big-endian number src in base from to base to,
+// and saves the digits right-aligned in dst, which must be long enough and must not overlap src,
+// and returns the number of the digits without the leading zeros.
+func convertRadix(dst, src []byte, from, to uint) int {
+	size := len(dst)
+	n := 0
+	for _, d := range src {
+		carry := uint(d)
+		j := size - 1
+		for ; j >= size-n || carry != 0; j-- {
+			if j >= size-n {
+				carry += uint(dst[j]) * from
+			}
+			dst[j] = byte(carry % to)
+			carry /= to
+		}
+		n = size - 1 - j
+	}
+	for n > 0 && dst[size-n] == 0 {
+		n--
+	}
+	return n
+}
+
+// maxBytesWidth returns the maximum number of digits in the base of n bytes.
+func maxBytesWidth(n int, base int) int {
+	if n == 0 {
+		return 0
+	}
+	return BytesFixedWidth(n, base)
+}
+
+// maxDigitsBytes returns the maximum number of bytes of n digits in the base.
+func maxDigitsBytes(n int, base int) int {
+	if n == 0 {
+		return 0
+	}
+	return int(math.Ceil(float64(n)*math.Log2(float64(base))/8)) + 1
+}
+
+// digitValue returns the value of the digit c in the base, that is case-insensitive if base <= 36.
+func digitValue(c byte, base int) (byte, bool) {
+	var d byte
+	switch {
+	case '0' <= c && c <= '9':
+		d = c - '0'
+	case 'a' <= c && c <= 'z':
+		d = c - 'a' + 10
+	case 'A' <= c && c <= 'Z':
+		d = c - 'A' + 10
+		if base > 36 {
+			d += 26
+		}
+	default:
+		return 0, false
+	}
+	return d, d < byte(base)
+}
+
+// growBytes extends b by n zero bytes.
+func growBytes(b []byte, n int) []byte {
+	if cap(b)-len(b) < n {
+		c := make([]byte, len(b), 2*cap(b)+n)
+		copy(c, b)
+		b = c
+	}
+	b = b[:len(b)+n]
+	tail := b[len(b)-n:]
+	for k := range tail {
+		tail[k] = 0
+	}
+	return b
+}
+
+func checkBytesBase(base int) {
+	if base < 2 || base > len(digits) {
+		panic("ameda(strconv): illegal AppendBytes/FormatBytes base")
+	}
+}
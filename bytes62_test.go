@@ -0,0 +1,116 @@
+package ameda
+
+import (
+	"bytes"
+	"math/big"
+	"math/rand"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFormatBytes(t *testing.T) {
+	uuid := []byte{0x12, 0x3e, 0x45, 0x67, 0xe8, 0x9b, 0x12, 0xd3, 0xa4, 0x56, 0x42, 0x66, 0x14, 0x17, 0x40, 0x00}
+	assert.Equal(t, new(big.Int).SetBytes(uuid).Text(62), FormatBytes(uuid, 62))
+	assert.Equal(t, "", FormatBytes(nil, 62))
+	assert.Equal(t, "00", FormatBytes([]byte{0, 0}, 16))
+	assert.Equal(t, "00ff", FormatBytes([]byte{0, 0, 255}, 16))
+	assert.Equal(t, "0100", FormatBytes([]byte{0, 4}, 2))
+	assert.Equal(t, "00004", FormatBytesFixed([]byte{0, 4}, 10))
+	assert.Equal(t, "00000100", FormatBytesFixed([]byte{4}, 2))
+	assert.Equal(t, 22, BytesFixedWidth(16, 62))
+	assert.Equal(t, 32, BytesFixedWidth(16, 16))
+	assert.Equal(t, 26, BytesFixedWidth(16, 32))
+	assert.Equal(t, 3, BytesFixedWidth(1, 10))
+	assert.Equal(t, "prefix:ff", string(AppendBytes([]byte("prefix:"), []byte{255}, 16)))
+	assert.Panics(t, func() { FormatBytes(uuid, 63) })
+
+	r := rand.New(rand.NewSource(1))
+	for k := 0; k < 200; k++ {
+		b := make([]byte, r.Intn(40))
+		r.Read(b)
+		if len(b) > 0 && k%3 == 0 {
+			b[0] = 0
+		}
+		base := 2 + r.Intn(61)
+		s := FormatBytes(b, base)
+		trimmed := bytes.TrimLeft(b, "\x00")
+		want := strings.Repeat("0", len(b)-len(trimmed))
+		if len(trimmed) > 0 {
+			want += new(big.Int).SetBytes(trimmed).Text(base)
+		}
+		assert.Equal(t, want, s, "base=%d bytes=%x", base, b)
+		got, err := ParseBytes(s, base)
+		assert.NoError(t, err)
+		assert.Equal(t, b, append([]byte{}, got...))
+
+		s = FormatBytesFixed(b, base)
+		assert.Equal(t, BytesFixedWidth(len(b), base), len(s))
+		got, err = ParseBytesFixed(s, base, len(b))
+		assert.NoError(t, err)
+		assert.Equal(t, b, append([]byte{}, got...))
+	}
+}
+
+func TestParseBytes(t *testing.T) {
+	b, err := ParseBytes("00FF", 16)
+	assert.NoError(t, err)
+	assert.Equal(t, []byte{0, 0, 255}, b)
+	b, err = AppendParseBytes([]byte{1}, "Zz", 62)
+	assert.NoError(t, err)
+	assert.Equal(t, []byte{1, 0x0e, 0xe9}, b)
+	b, err = ParseBytesFixed("255", 10, 1)
+	assert.NoError(t, err)
+	assert.Equal(t, []byte{255}, b)
+
+	_, err = ParseBytes("1g", 16)
+	assert.Equal(t, strconv.ErrSyntax, err.(*strconv.NumError).Err)
+	_, err = ParseBytes("1-", 62)
+	assert.Equal(t, strconv.ErrSyntax, err.(*strconv.NumError).Err)
+	_, err = ParseBytes("1", 1)
+	assert.EqualError(t, err, `strconv.ParseBytes: parsing "1": invalid base 1`)
+	_, err = ParseBytesFixed("256", 10, 1)
+	assert.Equal(t, strconv.ErrRange, err.(*strconv.NumError).Err)
+	_, err = ParseBytesFixed("25", 10, 1)
+	assert.Equal(t, strconv.ErrSyntax, err.(*strconv.NumError).Err)
+}
+
+func TestAppendBytesAllocs(t *testing.T) {
+	hash := bytes.Repeat([]byte{0xab}, 32)
+	buf := make([]byte, 0, 128)
+	s := string(AppendBytes(buf, hash, 62))
+	allocs := testing.AllocsPerRun(100, func() {
+		buf = AppendBytes(buf[:0], hash, 62)
+		buf = AppendBytesFixed(buf[:0], hash, 62)
+		buf, _ = AppendParseBytes(buf[:0], s, 62)
+	})
+	assert.Equal(t, float64(0), allocs)
+
+	// the documented capacities are enough
+	for _, base := range []int{2, 10, 36, 62} {
+		for _, b := range [][]byte{hash, {0, 0, 0xff}, {0xff, 0, 0}, {0}, {}} {
+			width := BytesFixedWidth(len(b), base)
+			s := FormatBytes(b, base)
+			fixed := FormatBytesFixed(b, base)
+			out := make([]byte, 0, width)
+			r := AppendBytes(out, b, base)
+			assert.Equal(t, s, string(r))
+			assert.Equal(t, cap(out), cap(r))
+			r = AppendBytesFixed(out, b, base)
+			assert.Equal(t, fixed, string(r))
+			assert.Equal(t, cap(out), cap(r))
+
+			scratch := make([]byte, 0, 2*len(fixed)+1)
+			r, err := AppendParseBytes(scratch, s, base)
+			assert.NoError(t, err)
+			assert.Equal(t, b, r)
+			assert.Equal(t, cap(scratch), cap(r))
+			r, err = AppendParseBytesFixed(scratch, fixed, base, len(b))
+			assert.NoError(t, err)
+			assert.Equal(t, b, r)
+			assert.Equal(t, cap(scratch), cap(r))
+		}
+	}
+}
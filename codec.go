@@ -0,0 +1,150 @@
+package ameda
+
+import (
+	"fmt"
+	"math"
+)
+
+// Codec converts integers to strings and back according to a dictionary of digits,
+// e.g. the first character of the dict is the digit 0.
+// NOTE:
+//
+//	It is safe for concurrent use.
+type Codec struct {
+	dict       []byte
+	base       uint64
+	index      [256]int16 // character -> digit, -1 if not in the dict, -2 if ignored
+	checkDict  []byte     // the check symbols of the value modulo len(checkDict), or nil for the Luhn mod N check digit
+	checkIndex [256]int16 // check symbol -> value, -1 if not a check symbol
+}
+
+// NewCodec creates a Codec with the dict, that must contain 2 to 256 unique characters.
+func NewCodec(dict []byte) (*Codec, error) {
+	if len(dict) < 2 || len(dict) > 256 {
+		return nil, fmt.Errorf("dict must contain 2 to 256 characters, got %d", len(dict))
+	}
+	c := &Codec{dict: append([]byte(nil), dict...), base: uint64(len(dict))}
+	for k := range c.index {
+		c.index[k] = -1
+	}
+	for k, ch := range dict {
+		if c.index[ch] >= 0 {
+			return nil, fmt.Errorf("duplicate character %q in dict", ch)
+		}
+		c.index[ch] = int16(k)
+	}
+	return c, nil
+}
+
+// MustNewCodec is like NewCodec but panics if the dict is invalid.
+func MustNewCodec(dict []byte) *Codec {
+	c, err := NewCodec(dict)
+	if err != nil {
+		panic(err)
+	}
+	return c
+}
+
+// Base returns the number of the digits.
+func (c *Codec) Base() int {
+	return len(c.dict)
+}
+
+// Dict returns a copy of the dict.
+func (c *Codec) Dict() []byte {
+	return append([]byte(nil), c.dict...)
+}
+
+// Digit returns the digit of the character ch, or -1 if ch is not in the dict.
+func (c *Codec) Digit(ch byte) int {
+	if d := c.index[ch]; d >= 0 {
+		return int(d)
+	}
+	return -1
+}
+
+// FormatUint returns the string representation of u.
+func (c *Codec) FormatUint(u uint64) string {
+	return string(c.AppendUint(nil, u))
+}
+
+// AppendUint appends the string form of u, as generated by FormatUint, to dst and returns the extended buffer.
+func (c *Codec) AppendUint(dst []byte, u uint64) []byte {
+	return appendUintByDict(dst, c.dict, u)
+}
+
+// FormatInt returns the string representation of i.
+// NOTE:
+//
+//	The value is ZigZag encoded (0, -1, 1, -2, 2 ... map to 0, 1, 2, 3, 4 ...),
+//	so no sign character is needed and any dict works.
+func (c *Codec) FormatInt(i int64) string {
+	return string(c.AppendInt(nil, i))
+}
+
+// AppendInt appends the string form of i, as generated by FormatInt, to dst and returns the extended buffer.
+func (c *Codec) AppendInt(dst []byte, i int64) []byte {
+	return c.AppendUint(dst, zigzagEncode(i))
+}
+
+// ParseUint interprets a string s, as generated by FormatUint, and returns the corresponding value.
+// NOTE:
+//
+//	The errors have concrete type *strconv.NumError.
+//	If s is empty or contains a character not in the dict, err.Err = ErrSyntax and the returned value is 0;
+//	if the value overflows uint64, err.Err = ErrRange and the returned value is the maximum uint64.
+func (c *Codec) ParseUint(s string) (uint64, error) {
+	return c.parseUint("ParseUint", s)
+}
+
+// ParseInt interprets a string s, as generated by FormatInt, and returns the corresponding value.
+// NOTE:
+//
+//	The errors are the same as ParseUint.
+func (c *Codec) ParseInt(s string) (int64, error) {
+	u, err := c.parseUint("ParseInt", s)
+	if err != nil {
+		return 0, err
+	}
+	return zigzagDecode(u), nil
+}
+
+func (c *Codec) parseUint(fn, s string) (uint64, error) {
+	if s == "" {
+		return 0, syntaxError(fn, s)
+	}
+	cutoff := math.MaxUint64 / c.base
+	var n uint64
+	count := 0
+	for k := 0; k < len(s); k++ {
+		d := c.index[s[k]]
+		if d == -2 {
+			continue
+		}
+		if d < 0 {
+			return 0, syntaxError(fn, s)
+		}
+		count++
+		if n > cutoff {
+			return math.MaxUint64, rangeError(fn, s)
+		}
+		n *= c.base
+		n1 := n + uint64(d)
+		if n1 < n {
+			return math.MaxUint64, rangeError(fn, s)
+		}
+		n = n1
+	}
+	if count == 0 {
+		return 0, syntaxError(fn, s)
+	}
+	return n, nil
+}
+
+func zigzagEncode(i int64) uint64 {
+	return uint64(i<<1) ^ uint64(i>>63)
+}
+
+func zigzagDecode(u uint64) int64 {
+	return int64(u>>1) ^ -int64(u&1)
+}
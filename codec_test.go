@@ -0,0 +1,64 @@
+package ameda
+
+import (
+	"math"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCodec(t *testing.T) {
+	_, err := NewCodec([]byte("a"))
+	assert.EqualError(t, err, "dict must contain 2 to 256 characters, got 1")
+	_, err = NewCodec([]byte("abca"))
+	assert.EqualError(t, err, `duplicate character 'a' in dict`)
+	assert.Panics(t, func() { MustNewCodec(nil) })
+
+	c := MustNewCodec([]byte(digits))
+	assert.Equal(t, 62, c.Base())
+	assert.Equal(t, 61, c.Digit('Z'))
+	assert.Equal(t, -1, c.Digit('-'))
+	for _, u := range []uint64{0, 1, 61, 62, 1 << 53, 1<<53 + 1, math.MaxUint64} {
+		s := c.FormatUint(u)
+		assert.Equal(t, FormatUint(u, 62), s)
+		u2, err := c.ParseUint(s)
+		assert.NoError(t, err)
+		assert.Equal(t, u, u2)
+	}
+	for _, i := range []int64{0, -1, 1, -2, math.MinInt64, math.MaxInt64} {
+		i2, err := c.ParseInt(c.FormatInt(i))
+		assert.NoError(t, err)
+		assert.Equal(t, i, i2)
+	}
+	assert.Equal(t, "1", c.FormatInt(-1))
+	assert.Equal(t, "2", c.FormatInt(1))
+	assert.Equal(t, "x:10", string(c.AppendUint([]byte("x:"), 62)))
+	assert.Equal(t, "x:3", string(c.AppendInt([]byte("x:"), -2)))
+
+	u, err := c.ParseUint(c.FormatUint(math.MaxUint64) + "0")
+	assert.Equal(t, strconv.ErrRange, err.(*strconv.NumError).Err)
+	assert.Equal(t, uint64(math.MaxUint64), u)
+	_, err = c.ParseUint("lYGhA16ahyg") // MaxUint64 + 1
+	assert.Equal(t, strconv.ErrRange, err.(*strconv.NumError).Err)
+	_, err = c.ParseUint("")
+	assert.Equal(t, strconv.ErrSyntax, err.(*strconv.NumError).Err)
+	_, err = c.ParseInt("a-b")
+	assert.EqualError(t, err, `strconv.ParseInt: parsing "a-b": invalid syntax`)
+
+	bin := MustNewCodec([]byte("-+"))
+	assert.Equal(t, "+-+", bin.FormatUint(5))
+}
+
+func TestParseUintByDictExact(t *testing.T) {
+	dict := []byte(digits)
+	for _, u := range []uint64{1<<53 + 1, math.MaxUint64} {
+		u2, err := ParseUintByDict(dict, FormatUintByDict(dict, u))
+		assert.NoError(t, err)
+		assert.Equal(t, u, u2)
+	}
+	_, err := ParseUintByDict(dict, FormatUintByDict(dict, math.MaxUint64)+"0")
+	assert.Error(t, err)
+	_, err = ParseUintByDict(dict, "a-")
+	assert.EqualError(t, err, `found a char not included in the dict: "-"`)
+}
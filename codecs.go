@@ -0,0 +1,147 @@
+package ameda
+
+import (
+	"errors"
+	"strconv"
+)
+
+// The standard alphabets of the Codec presets.
+const (
+	Base58BitcoinAlphabet = "123456789ABCDEFGHJKLMNPQRSTUVWXYZabcdefghijkmnopqrstuvwxyz"
+	Base58FlickrAlphabet  = "123456789abcdefghijkmnopqrstuvwxyzABCDEFGHJKLMNPQRSTUVWXYZ"
+	Crockford32Alphabet   = "0123456789ABCDEFGHJKMNPQRSTVWXYZ"
+	Base36Alphabet        = "0123456789abcdefghijklmnopqrstuvwxyz"
+	Base64URLAlphabet     = "ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz0123456789-_"
+)
+
+var (
+	// Base62Codec is the base62 codec, the same as FormatUint(u, 62).
+	Base62Codec = MustNewCodec([]byte(digits))
+	// Base58BitcoinCodec is the base58 codec of Bitcoin addresses, without 0, O, I and l.
+	Base58BitcoinCodec = MustNewCodec([]byte(Base58BitcoinAlphabet))
+	// Base58FlickrCodec is the base58 codec of Flickr short URLs, with lower-case letters before upper-case ones.
+	Base58FlickrCodec = MustNewCodec([]byte(Base58FlickrAlphabet))
+	// Crockford32Codec is the Crockford's base32 codec.
+	// NOTE:
+	//
+	//	When decoding, letters are case-insensitive, I and L are read as 1, O is read as 0, and hyphens are ignored;
+	//	the check symbol is the value modulo 37, written as one of 0-9A-Z (without I, L, O, U) and *~$=U.
+	Crockford32Codec = newCrockford32Codec()
+	// Base36Codec is the base36 codec, the same as strconv.FormatUint(u, 36).
+	// NOTE:
+	//
+	//	When decoding, letters are case-insensitive.
+	Base36Codec = newFoldCodec(Base36Alphabet)
+	// Base64URLCodec is the codec of the URL-safe base64 digits (RFC 4648), whose digit 0 is A.
+	Base64URLCodec = MustNewCodec([]byte(Base64URLAlphabet))
+)
+
+// ErrChecksum indicates that the check symbol of a string does not match its value.
+var ErrChecksum = errors.New("checksum mismatch")
+
+// FormatUintCheck returns the string representation of u followed by a check symbol,
+// that detects a mistyped character and most transpositions of adjacent characters.
+// NOTE:
+//
+//	The check symbol is a Luhn mod N check digit of the dict,
+//	except that Crockford32Codec uses the check symbol of Crockford's base32.
+func (c *Codec) FormatUintCheck(u uint64) string {
+	return string(c.AppendUintCheck(nil, u))
+}
+
+// AppendUintCheck appends the string form of u, as generated by FormatUintCheck, to dst and returns the extended buffer.
+func (c *Codec) AppendUintCheck(dst []byte, u uint64) []byte {
+	start := len(dst)
+	dst = c.AppendUint(dst, u)
+	if c.checkDict != nil {
+		return append(dst, c.checkDict[u%uint64(len(c.checkDict))])
+	}
+	return append(dst, c.dict[c.luhnCheck(dst[start:])])
+}
+
+// ParseUintCheck interprets a string s, as generated by FormatUintCheck, and returns the corresponding value.
+// NOTE:
+//
+//	The errors are the same as ParseUint, and if the check symbol does not match, err.Err = ErrChecksum.
+func (c *Codec) ParseUintCheck(s string) (uint64, error) {
+	const fnParseUintCheck = "ParseUintCheck"
+	end := len(s) - 1
+	for end >= 0 && c.index[s[end]] == -2 {
+		end--
+	}
+	if end < 1 {
+		return 0, syntaxError(fnParseUintCheck, s)
+	}
+	u, err := c.parseUint(fnParseUintCheck, s[:end])
+	if err != nil {
+		err.(*strconv.NumError).Num = s
+		return u, err
+	}
+	if c.checkDict != nil {
+		v := c.checkIndex[s[end]]
+		if v < 0 {
+			return 0, syntaxError(fnParseUintCheck, s)
+		}
+		if uint64(v) != u%uint64(len(c.checkDict)) {
+			return 0, &strconv.NumError{Func: fnParseUintCheck, Num: s, Err: ErrChecksum}
+		}
+		return u, nil
+	}
+	d := c.index[s[end]]
+	if d < 0 {
+		return 0, syntaxError(fnParseUintCheck, s)
+	}
+	var a [64]byte
+	if int(d) != c.luhnCheck(c.AppendUint(a[:0], u)) {
+		return 0, &strconv.NumError{Func: fnParseUintCheck, Num: s, Err: ErrChecksum}
+	}
+	return u, nil
+}
+
+// luhnCheck returns the Luhn mod N check digit of the canonical characters s.
+func (c *Codec) luhnCheck(s []byte) int {
+	n := len(c.dict)
+	factor, sum := 2, 0
+	for k := len(s) - 1; k >= 0; k-- {
+		addend := factor * int(c.index[s[k]])
+		factor = 3 - factor
+		sum += addend/n + addend%n
+	}
+	return (n - sum%n) % n
+}
+
+// newFoldCodec creates a codec of the lower-case alphabet, whose upper-case letters are decoded as lower-case ones.
+func newFoldCodec(alphabet string) *Codec {
+	c := MustNewCodec([]byte(alphabet))
+	for k := 0; k < len(alphabet); k++ {
+		if ch := alphabet[k]; 'a' <= ch && ch <= 'z' {
+			c.index[ch-'a'+'A'] = c.index[ch]
+		}
+	}
+	return c
+}
+
+func newCrockford32Codec() *Codec {
+	c := MustNewCodec([]byte(Crockford32Alphabet))
+	c.checkDict = []byte(Crockford32Alphabet + "*~$=U")
+	for k := range c.checkIndex {
+		c.checkIndex[k] = -1
+	}
+	for k, ch := range c.checkDict {
+		c.checkIndex[ch] = int16(k)
+	}
+	aliases := map[byte]byte{'I': '1', 'L': '1', 'O': '0'}
+	for k := 'A'; k <= 'Z'; k++ {
+		upper := byte(k)
+		from := upper
+		if to, ok := aliases[upper]; ok {
+			from = to
+		}
+		c.index[upper] = c.index[from]
+		c.index[upper+'a'-'A'] = c.index[from]
+		c.checkIndex[upper] = c.checkIndex[from]
+		c.checkIndex[upper+'a'-'A'] = c.checkIndex[from]
+	}
+	c.index['-'] = -2
+	return c
+}
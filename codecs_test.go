@@ -0,0 +1,70 @@
+package ameda
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCodecPresets(t *testing.T) {
+	assert.Equal(t, "z", Base58BitcoinCodec.FormatUint(57))
+	assert.Equal(t, "21", Base58BitcoinCodec.FormatUint(58))
+	assert.Equal(t, "Z", Base58FlickrCodec.FormatUint(57))
+	assert.Equal(t, "_", Base64URLCodec.FormatUint(63))
+	assert.Equal(t, "BA", Base64URLCodec.FormatUint(64))
+	for _, u := range []uint64{0, 35, 36, 1<<64 - 1} {
+		assert.Equal(t, strconv.FormatUint(u, 36), Base36Codec.FormatUint(u))
+	}
+	u, err := Base36Codec.ParseUint("ZZ")
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(36*36-1), u)
+	_, err = Base58BitcoinCodec.ParseUint("0OIl")
+	assert.Error(t, err)
+
+	assert.Equal(t, "16J", Crockford32Codec.FormatUint(1234))
+	for _, s := range []string{"16J", "16j", "1-6-J", "i6J", "L6J", "0016J", "oO16J"} {
+		u, err = Crockford32Codec.ParseUint(s)
+		assert.NoError(t, err, s)
+		assert.Equal(t, uint64(1234), u, s)
+	}
+	_, err = Crockford32Codec.ParseUint("16U")
+	assert.Error(t, err)
+	_, err = Crockford32Codec.ParseUint("--")
+	assert.Error(t, err)
+}
+
+func TestCodecCheck(t *testing.T) {
+	assert.Equal(t, "16JD", Crockford32Codec.FormatUintCheck(1234))
+	assert.Equal(t, "14U", Crockford32Codec.FormatUintCheck(36))
+	u, err := Crockford32Codec.ParseUintCheck("16j-d")
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(1234), u)
+	u, err = Crockford32Codec.ParseUintCheck("14u")
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(36), u)
+	_, err = Crockford32Codec.ParseUintCheck("16JE")
+	assert.Equal(t, ErrChecksum, err.(*strconv.NumError).Err)
+	_, err = Crockford32Codec.ParseUintCheck("D")
+	assert.Equal(t, strconv.ErrSyntax, err.(*strconv.NumError).Err)
+
+	decimal := MustNewCodec([]byte("0123456789"))
+	assert.Equal(t, "79927398713", decimal.FormatUintCheck(7992739871))
+	_, err = decimal.ParseUintCheck("79927398710")
+	assert.EqualError(t, err, `strconv.ParseUintCheck: parsing "79927398710": checksum mismatch`)
+
+	for _, c := range []*Codec{Base58BitcoinCodec, Base36Codec, Base64URLCodec, Crockford32Codec} {
+		for _, u := range []uint64{0, 1, 12345, 1<<64 - 1} {
+			s := c.FormatUintCheck(u)
+			u2, err := c.ParseUintCheck(s)
+			assert.NoError(t, err)
+			assert.Equal(t, u, u2)
+			// a mistyped character is detected
+			b := []byte(s)
+			b[0] = c.dict[(c.Digit(b[0])+1)%c.Base()]
+			_, err = c.ParseUintCheck(string(b))
+			assert.Error(t, err, string(b))
+		}
+	}
+	assert.Equal(t, "x:109", string(decimal.AppendUintCheck([]byte("x:"), 10)))
+}
@@ -0,0 +1,171 @@
+package ameda
+
+// DiffKind is the kind of an edit run.
+type DiffKind uint8
+
+const (
+	// DiffEqual means the elements are kept.
+	DiffEqual DiffKind = iota
+	// DiffDelete means the elements are removed from the old slice.
+	DiffDelete
+	// DiffInsert means the elements are inserted from the new slice.
+	DiffInsert
+)
+
+// String returns the name of the kind.
+func (k DiffKind) String() string {
+	switch k {
+	case DiffEqual:
+		return "equal"
+	case DiffDelete:
+		return "delete"
+	case DiffInsert:
+		return "insert"
+	default:
+		return "unknown"
+	}
+}
+
+// DiffSpan is a run of edits with the same kind.
+// @A
+//
+//	The start index in the old slice. For DiffInsert, it is the position of the insertion.
+//
+// @B
+//
+//	The start index in the new slice. For DiffDelete, it is the position of the deletion.
+//
+// @Len
+//
+//	The number of elements in the run.
+type DiffSpan struct {
+	Kind DiffKind
+	A    int
+	B    int
+	Len  int
+}
+
+// DiffFunc computes the shortest edit script that turns the old slice into the new slice
+// with the Myers algorithm, and returns it as runs of edits.
+// @lenA
+//
+//	The length of the old slice.
+//
+// @lenB
+//
+//	The length of the new slice.
+//
+// @equal
+//
+//	Reports whether the element i of the old slice equals the element j of the new slice.
+func DiffFunc(lenA, lenB int, equal func(i, j int) bool) []DiffSpan {
+	var spans []DiffSpan
+	add := func(kind DiffKind, a, b, n int) {
+		if last := len(spans) - 1; last >= 0 && spans[last].Kind == kind {
+			spans[last].Len += n
+			return
+		}
+		spans = append(spans, DiffSpan{Kind: kind, A: a, B: b, Len: n})
+	}
+
+	// trim the common prefix and suffix
+	pre := 0
+	for pre < lenA && pre < lenB && equal(pre, pre) {
+		pre++
+	}
+	suf := 0
+	for suf < lenA-pre && suf < lenB-pre && equal(lenA-1-suf, lenB-1-suf) {
+		suf++
+	}
+	if pre > 0 {
+		add(DiffEqual, 0, 0, pre)
+	}
+	for _, op := range myers(lenA-pre-suf, lenB-pre-suf, func(i, j int) bool { return equal(pre+i, pre+j) }) {
+		add(op.kind, pre+op.a, pre+op.b, 1)
+	}
+	if suf > 0 {
+		add(DiffEqual, lenA-suf, lenB-suf, suf)
+	}
+	return spans
+}
+
+type diffOp struct {
+	kind DiffKind
+	a, b int
+}
+
+// myers returns the edit operations of every element in order.
+func myers(n, m int, equal func(i, j int) bool) []diffOp {
+	if n == 0 && m == 0 {
+		return nil
+	}
+	max := n + m
+	offset := max
+	v := make([]int, 2*max+2)
+	// trace[d] holds v[k] for k in [-(d-1), d-1], the only diagonals read when backtracking step d,
+	// so it takes O(D^2) rather than O((N+M)*D) memory.
+	var trace [][]int
+	var d int
+L:
+	for d = 0; d <= max; d++ {
+		if d == 0 {
+			trace = append(trace, nil)
+		} else {
+			trace = append(trace, append([]int(nil), v[offset-d+1:offset+d]...))
+		}
+		for k := -d; k <= d; k += 2 {
+			var x int
+			if k == -d || k != d && v[offset+k-1] < v[offset+k+1] {
+				x = v[offset+k+1]
+			} else {
+				x = v[offset+k-1] + 1
+			}
+			y := x - k
+			for x < n && y < m && equal(x, y) {
+				x++
+				y++
+			}
+			v[offset+k] = x
+			if x >= n && y >= m {
+				break L
+			}
+		}
+	}
+
+	ops := make([]diffOp, 0, n+m)
+	x, y := n, m
+	for ; d > 0; d-- {
+		v := trace[d]
+		vOffset := d - 1
+		k := x - y
+		var prevK int
+		if k == -d || k != d && v[vOffset+k-1] < v[vOffset+k+1] {
+			prevK = k + 1
+		} else {
+			prevK = k - 1
+		}
+		prevX := v[vOffset+prevK]
+		prevY := prevX - prevK
+		for x > prevX && y > prevY {
+			x--
+			y--
+			ops = append(ops, diffOp{kind: DiffEqual, a: x, b: y})
+		}
+		if x == prevX {
+			y--
+			ops = append(ops, diffOp{kind: DiffInsert, a: x, b: y})
+		} else {
+			x--
+			ops = append(ops, diffOp{kind: DiffDelete, a: x, b: y})
+		}
+	}
+	for x > 0 && y > 0 {
+		x--
+		y--
+		ops = append(ops, diffOp{kind: DiffEqual, a: x, b: y})
+	}
+	for first, last := 0, len(ops)-1; first < last; first, last = first+1, last-1 {
+		ops[first], ops[last] = ops[last], ops[first]
+	}
+	return ops
+}
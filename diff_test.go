@@ -0,0 +1,154 @@
+package ameda
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDiffFunc(t *testing.T) {
+	a := []string{"a", "b", "c", "a", "b", "b", "a"}
+	b := []string{"c", "b", "a", "b", "a", "c"}
+	spans := DiffFunc(len(a), len(b), func(i, j int) bool { return a[i] == b[j] })
+	var edits int
+	for _, span := range spans {
+		if span.Kind != DiffEqual {
+			edits += span.Len
+		}
+	}
+	// the shortest edit script of the example in Myers' paper has 5 edits
+	assert.Equal(t, 5, edits)
+	assert.Nil(t, DiffFunc(0, 0, nil))
+}
+
+func TestStringsDiff(t *testing.T) {
+	a := []string{"a", "b", "c", "d"}
+	b := []string{"a", "x", "c", "d", "e"}
+	patch := StringsDiff(a, b)
+	assert.Equal(t, []StringsEdit{
+		{Kind: DiffEqual, Values: []string{"a"}},
+		{Kind: DiffDelete, Values: []string{"b"}},
+		{Kind: DiffInsert, Values: []string{"x"}},
+		{Kind: DiffEqual, Values: []string{"c", "d"}},
+		{Kind: DiffInsert, Values: []string{"e"}},
+	}, patch)
+	assert.NoError(t, StringsApplyPatch(&a, patch))
+	assert.Equal(t, b, a)
+
+	c := []string{"a", "y", "c", "d"}
+	assert.EqualError(t, StringsApplyPatch(&c, patch), "patch mismatch: delete run at index 1")
+	assert.Equal(t, []string{"a", "y", "c", "d"}, c)
+}
+
+func TestIntsDiffRandom(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	gen := func() []int {
+		s := make([]int, r.Intn(20))
+		for k := range s {
+			s[k] = r.Intn(4)
+		}
+		return s
+	}
+	for n := 0; n < 200; n++ {
+		a, b := gen(), gen()
+		patch := IntsDiff(a, b)
+		c := IntsCopy(a)
+		assert.NoError(t, IntsApplyPatch(&c, patch))
+		assert.Equal(t, b, IntsConcat(c))
+	}
+}
+
+func TestStringsUnifiedDiff(t *testing.T) {
+	a := []string{"1", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12"}
+	b := []string{"1", "2", "three", "4", "5", "6", "7", "8", "9", "10", "11", "12", "13"}
+	assert.Equal(t, `--- a.txt
++++ b.txt
+@@ -1,5 +1,5 @@
+ 1
+ 2
+-3
++three
+ 4
+ 5
+@@ -11,2 +11,3 @@
+ 11
+ 12
++13
+`, StringsUnifiedDiff(a, b, "a.txt", "b.txt", 2))
+	assert.Equal(t, `@@ -1,12 +1,13 @@
+ 1
+ 2
+-3
++three
+ 4
+ 5
+ 6
+ 7
+ 8
+ 9
+ 10
+ 11
+ 12
++13
+`, StringsUnifiedDiff(a, b, "", "", 5))
+	assert.Equal(t, "@@ -0,0 +1 @@\n+x\n", StringsUnifiedDiff(nil, []string{"x"}, "", "", 3))
+	assert.Equal(t, "", StringsUnifiedDiff(a, a, "a", "b", 3))
+}
+
+func TestFloat64sDiffNaN(t *testing.T) {
+	nan := math.NaN()
+	a := []float64{1, nan, 2, math.Copysign(0, -1)}
+	b := []float64{nan, 2, 3, 0}
+	patch := Float64sDiff(a, b)
+	var kinds []DiffKind
+	for _, e := range patch {
+		kinds = append(kinds, e.Kind)
+	}
+	// -0 does not equal +0 bitwise
+	assert.Equal(t, []DiffKind{DiffDelete, DiffEqual, DiffDelete, DiffInsert}, kinds)
+	assert.NoError(t, Float64sApplyPatch(&a, patch))
+	assert.Equal(t, 4, len(a))
+	assert.True(t, math.IsNaN(a[0]))
+	assert.Equal(t, []float64{2, 3, 0}, a[1:])
+	assert.False(t, math.Signbit(a[3]))
+}
+
+func TestInterfacesDiffIncomparable(t *testing.T) {
+	a := []interface{}{[]int{1}, map[string]int{"x": 1}, 2}
+	b := []interface{}{[]int{1}, 3, map[string]int{"x": 1}}
+	patch := InterfacesDiff(a, b)
+	assert.Equal(t, DiffEqual, patch[0].Kind)
+	assert.NoError(t, InterfacesApplyPatch(&a, patch))
+	assert.Equal(t, b, a)
+
+	equal := InterfaceLooseEqual
+	c := []interface{}{1, int8(2), "x"}
+	d := []interface{}{1.0, 2, uint(7)}
+	patch = InterfacesDiffFunc(c, d, equal)
+	// the equal run carries the values of d, so applying the patch reproduces d exactly
+	assert.Equal(t, []InterfacesEdit{
+		{Kind: DiffEqual, Values: []interface{}{1.0, 2}},
+		{Kind: DiffDelete, Values: []interface{}{"x"}},
+		{Kind: DiffInsert, Values: []interface{}{uint(7)}},
+	}, patch)
+	assert.NoError(t, InterfacesApplyPatchFunc(&c, patch, equal))
+	assert.Equal(t, d, c)
+	assert.EqualError(t, InterfacesApplyPatch(&[]interface{}{1, int8(2), "x"}, patch), "patch mismatch: equal run at index 0")
+}
+
+func TestIntsDiffDistinct(t *testing.T) {
+	// no common element, so D = N + M
+	a, b := make([]int, 500), make([]int, 700)
+	for k := range a {
+		a[k] = k
+	}
+	for k := range b {
+		b[k] = -k - 1
+	}
+	patch := IntsDiff(a, b)
+	assert.Equal(t, []IntsEdit{{Kind: DiffDelete, Values: a}, {Kind: DiffInsert, Values: b}}, patch)
+	assert.NoError(t, IntsApplyPatch(&a, patch))
+	assert.Equal(t, b, a)
+}
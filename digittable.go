@@ -0,0 +1,194 @@
+package ameda
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+)
+
+// digits95 extends digits with the printable ASCII punctuation and space,
+// the URL-safe ones first, so base 64 is 0-9a-zA-Z-_, and '-' is a digit of base >= 63.
+const digits95 = digits + "-_.~!$&'()*,;=:@/?#[]%^`{|}<>\"\\+ "
+
+// DigitTable is a pluggable table of 2 to 95 digits for the strconv-style functions,
+// the base of which can be up to the number of the digits.
+// NOTE:
+//
+//	The signed functions are unambiguous for a base of which '-' and '+' are not digits,
+//	e.g. any base of a table without them, or base <= 62 of StdDigitTable.
+//	Otherwise FormatInt/AppendInt panic on negative values, since '-' would read as a digit,
+//	and ParseInt reads a leading '-' or '+' of the base as a digit, so non-negative values still round-trip.
+//	If the table begins with 0-9a-z, the letters are case-insensitive for base <= 36, like strconv.
+//	Unlike strconv, a base prefix (e.g. 0x) and underscores are not accepted.
+//	It is safe for concurrent use.
+type DigitTable struct {
+	digits   string
+	index    [256]int8 // character -> digit, -1 if not a digit
+	foldCase bool
+}
+
+// StdDigitTable is the digit table of FormatUint/ParseUint for base > 62:
+// 0-9a-zA-Z followed by -_.~!$&'()*,;=:@/?#[]%^`{|}<>"\+ and space.
+var StdDigitTable = MustNewDigitTable(digits95)
+
+// NewDigitTable creates a DigitTable with 2 to 95 unique digits.
+func NewDigitTable(digits string) (*DigitTable, error) {
+	if len(digits) < 2 || len(digits) > 95 {
+		return nil, fmt.Errorf("digit table must contain 2 to 95 characters, got %d", len(digits))
+	}
+	t := &DigitTable{digits: digits, foldCase: len(digits) >= 36 && digits[:36] == digits95[:36]}
+	for k := range t.index {
+		t.index[k] = -1
+	}
+	for k := 0; k < len(digits); k++ {
+		if t.index[digits[k]] >= 0 {
+			return nil, fmt.Errorf("duplicate character %q in digit table", digits[k])
+		}
+		t.index[digits[k]] = int8(k)
+	}
+	return t, nil
+}
+
+// MustNewDigitTable is like NewDigitTable but panics if the digits are invalid.
+func MustNewDigitTable(digits string) *DigitTable {
+	t, err := NewDigitTable(digits)
+	if err != nil {
+		panic(err)
+	}
+	return t
+}
+
+// Digits returns the digits of the table.
+func (t *DigitTable) Digits() string {
+	return t.digits
+}
+
+// FormatUint returns the string representation of u in the given base, for 2 <= base <= len(t.Digits()).
+func (t *DigitTable) FormatUint(u uint64, base int) string {
+	_, s := formatBits(nil, u, base, false, false, t.digits)
+	return s
+}
+
+// FormatInt returns the string representation of i in the given base, for 2 <= base <= len(t.Digits()).
+// It panics if i is negative and '-' is a digit of the base.
+func (t *DigitTable) FormatInt(i int64, base int) string {
+	t.checkSign(i, base)
+	_, s := formatBits(nil, uint64(i), base, i < 0, false, t.digits)
+	return s
+}
+
+// AppendUint appends the string form of u, as generated by FormatUint, to dst and returns the extended buffer.
+func (t *DigitTable) AppendUint(dst []byte, u uint64, base int) []byte {
+	dst, _ = formatBits(dst, u, base, false, true, t.digits)
+	return dst
+}
+
+// AppendInt appends the string form of i, as generated by FormatInt, to dst and returns the extended buffer.
+func (t *DigitTable) AppendInt(dst []byte, i int64, base int) []byte {
+	t.checkSign(i, base)
+	dst, _ = formatBits(dst, uint64(i), base, i < 0, true, t.digits)
+	return dst
+}
+
+// ParseUint interprets a string s in the given base (2 to len(t.Digits())) and bit size (0 to 64),
+// and returns the corresponding value.
+// NOTE:
+//
+//	The errors are the same as ParseUint.
+func (t *DigitTable) ParseUint(s string, base int, bitSize int) (uint64, error) {
+	return t.parseUint("ParseUint", s, base, bitSize)
+}
+
+// ParseInt interprets a string s in the given base (2 to len(t.Digits())) and bit size (0 to 64),
+// and returns the corresponding value.
+// NOTE:
+//
+//	The errors are the same as ParseInt.
+//	A leading '-' or '+' is a sign only if it is not a digit of the base.
+func (t *DigitTable) ParseInt(s string, base int, bitSize int) (int64, error) {
+	const fnParseInt = "ParseInt"
+	if base < 2 || base > len(t.digits) {
+		return 0, baseError(fnParseInt, s, base)
+	}
+	if s == "" {
+		return 0, syntaxError(fnParseInt, s)
+	}
+	s0 := s
+	neg := false
+	if s[0] == '+' && !t.isDigit('+', base) {
+		s = s[1:]
+	} else if s[0] == '-' && !t.isDigit('-', base) {
+		neg = true
+		s = s[1:]
+	}
+	un, err := t.parseUint(fnParseInt, s, base, bitSize)
+	if err != nil && err.(*strconv.NumError).Err != strconv.ErrRange {
+		err.(*strconv.NumError).Num = s0
+		return 0, err
+	}
+	if bitSize == 0 {
+		bitSize = int(strconv.IntSize)
+	}
+	cutoff := uint64(1 << uint(bitSize-1))
+	if !neg && un >= cutoff {
+		return int64(cutoff - 1), rangeError(fnParseInt, s0)
+	}
+	if neg && un > cutoff {
+		return -int64(cutoff), rangeError(fnParseInt, s0)
+	}
+	n := int64(un)
+	if neg {
+		n = -n
+	}
+	return n, nil
+}
+
+func (t *DigitTable) parseUint(fn, s string, base int, bitSize int) (uint64, error) {
+	if base < 2 || base > len(t.digits) {
+		return 0, baseError(fn, s, base)
+	}
+	if s == "" {
+		return 0, syntaxError(fn, s)
+	}
+	if bitSize == 0 {
+		bitSize = int(strconv.IntSize)
+	} else if bitSize < 0 || bitSize > 64 {
+		return 0, bitSizeError(fn, s, bitSize)
+	}
+	cutoff := math.MaxUint64/uint64(base) + 1
+	maxVal := uint64(1)<<uint(bitSize) - 1
+	fold := t.foldCase && base <= 36
+	var n uint64
+	for k := 0; k < len(s); k++ {
+		c := s[k]
+		if fold && 'A' <= c && c <= 'Z' {
+			c += 'a' - 'A'
+		}
+		d := t.index[c]
+		if d < 0 || int(d) >= base {
+			return 0, syntaxError(fn, s)
+		}
+		if n >= cutoff {
+			return maxVal, rangeError(fn, s)
+		}
+		n *= uint64(base)
+		n1 := n + uint64(d)
+		if n1 < n || n1 > maxVal {
+			return maxVal, rangeError(fn, s)
+		}
+		n = n1
+	}
+	return n, nil
+}
+
+// isDigit reports whether the character c is a digit of the base.
+func (t *DigitTable) isDigit(c byte, base int) bool {
+	d := t.index[c]
+	return d >= 0 && int(d) < base
+}
+
+func (t *DigitTable) checkSign(i int64, base int) {
+	if i < 0 && t.isDigit('-', base) {
+		panic("ameda(strconv): the sign '-' is a digit of the base")
+	}
+}
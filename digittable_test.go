@@ -0,0 +1,165 @@
+package ameda_test
+
+import (
+	"math"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	. "github.com/andeya/ameda"
+)
+
+func TestFormatUintBase95(t *testing.T) {
+	assert.Equal(t, 95, len(StdDigitTable.Digits()))
+	// the digits of base <= 62 are unchanged
+	for _, base := range []int{2, 10, 16, 36, 62} {
+		assert.Equal(t, FormatUint(123456789, base), StdDigitTable.FormatUint(123456789, base))
+	}
+	assert.Equal(t, "-", FormatUint(62, 64))
+	assert.Equal(t, "_", FormatUint(63, 64))
+	assert.Equal(t, " ", FormatUint(94, 95))
+	assert.Equal(t, "10", FormatUint(95, 95))
+	assert.Panics(t, func() { FormatUint(1, 96) })
+
+	for _, base := range []int{63, 64, 85, 95} {
+		for _, u := range []uint64{0, 1, 94, 95, 1 << 32, math.MaxUint64} {
+			s := FormatUint(u, base)
+			assert.Equal(t, s, string(AppendUint(nil, u, base)))
+			v, err := ParseUint(s, base, 64)
+			assert.NoError(t, err)
+			assert.Equal(t, u, v)
+		}
+	}
+}
+
+func TestFormatIntBase95(t *testing.T) {
+	assert.Equal(t, "-Z", FormatInt(-61, 62))
+	assert.Equal(t, "x-Z", string(AppendInt([]byte("x"), -61, 62)))
+	for _, i := range []int64{-1, -61, -62, math.MinInt64, math.MaxInt64} {
+		v, err := ParseInt(FormatInt(i, 62), 62, 64)
+		assert.NoError(t, err)
+		assert.Equal(t, i, v)
+	}
+
+	// non-negative values work for any base of StdDigitTable
+	for _, base := range []int{63, 64, 85, 95} {
+		for _, i := range []int64{0, 1, 62, 93, math.MaxInt64} {
+			s := FormatInt(i, base)
+			assert.Equal(t, FormatUint(uint64(i), base), s)
+			assert.Equal(t, s, string(AppendInt(nil, i, base)))
+			v, err := ParseInt(s, base, 64)
+			assert.NoError(t, err)
+			assert.Equal(t, i, v)
+		}
+		// '-' is the digit 62 of base >= 63, so a negative value is ambiguous
+		assert.Panics(t, func() { FormatInt(-1, base) })
+		assert.Panics(t, func() { AppendInt(nil, math.MinInt64, base) })
+	}
+	assert.Equal(t, "-", FormatInt(62, 64))
+	assert.Equal(t, "5", FormatInt(5, 64))
+	v, err := ParseInt("5", 64, 64)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(5), v)
+
+	// a leading '-' or '+' is a digit if it is a digit of the base
+	v, err = ParseInt("-1", 63, 64)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(62*63+1), v)
+	v, err = ParseInt("+1", 63, 64)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), v)
+	v, err = ParseInt("+1", 94, 64)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(93*94+1), v)
+	_, err = ParseInt("0", 96, 64)
+	assert.Contains(t, err.Error(), "invalid base")
+
+	// '-' is read as a digit by ParseUint of base >= 63
+	u, err := ParseUint("-10", 63, 64)
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(62*63*63+63), u)
+	assert.Equal(t, "-10", FormatUint(u, 63))
+	_, err = ParseUint("-", 62, 64)
+	assert.Equal(t, strconv.ErrSyntax, err.(*strconv.NumError).Err)
+}
+
+func TestDigitTableSigned(t *testing.T) {
+	// a base-64 table without '-' and '+' has unambiguous signed values
+	tab := MustNewDigitTable("0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz._")
+	assert.Equal(t, "-1", tab.FormatInt(-1, 64))
+	assert.Equal(t, "-10", tab.FormatInt(-64, 64))
+	assert.Equal(t, "x-_", string(tab.AppendInt([]byte("x"), -63, 64)))
+	for _, i := range []int64{0, -1, 63, -63, -64, math.MinInt64, math.MaxInt64} {
+		v, err := tab.ParseInt(tab.FormatInt(i, 64), 64, 64)
+		assert.NoError(t, err)
+		assert.Equal(t, i, v)
+	}
+	v, err := tab.ParseInt("+_", 64, 64)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(63), v)
+	v, err = tab.ParseInt("-800000000000", 64, 64)
+	assert.Equal(t, strconv.ErrRange, err.(*strconv.NumError).Err)
+	assert.Equal(t, int64(math.MinInt64), v)
+	_, err = tab.ParseInt("-", 64, 64)
+	assert.Equal(t, strconv.ErrSyntax, err.(*strconv.NumError).Err)
+	assert.Equal(t, "ParseInt", err.(*strconv.NumError).Func)
+
+	// a table with '-' as a digit
+	tab = MustNewDigitTable("ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz0123456789-_")
+	assert.Equal(t, "B", tab.FormatInt(1, 64))
+	assert.Panics(t, func() { tab.FormatInt(-1, 64) })
+	assert.Equal(t, "-B", tab.FormatInt(-1, 62))
+	v, err = tab.ParseInt("-B", 62, 64)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(-1), v)
+	v, err = tab.ParseInt("-B", 64, 64)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(62*64+1), v)
+}
+
+func TestParseUintBase95(t *testing.T) {
+	// '+' is the digit 93 of base 95
+	u, err := ParseUint("+", 95, 64)
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(93), u)
+	_, err = ParseUint("1_0", 70, 64)
+	assert.NoError(t, err)
+	_, err = ParseUint("1 0", 90, 64)
+	assert.Equal(t, strconv.ErrSyntax, err.(*strconv.NumError).Err)
+	_, err = ParseUint("~", 65, 64)
+	assert.Equal(t, strconv.ErrSyntax, err.(*strconv.NumError).Err)
+	u, err = ParseUint("~~", 70, 8)
+	assert.Equal(t, strconv.ErrRange, err.(*strconv.NumError).Err)
+	assert.Equal(t, uint64(math.MaxUint8), u)
+	_, err = ParseUint("0", 96, 64)
+	assert.Equal(t, "ParseUint", err.(*strconv.NumError).Func)
+}
+
+func TestDigitTable(t *testing.T) {
+	_, err := NewDigitTable("0")
+	assert.Error(t, err)
+	_, err = NewDigitTable("0120")
+	assert.Error(t, err)
+
+	tab := MustNewDigitTable("ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz0123456789-_")
+	assert.Equal(t, "B", tab.FormatUint(1, 64))
+	assert.Equal(t, "BA", tab.FormatUint(64, 64))
+	assert.Equal(t, "xBA", string(tab.AppendUint([]byte("x"), 64, 64)))
+	u, err := tab.ParseUint("BA", 64, 64)
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(64), u)
+	// no case folding for a table not beginning with 0-9a-z
+	_, err = tab.ParseUint("b", 2, 64)
+	assert.Equal(t, strconv.ErrSyntax, err.(*strconv.NumError).Err)
+
+	// case folding like strconv for base <= 36
+	u, err = StdDigitTable.ParseUint("FF", 16, 64)
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(255), u)
+	u, err = StdDigitTable.ParseUint("FF", 42, 64)
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(41*42+41), u)
+	_, err = StdDigitTable.ParseUint("FF", 40, 64)
+	assert.Equal(t, strconv.ErrSyntax, err.(*strconv.NumError).Err)
+}
@@ -215,3 +215,86 @@ func Float32ToUint64Ptr(v float32) (*uint64, error) {
 	r, err := Float32ToUint64(v)
 	return &r, err
 }
+
+// Float32AlmostEqual reports whether a and b are equal within the absolute tolerance absTol
+// or the relative tolerance relTol, i.e. |a-b| <= max(absTol, relTol*max(|a|, |b|)).
+// NOTE:
+//
+//	NaN is not equal to any value, and an infinity is only equal to itself.
+func Float32AlmostEqual(a, b, absTol, relTol float32) bool {
+	if a == b {
+		return true
+	}
+	if a != a || b != b || math.IsInf(float64(a), 0) || math.IsInf(float64(b), 0) {
+		return false
+	}
+	diff := float32(math.Abs(float64(a - b)))
+	if diff <= absTol {
+		return true
+	}
+	return diff <= relTol*float32(math.Max(math.Abs(float64(a)), math.Abs(float64(b))))
+}
+
+// Float32ULPDistance returns the number of representable float32 values between a and b,
+// i.e. the distance in units in the last place.
+// NOTE:
+//
+//	+0 and -0 are at distance 0;
+//	if a or b is NaN, the maximum uint32 is returned.
+func Float32ULPDistance(a, b float32) uint32 {
+	if a == b {
+		return 0
+	}
+	if a != a || b != b {
+		return math.MaxUint32
+	}
+	x, y := orderedFloat32Bits(a), orderedFloat32Bits(b)
+	if x > y {
+		return x - y
+	}
+	return y - x
+}
+
+// orderedFloat32Bits maps the bits of f to an unsigned integer in the same order as the floats, with +0 and -0 merged.
+func orderedFloat32Bits(f float32) uint32 {
+	const signBit = 1 << (32 - 1)
+	bits := math.Float32bits(f)
+	if bits&signBit != 0 {
+		return signBit - bits&^signBit
+	}
+	return signBit + bits
+}
+
+// Float32EqualWith reports whether a and b are equal according to the policy.
+func Float32EqualWith(a, b float32, policy FloatPolicy) bool {
+	if a != a || b != b {
+		return policy.NaN.nanMatch(a != a, b != b)
+	}
+	if a != b {
+		return false
+	}
+	return a != 0 || policy.SignedZero == SignedZeroEqual || math.Signbit(float64(a)) == math.Signbit(float64(b))
+}
+
+// Float32CompareWith compares a and b in a total order, and returns -1 if a < b, 0 if a == b, or +1 if a > b.
+// NOTE:
+//
+//	NaN is less than any other value and equal to NaN, like sort.Float64s;
+//	-0 is less than +0 if policy.SignedZero is SignedZeroDistinct.
+func Float32CompareWith(a, b float32, policy FloatPolicy) int {
+	if c := compareFloat32(a, b); c != 0 || a != 0 || b != 0 || policy.SignedZero == SignedZeroEqual {
+		return c
+	}
+	return compareBool(!math.Signbit(float64(a)), !math.Signbit(float64(b)))
+}
+
+// float32PolicyKey returns the map key of v according to the policy, or false if v is not equal to any value.
+func float32PolicyKey(v float32, policy FloatPolicy) (uint32, bool) {
+	switch {
+	case v != v:
+		return math.MaxUint32, policy.NaN == NaNEqual
+	case v == 0 && policy.SignedZero == SignedZeroEqual:
+		return 0, true
+	}
+	return math.Float32bits(v), true
+}
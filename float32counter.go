@@ -0,0 +1,164 @@
+package ameda
+
+import "sort"
+
+// Float32Counter is a multiset that maps each float32 element to its count.
+// NOTE:
+//
+//	Only positive counts are kept.
+//	It has the same underlying type as the result of Float32sDistinct and Float32sIntersect,
+//	so they can be converted to each other directly.
+type Float32Counter map[float32]int
+
+// Float32CounterItem is an element with its count.
+type Float32CounterItem struct {
+	Element float32
+	Count   int
+}
+
+// NewFloat32Counter creates a counter that counts the elements.
+func NewFloat32Counter(elements ...float32) Float32Counter {
+	c := make(Float32Counter, len(elements))
+	c.Add(elements...)
+	return c
+}
+
+// Float32sDistinctCounter is like Float32sDistinct, but returns a counter.
+func Float32sDistinctCounter(f *[]float32, changeSlice bool) Float32Counter {
+	return Float32Counter(Float32sDistinct(f, changeSlice))
+}
+
+// Float32sIntersectCounter is like Float32sIntersect, but returns a counter, which is empty but not nil if there is no common element.
+func Float32sIntersectCounter(f ...[]float32) Float32Counter {
+	if c := Float32sIntersect(f...); c != nil {
+		return Float32Counter(c)
+	}
+	return make(Float32Counter)
+}
+
+// Add increases the count of each element by 1.
+func (c Float32Counter) Add(elements ...float32) {
+	for _, v := range elements {
+		c[v]++
+	}
+}
+
+// AddN increases the count of the element by n, and returns the new count.
+// NOTE:
+//
+//	If the count is no longer positive, the element is removed.
+func (c Float32Counter) AddN(element float32, n int) int {
+	n += c[element]
+	if n <= 0 {
+		delete(c, element)
+		return 0
+	}
+	c[element] = n
+	return n
+}
+
+// Subtract decreases the count of each element by 1.
+// NOTE:
+//
+//	If the count is no longer positive, the element is removed.
+func (c Float32Counter) Subtract(elements ...float32) {
+	for _, v := range elements {
+		c.AddN(v, -1)
+	}
+}
+
+// Get returns the count of the element.
+func (c Float32Counter) Get(element float32) int {
+	return c[element]
+}
+
+// Total returns the sum of all counts.
+func (c Float32Counter) Total() int {
+	var n int
+	for _, v := range c {
+		n += v
+	}
+	return n
+}
+
+// Clone creates a copy of the counter.
+func (c Float32Counter) Clone() Float32Counter {
+	r := make(Float32Counter, len(c))
+	for k, v := range c {
+		r[k] = v
+	}
+	return r
+}
+
+// Union returns a new counter with the maximum count of each element: c ∪ others...
+func (c Float32Counter) Union(others ...Float32Counter) Float32Counter {
+	r := c.Clone()
+	for _, o := range others {
+		for k, v := range o {
+			if v > r[k] {
+				r[k] = v
+			}
+		}
+	}
+	return r
+}
+
+// Intersect returns a new counter with the minimum count of each element: c ∩ others...
+func (c Float32Counter) Intersect(others ...Float32Counter) Float32Counter {
+	r := make(Float32Counter, len(c))
+L:
+	for k, v := range c {
+		for _, o := range others {
+			v2 := o[k]
+			if v2 <= 0 {
+				continue L
+			}
+			if v > v2 {
+				v = v2
+			}
+		}
+		r[k] = v
+	}
+	return r
+}
+
+// MostCommon returns the k most common elements from the most to the least common,
+// elements with equal counts are in ascending order.
+// NOTE:
+//
+//	If k < 0, returns all elements.
+func (c Float32Counter) MostCommon(k int) []Float32CounterItem {
+	items := make([]Float32CounterItem, 0, len(c))
+	for e, n := range c {
+		items = append(items, Float32CounterItem{Element: e, Count: n})
+	}
+	sort.Slice(items, func(i, j int) bool {
+		if items[i].Count != items[j].Count {
+			return items[i].Count > items[j].Count
+		}
+		return items[i].Element < items[j].Element
+	})
+	if k >= 0 && k < len(items) {
+		items = items[:k]
+	}
+	return items
+}
+
+// SortedElements returns the distinct elements in ascending order.
+func (c Float32Counter) SortedElements() []float32 {
+	r := make([]float32, 0, len(c))
+	for k := range c {
+		r = append(r, k)
+	}
+	sort.Slice(r, func(i, j int) bool { return r[i] < r[j] })
+	return r
+}
+
+// RangeSorted calls fn for each element in ascending order; if fn returns false, range stops the iteration.
+func (c Float32Counter) RangeSorted(fn func(element float32, count int) bool) {
+	for _, k := range c.SortedElements() {
+		if !fn(k, c[k]) {
+			return
+		}
+	}
+}
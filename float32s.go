@@ -1,5 +1,11 @@
 package ameda
 
+import (
+	"fmt"
+	"math"
+	"sort"
+)
+
 // OneFloat32 try to return the first element, otherwise return zero value.
 func OneFloat32(f []float32) float32 {
 	if len(f) > 0 {
@@ -274,6 +280,10 @@ func Float32sFind(f []float32, fn func(f []float32, k int, v float32) bool) (k i
 // @fromIndex
 //
 //	The index to start the search at. Defaults to 0.
+//
+// NOTE:
+//
+//	NaN can never be found, see Float32sIncludesWith for the NaN policy.
 func Float32sIncludes(f []float32, valueToFind float32, fromIndex ...int) bool {
 	return Float32sIndexOf(f, valueToFind, fromIndex...) > -1
 }
@@ -282,6 +292,10 @@ func Float32sIncludes(f []float32, valueToFind float32, fromIndex ...int) bool {
 // @fromIndex
 //
 //	The index to start the search at. Defaults to 0.
+//
+// NOTE:
+//
+//	NaN can never be found, see Float32sIndexOfWith for the NaN policy.
 func Float32sIndexOf(f []float32, searchElement float32, fromIndex ...int) int {
 	idx := getFromIndex(len(f), fromIndex...)
 	for k, v := range f[idx:] {
@@ -296,6 +310,10 @@ func Float32sIndexOf(f []float32, searchElement float32, fromIndex ...int) int {
 // @fromIndex
 //
 //	The index to start the search at. Defaults to 0.
+//
+// NOTE:
+//
+//	NaN can never be found, see Float32sLastIndexOfWith for the NaN policy.
 func Float32sLastIndexOf(f []float32, searchElement float32, fromIndex ...int) int {
 	idx := getFromIndex(len(f), fromIndex...)
 	for k := len(f) - 1; k >= idx; k-- {
@@ -469,26 +487,20 @@ func Float32sSplice(f *[]float32, start, deleteCount int, items ...float32) {
 	if deleteCount < 0 {
 		deleteCount = 0
 	}
-	start, end, _ := fixRange(len(a), start, start+1+deleteCount)
-	deleteCount = end - start - 1
-	for k := 0; k < len(items); k++ {
-		if deleteCount > 0 {
-			// replace
-			a[start] = items[k]
-			deleteCount--
-			start++
-		} else {
-			// insert
-			lastSlice := Float32sCopy(a[start:])
-			items = items[k:]
-			a = append(a[:start], items...)
-			a = append(a[:start+len(items)], lastSlice...)
-			*f = a[:len(a):len(a)]
-			return
-		}
+	start = fixIndex(len(a), start, true)
+	if rest := len(a) - start; deleteCount > rest {
+		deleteCount = rest
 	}
-	if deleteCount > 0 {
-		a = append(a[:start], a[start+1+deleteCount:]...)
+	// replace
+	n := copy(a[start:start+deleteCount], items)
+	if n == len(items) {
+		// delete the rest
+		a = append(a[:start+n], a[start+deleteCount:]...)
+	} else {
+		// insert the rest
+		lastSlice := Float32sCopy(a[start+deleteCount:])
+		a = append(a[:start+deleteCount], items[n:]...)
+		a = append(a, lastSlice...)
 	}
 	*f = a[:len(a):len(a)]
 }
@@ -570,8 +582,27 @@ func Float32sRemoveEvery(p *[]float32, elements ...float32) int {
 	return n
 }
 
+// Float32sConcat is used to merge two or more slices.
+// This method does not change the existing slices, but instead returns a new slice.
+func Float32sConcat(f ...[]float32) []float32 {
+	var totalLen int
+	for _, v := range f {
+		totalLen += len(v)
+	}
+	ret := make([]float32, totalLen)
+	dst := ret
+	for _, v := range f {
+		n := copy(dst, v)
+		dst = dst[n:]
+	}
+	return ret
+}
+
 // Float32sIntersect calculates intersection of two or more slices,
 // and returns the count of each element.
+// NOTE:
+//
+//	Each NaN is a separate map key and +0 is merged with -0, see Float32sIntersectWith for the NaN and signed-zero policy.
 func Float32sIntersect(f ...[]float32) (intersectCount map[float32]int) {
 	if len(f) == 0 {
 		return nil
@@ -605,6 +636,9 @@ L:
 
 // Float32sDistinct calculates the count of each different element,
 // and only saves these different elements in place if changeSlice is true.
+// NOTE:
+//
+//	Each NaN is a separate map key and +0 is merged with -0, see Float32sDistinctWith for the NaN and signed-zero policy.
 func Float32sDistinct(f *[]float32, changeSlice bool) (distinctCount map[float32]int) {
 	if !changeSlice {
 		return float32sDistinct(*f, nil)
@@ -701,3 +735,769 @@ func Float32SetDifference(set1, set2 []float32, others ...[]float32) []float32 {
 	}
 	return r
 }
+
+// Float32sIsSorted reports whether the slice is sorted in ascending order.
+func Float32sIsSorted(f []float32) bool {
+	for k := 1; k < len(f); k++ {
+		if f[k] < f[k-1] {
+			return false
+		}
+	}
+	return true
+}
+
+// Float32SortedSetUnion calculates between multiple sorted collections: set1 ∪ set2 ∪ others...
+// NOTE:
+//
+//	Every set must be sorted in ascending order (see Float32sIsSorted), and the result is sorted too.
+//	It merges the sets in O(n+m) without building any map.
+//	This method does not change the existing slices, but instead returns a new slice.
+func Float32SortedSetUnion(set1, set2 []float32, others ...[]float32) []float32 {
+	r := float32SortedSetUnion(set1, set2)
+	for _, set := range others {
+		r = float32SortedSetUnion(r, set)
+	}
+	return r
+}
+
+// Float32SortedSetIntersect calculates between multiple sorted collections: set1 ∩ set2 ∩ others...
+// NOTE:
+//
+//	Every set must be sorted in ascending order (see Float32sIsSorted), and the result is sorted too.
+//	It merges the sets in O(n+m) without building any map.
+//	This method does not change the existing slices, but instead returns a new slice.
+func Float32SortedSetIntersect(set1, set2 []float32, others ...[]float32) []float32 {
+	r := float32SortedSetIntersect(set1, set2)
+	for _, set := range others {
+		r = float32SortedSetIntersect(r, set)
+	}
+	return r
+}
+
+// Float32SortedSetDifference calculates between multiple sorted collections: set1 - set2 - others...
+// NOTE:
+//
+//	Every set must be sorted in ascending order (see Float32sIsSorted), and the result is sorted too.
+//	It merges the sets in O(n+m) without building any map.
+//	This method does not change the existing slices, but instead returns a new slice.
+func Float32SortedSetDifference(set1, set2 []float32, others ...[]float32) []float32 {
+	r := float32SortedSetDifference(set1, set2)
+	for _, set := range others {
+		r = float32SortedSetDifference(r, set)
+	}
+	return r
+}
+
+// Float32SortedSetSymmetricDifference calculates between multiple sorted collections: set1 ∆ set2 ∆ others...
+// NOTE:
+//
+//	Every set must be sorted in ascending order (see Float32sIsSorted), and the result is sorted too.
+//	It merges the sets in O(n+m) without building any map.
+//	This method does not change the existing slices, but instead returns a new slice.
+func Float32SortedSetSymmetricDifference(set1, set2 []float32, others ...[]float32) []float32 {
+	r := float32SortedSetSymmetricDifference(set1, set2)
+	for _, set := range others {
+		r = float32SortedSetSymmetricDifference(r, set)
+	}
+	return r
+}
+
+// Float32SortedSetIsSubset reports whether every element of sub is also in set: sub ⊆ set.
+// NOTE:
+//
+//	Both sets must be sorted in ascending order (see Float32sIsSorted).
+func Float32SortedSetIsSubset(sub, set []float32) bool {
+	j := 0
+	for k := 0; k < len(sub); {
+		if j >= len(set) || sub[k] < set[j] {
+			return false
+		}
+		if set[j] < sub[k] {
+			j++
+			continue
+		}
+		k++
+	}
+	return true
+}
+
+func float32SortedSetUnion(a, b []float32) []float32 {
+	r := make([]float32, 0, len(a)+len(b))
+	i, j := 0, 0
+	for i < len(a) || j < len(b) {
+		var v float32
+		switch {
+		case j >= len(b) || i < len(a) && a[i] < b[j]:
+			v = a[i]
+			i++
+		case i >= len(a) || b[j] < a[i]:
+			v = b[j]
+			j++
+		default:
+			v = a[i]
+			i++
+			j++
+		}
+		if n := len(r); n == 0 || r[n-1] != v {
+			r = append(r, v)
+		}
+	}
+	return r
+}
+
+func float32SortedSetIntersect(a, b []float32) []float32 {
+	n := len(a)
+	if len(b) < n {
+		n = len(b)
+	}
+	r := make([]float32, 0, n)
+	i, j := 0, 0
+	for i < len(a) && j < len(b) {
+		switch {
+		case a[i] < b[j]:
+			i++
+		case b[j] < a[i]:
+			j++
+		default:
+			v := a[i]
+			if n := len(r); n == 0 || r[n-1] != v {
+				r = append(r, v)
+			}
+			i++
+			j++
+		}
+	}
+	return r
+}
+
+func float32SortedSetDifference(a, b []float32) []float32 {
+	r := make([]float32, 0, len(a))
+	j := 0
+	for _, v := range a {
+		for j < len(b) && b[j] < v {
+			j++
+		}
+		if j < len(b) && b[j] == v {
+			continue
+		}
+		if n := len(r); n == 0 || r[n-1] != v {
+			r = append(r, v)
+		}
+	}
+	return r
+}
+
+func float32SortedSetSymmetricDifference(a, b []float32) []float32 {
+	r := make([]float32, 0, len(a)+len(b))
+	i, j := 0, 0
+	for i < len(a) || j < len(b) {
+		var v float32
+		switch {
+		case j >= len(b) || i < len(a) && a[i] < b[j]:
+			v = a[i]
+			i++
+		case i >= len(a) || b[j] < a[i]:
+			v = b[j]
+			j++
+		default:
+			v = a[i]
+			for i < len(a) && a[i] == v {
+				i++
+			}
+			for j < len(b) && b[j] == v {
+				j++
+			}
+			continue
+		}
+		if n := len(r); n == 0 || r[n-1] != v {
+			r = append(r, v)
+		}
+	}
+	return r
+}
+
+// Float32sEdit is an edit run of the edit script produced by Float32sDiff.
+type Float32sEdit struct {
+	Kind   DiffKind
+	Values []float32
+}
+
+// Float32sDiff computes the shortest edit script that turns slice a into slice b, see also DiffFunc.
+// This method does not change the existing slices, but instead returns the runs of edits.
+// NOTE:
+//
+//	The elements are compared bitwise, so NaN equals NaN with the same bits, and -0 does not equal +0.
+func Float32sDiff(a, b []float32) []Float32sEdit {
+	spans := DiffFunc(len(a), len(b), func(i, j int) bool { return math.Float32bits(a[i]) == math.Float32bits(b[j]) })
+	r := make([]Float32sEdit, len(spans))
+	for k, span := range spans {
+		r[k].Kind = span.Kind
+		if span.Kind == DiffInsert {
+			r[k].Values = Float32sCopy(b[span.B : span.B+span.Len])
+		} else {
+			r[k].Values = Float32sCopy(a[span.A : span.A+span.Len])
+		}
+	}
+	return r
+}
+
+// Float32sApplyPatch changes the contents of an slice in place by applying the edit script produced by Float32sDiff.
+// NOTE:
+//
+//	If the slice does not match the equal and delete runs of the patch, it returns an error
+//	and the slice will not be modified.
+func Float32sApplyPatch(p *[]float32, patch []Float32sEdit) error {
+	a := *p
+	r := make([]float32, 0, len(a))
+	pos := 0
+	for _, e := range patch {
+		switch e.Kind {
+		case DiffInsert:
+			r = append(r, e.Values...)
+		case DiffEqual, DiffDelete:
+			if pos+len(e.Values) > len(a) {
+				return fmt.Errorf("patch mismatch: %s run exceeds the slice length %d", e.Kind, len(a))
+			}
+			for k, v := range e.Values {
+				if math.Float32bits(a[pos+k]) != math.Float32bits(v) {
+					return fmt.Errorf("patch mismatch: %s run at index %d", e.Kind, pos+k)
+				}
+			}
+			if e.Kind == DiffEqual {
+				r = append(r, e.Values...)
+			}
+			pos += len(e.Values)
+		default:
+			return fmt.Errorf("patch mismatch: unknown edit kind %d", e.Kind)
+		}
+	}
+	if pos != len(a) {
+		return fmt.Errorf("patch mismatch: the slice has %d unpatched elements", len(a)-pos)
+	}
+	n := len(r)
+	*p = r[:n:n]
+	return nil
+}
+
+// Float32sShuffle randomizes the order of elements in place.
+// @r
+//
+//	The source of random numbers, nil means the global source of package math/rand.
+func Float32sShuffle(f []float32, r Randomizer) {
+	shuffleIndex(len(f), len(f), r, func(x, y int) { f[x], f[y] = f[y], f[x] })
+}
+
+// Float32sSample returns k elements randomly selected from the slice without replacement.
+// If k is greater than the length of the slice, all the elements are returned in random order.
+// This method does not change the existing slice, but instead returns a new slice.
+// @r
+//
+//	The source of random numbers, nil means the global source of package math/rand.
+func Float32sSample(f []float32, k int, r Randomizer) []float32 {
+	if k <= 0 {
+		return []float32{}
+	}
+	a := Float32sCopy(f)
+	shuffleIndex(len(a), k, r, func(x, y int) { a[x], a[y] = a[y], a[x] })
+	if k > len(a) {
+		k = len(a)
+	}
+	return a[:k:k]
+}
+
+// Float32sSampleWithReplacement returns k elements randomly selected from the slice with replacement.
+// This method does not change the existing slice, but instead returns a new slice.
+// @r
+//
+//	The source of random numbers, nil means the global source of package math/rand.
+func Float32sSampleWithReplacement(f []float32, k int, r Randomizer) []float32 {
+	if k <= 0 || len(f) == 0 {
+		return []float32{}
+	}
+	r = getRandomizer(r)
+	ret := make([]float32, k)
+	for n := range ret {
+		ret[n] = f[r.Intn(len(f))]
+	}
+	return ret
+}
+
+// Float32sWeightedChoice returns the key-value of an element randomly selected
+// with the probability proportional to its weight.
+// @weights
+//
+//	The weights of the elements with the same indexes, must be finite and non-negative.
+//
+// @r
+//
+//	The source of random numbers, nil means the global source of package math/rand.
+//
+// NOTE:
+//
+//	If the slice is empty, or the weights are invalid, returns an error and k = -1
+func Float32sWeightedChoice(f []float32, weights []float64, r Randomizer) (k int, v float32, err error) {
+	k, err = weightedIndex(len(f), weights, r)
+	if err != nil {
+		return k, v, err
+	}
+	return k, f[k], nil
+}
+
+// Float32sReservoirSample returns k elements randomly selected from a stream of unknown length,
+// in a single pass and with O(k) memory.
+// @next
+//
+//	Returns the next element of the stream, or ok = false at the end of the stream.
+//
+// @r
+//
+//	The source of random numbers, nil means the global source of package math/rand.
+func Float32sReservoirSample(next func() (v float32, ok bool), k int, r Randomizer) []float32 {
+	if k <= 0 {
+		return []float32{}
+	}
+	r = getRandomizer(r)
+	ret := make([]float32, 0, k)
+	for seen := 0; ; seen++ {
+		v, ok := next()
+		if !ok {
+			return ret
+		}
+		switch slot := reservoirIndex(seen, k, r); {
+		case slot == len(ret):
+			ret = append(ret, v)
+		case slot >= 0:
+			ret[slot] = v
+		}
+	}
+}
+
+// Float32sAt returns the element at the given index.
+// @index
+//
+//	Zero-based index of the element. If negative, index will be counted from the end.
+//
+// NOTE:
+//
+//	If the index is out of range, ok = false
+func Float32sAt(f []float32, index int) (v float32, ok bool) {
+	index, ok = atIndex(len(f), index)
+	if !ok {
+		return v, false
+	}
+	return f[index], true
+}
+
+// Float32sWith returns a copy of the slice with the element at the given index replaced with the value.
+// @index
+//
+//	Zero-based index of the element. If negative, index will be counted from the end.
+//
+// NOTE:
+//
+//	If the index is out of range, ok = false
+func Float32sWith(f []float32, index int, value float32) (ret []float32, ok bool) {
+	ret = Float32sCopy(f)
+	index, ok = atIndex(len(f), index)
+	if ok {
+		ret[index] = value
+	}
+	return ret, ok
+}
+
+// Float32sFindIndex returns the index of the first element in the provided slice that satisfies the provided testing function.
+// NOTE:
+//
+//	If not found, returns -1
+func Float32sFindIndex(f []float32, fn func(f []float32, k int, v float32) bool) int {
+	k, _ := Float32sFind(f, fn)
+	return k
+}
+
+// Float32sFindLast returns the key-value of the last element in the provided slice that satisfies the provided testing function.
+// NOTE:
+//
+//	If not found, k = -1
+func Float32sFindLast(f []float32, fn func(f []float32, k int, v float32) bool) (k int, v float32) {
+	for k := len(f) - 1; k >= 0; k-- {
+		if fn(f, k, f[k]) {
+			return k, f[k]
+		}
+	}
+	return -1, v
+}
+
+// Float32sFindLastIndex returns the index of the last element in the provided slice that satisfies the provided testing function.
+// NOTE:
+//
+//	If not found, returns -1
+func Float32sFindLastIndex(f []float32, fn func(f []float32, k int, v float32) bool) int {
+	k, _ := Float32sFindLast(f, fn)
+	return k
+}
+
+// Float32sFlat creates a new slice with all sub-slice elements concatenated into it.
+func Float32sFlat(f [][]float32) []float32 {
+	return Float32sConcat(f...)
+}
+
+// Float32sFlatMap creates a new slice populated with the results of calling a provided function
+// on every element in the calling slice, and then flattening the results.
+func Float32sFlatMap(f []float32, fn func(f []float32, k int, v float32) []float32) []float32 {
+	ret := make([]float32, 0, len(f))
+	for k, v := range f {
+		ret = append(ret, fn(f, k, v)...)
+	}
+	return ret
+}
+
+// Float32sToSorted returns a copy of the slice sorted stably.
+// @less
+//
+//	Reports whether the element a must sort before the element b. If nil, sorts in ascending order.
+func Float32sToSorted(f []float32, less func(a, b float32) bool) []float32 {
+	ret := Float32sCopy(f)
+	if less == nil {
+		less = func(a, b float32) bool { return a < b }
+	}
+	sort.SliceStable(ret, func(x, y int) bool { return less(ret[x], ret[y]) })
+	return ret
+}
+
+// Float32sToReversed returns a copy of the slice in reversed order.
+func Float32sToReversed(f []float32) []float32 {
+	ret := Float32sCopy(f)
+	Float32sReverse(ret)
+	return ret
+}
+
+// Float32sToSpliced returns a copy of the slice with some elements removed and/or replaced at a given index,
+// see also Float32sSplice.
+func Float32sToSpliced(f []float32, start, deleteCount int, items ...float32) []float32 {
+	ret := Float32sCopy(f)
+	Float32sSplice(&ret, start, deleteCount, items...)
+	return ret
+}
+
+// Float32sKeys returns an iterator of the indexes of the slice.
+// NOTE:
+//
+//	The iterator returns ok = false when it is exhausted.
+func Float32sKeys(f []float32) (next func() (k int, ok bool)) {
+	k := -1
+	return func() (int, bool) {
+		if k+1 >= len(f) {
+			return -1, false
+		}
+		k++
+		return k, true
+	}
+}
+
+// Float32sValues returns an iterator of the elements of the slice.
+// NOTE:
+//
+//	The iterator returns ok = false when it is exhausted.
+func Float32sValues(f []float32) (next func() (v float32, ok bool)) {
+	k := -1
+	return func() (v float32, ok bool) {
+		if k+1 >= len(f) {
+			return v, false
+		}
+		k++
+		return f[k], true
+	}
+}
+
+// Float32sEntries returns an iterator of the key-value pairs of the slice.
+// NOTE:
+//
+//	The iterator returns ok = false when it is exhausted.
+func Float32sEntries(f []float32) (next func() (k int, v float32, ok bool)) {
+	k := -1
+	return func() (int, float32, bool) {
+		if k+1 >= len(f) {
+			var v float32
+			return -1, v, false
+		}
+		k++
+		return k, f[k], true
+	}
+}
+
+// Float32sGroup groups the elements of the slice according to the string keys returned by the provided function.
+func Float32sGroup(f []float32, fn func(f []float32, k int, v float32) string) map[string][]float32 {
+	ret := make(map[string][]float32)
+	for k, v := range f {
+		key := fn(f, k, v)
+		ret[key] = append(ret[key], v)
+	}
+	return ret
+}
+
+// Float32sGroupToMap groups the elements of the slice according to the keys returned by the provided function.
+// NOTE:
+//
+//	The keys must be comparable.
+func Float32sGroupToMap(f []float32, fn func(f []float32, k int, v float32) interface{}) map[interface{}][]float32 {
+	ret := make(map[interface{}][]float32)
+	for k, v := range f {
+		key := fn(f, k, v)
+		ret[key] = append(ret[key], v)
+	}
+	return ret
+}
+
+// Float32sEqual reports whether a and b have the same length and the same elements in the same order.
+// NOTE:
+//
+//	The elements are compared by ==, so NaN is not equal to NaN;
+//	see Float32sAlmostEqual and Float32sEqualULP for the explicit NaN policy.
+func Float32sEqual(a, b []float32) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for k, v := range a {
+		if v != b[k] {
+			return false
+		}
+	}
+	return true
+}
+
+// Float32sCompare compares a and b lexicographically, and returns -1 if a < b, 0 if a == b, or +1 if a > b.
+// NOTE:
+//
+//	A slice that is a prefix of another slice is less than it.
+//	NaN is less than any other value and equal to NaN, like sort.Float64s.
+func Float32sCompare(a, b []float32) int {
+	for k := 0; k < len(a) && k < len(b); k++ {
+		if c := compareFloat32(a[k], b[k]); c != 0 {
+			return c
+		}
+	}
+	return compareInt(len(a), len(b))
+}
+
+// Float32sHasPrefix reports whether the slice f begins with prefix.
+func Float32sHasPrefix(f, prefix []float32) bool {
+	return len(f) >= len(prefix) && Float32sEqual(f[:len(prefix)], prefix)
+}
+
+// Float32sHasSuffix reports whether the slice f ends with suffix.
+func Float32sHasSuffix(f, suffix []float32) bool {
+	return len(f) >= len(suffix) && Float32sEqual(f[len(f)-len(suffix):], suffix)
+}
+
+func compareFloat32(a, b float32) int {
+	aNaN, bNaN := a != a, b != b
+	switch {
+	case aNaN || bNaN:
+		return compareBool(!aNaN, !bNaN)
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+	return 0
+}
+
+// Float32sAlmostEqual reports whether a and b have the same length, and each pair of elements
+// is equal within the tolerances (see Float32AlmostEqual).
+// @nan
+//
+//	How NaN elements are regarded.
+func Float32sAlmostEqual(a, b []float32, absTol, relTol float32, nan NaNPolicy) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for k, v := range a {
+		if v != v || b[k] != b[k] {
+			if !nan.nanMatch(v != v, b[k] != b[k]) {
+				return false
+			}
+			continue
+		}
+		if !Float32AlmostEqual(v, b[k], absTol, relTol) {
+			return false
+		}
+	}
+	return true
+}
+
+// Float32sEqualULP reports whether a and b have the same length, and each pair of elements
+// is at most maxULP units in the last place apart (see Float32ULPDistance).
+// @nan
+//
+//	How NaN elements are regarded.
+func Float32sEqualULP(a, b []float32, maxULP uint32, nan NaNPolicy) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for k, v := range a {
+		if v != v || b[k] != b[k] {
+			if !nan.nanMatch(v != v, b[k] != b[k]) {
+				return false
+			}
+			continue
+		}
+		if Float32ULPDistance(v, b[k]) > maxULP {
+			return false
+		}
+	}
+	return true
+}
+
+// Float32sIncludesWith determines whether the slice includes a certain element according to the policy.
+func Float32sIncludesWith(f []float32, valueToFind float32, policy FloatPolicy, fromIndex ...int) bool {
+	return Float32sIndexOfWith(f, valueToFind, policy, fromIndex...) > -1
+}
+
+// Float32sIndexOfWith returns the first index at which a given element can be found according to the policy,
+// or -1 if it is not present.
+func Float32sIndexOfWith(f []float32, searchElement float32, policy FloatPolicy, fromIndex ...int) int {
+	idx := getFromIndex(len(f), fromIndex...)
+	for k, v := range f[idx:] {
+		if Float32EqualWith(searchElement, v, policy) {
+			return k + idx
+		}
+	}
+	return -1
+}
+
+// Float32sLastIndexOfWith returns the last index at which a given element can be found according to the policy,
+// or -1 if it is not present.
+func Float32sLastIndexOfWith(f []float32, searchElement float32, policy FloatPolicy, fromIndex ...int) int {
+	idx := getFromIndex(len(f), fromIndex...)
+	for k := len(f) - 1; k >= idx; k-- {
+		if Float32EqualWith(searchElement, f[k], policy) {
+			return k
+		}
+	}
+	return -1
+}
+
+// Float32sDistinctWith calculates the different elements according to the policy in the order of their first appearance,
+// and the count of each of them.
+// NOTE:
+//
+//	The different elements are saved in place if changeSlice is true, otherwise a new slice is returned.
+//	Each NaN is a different element unless policy.NaN is NaNEqual.
+func Float32sDistinctWith(f *[]float32, changeSlice bool, policy FloatPolicy) (distinct []float32, counts []int) {
+	src := *f
+	if changeSlice {
+		distinct = src[:0]
+	} else {
+		distinct = make([]float32, 0, len(src))
+	}
+	index := make(map[uint32]int, len(src))
+	for _, v := range src {
+		key, ok := float32PolicyKey(v, policy)
+		if ok {
+			if k, found := index[key]; found {
+				counts[k]++
+				continue
+			}
+			index[key] = len(distinct)
+		}
+		distinct = append(distinct, v)
+		counts = append(counts, 1)
+	}
+	if changeSlice {
+		n := len(distinct)
+		*f = distinct[:n:n]
+	}
+	return distinct, counts
+}
+
+// Float32sIntersectWith calculates intersection of two or more slices according to the policy,
+// and returns the common elements in the order of the first slice, and the minimum count of each of them.
+func Float32sIntersectWith(policy FloatPolicy, f ...[]float32) (elements []float32, counts []int) {
+	if len(f) == 0 {
+		return nil, nil
+	}
+	elements, counts = Float32sDistinctWith(&f[0], false, policy)
+	for _, other := range f[1:] {
+		otherCounts := float32PolicyCounts(other, policy)
+		n := 0
+		for k, v := range elements {
+			key, ok := float32PolicyKey(v, policy)
+			if !ok || otherCounts[key] == 0 {
+				continue
+			}
+			elements[n] = v
+			counts[n] = minInt(counts[k], otherCounts[key])
+			n++
+		}
+		elements, counts = elements[:n], counts[:n]
+	}
+	return elements, counts
+}
+
+// Float32SetUnionWith calculates between multiple collections according to the policy: set1 ∪ set2 ∪ others...
+// This method does not change the existing slices, but instead returns a new slice.
+func Float32SetUnionWith(policy FloatPolicy, set1, set2 []float32, others ...[]float32) []float32 {
+	all := Float32sConcat(append([][]float32{set1, set2}, others...)...)
+	r, _ := Float32sDistinctWith(&all, true, policy)
+	return r
+}
+
+// Float32SetIntersectWith calculates between multiple collections according to the policy: set1 ∩ set2 ∩ others...
+// This method does not change the existing slices, but instead returns a new slice.
+func Float32SetIntersectWith(policy FloatPolicy, set1, set2 []float32, others ...[]float32) []float32 {
+	r, _ := Float32sIntersectWith(policy, append([][]float32{set1, set2}, others...)...)
+	return r
+}
+
+// Float32SetDifferenceWith calculates between multiple collections according to the policy: set1 - set2 - others...
+// This method does not change the existing slices, but instead returns a new slice.
+func Float32SetDifferenceWith(policy FloatPolicy, set1, set2 []float32, others ...[]float32) []float32 {
+	m := make(map[uint32]struct{})
+	for _, set := range append([][]float32{set2}, others...) {
+		for _, v := range set {
+			if key, ok := float32PolicyKey(v, policy); ok {
+				m[key] = struct{}{}
+			}
+		}
+	}
+	r, _ := Float32sDistinctWith(&set1, false, policy)
+	n := 0
+	for _, v := range r {
+		if key, ok := float32PolicyKey(v, policy); ok {
+			if _, found := m[key]; found {
+				continue
+			}
+		}
+		r[n] = v
+		n++
+	}
+	return r[:n]
+}
+
+// Float32sIsSortedWith reports whether the slice is sorted in ascending order of Float32CompareWith.
+func Float32sIsSortedWith(f []float32, policy FloatPolicy) bool {
+	for k := 1; k < len(f); k++ {
+		if Float32CompareWith(f[k], f[k-1], policy) < 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// Float32sToSortedWith returns a copy of the slice sorted stably in ascending order of Float32CompareWith,
+// so NaN elements come first.
+func Float32sToSortedWith(f []float32, policy FloatPolicy) []float32 {
+	ret := Float32sCopy(f)
+	sort.SliceStable(ret, func(x, y int) bool { return Float32CompareWith(ret[x], ret[y], policy) < 0 })
+	return ret
+}
+
+func float32PolicyCounts(f []float32, policy FloatPolicy) map[uint32]int {
+	m := make(map[uint32]int, len(f))
+	for _, v := range f {
+		if key, ok := float32PolicyKey(v, policy); ok {
+			m[key]++
+		}
+	}
+	return m
+}
@@ -0,0 +1,231 @@
+package ameda
+
+import "sort"
+
+// Float32Set is a set of float32 elements that remembers the insertion order.
+// NOTE:
+//
+//	The zero value is an empty set ready to use.
+//	All NaNs are the same element, so the set holds at most one NaN, and +0 is the same element as -0.
+//	It is not safe for concurrent use.
+type Float32Set struct {
+	index   map[uint32]int // setKey(element) -> position in elems
+	elems   []float32
+	removed []bool
+	holes   int
+}
+
+// NewFloat32Set creates a set from the elements, see also Float32Set.Slice.
+func NewFloat32Set(elements ...float32) *Float32Set {
+	s := &Float32Set{
+		index: make(map[uint32]int, len(elements)),
+		elems: make([]float32, 0, len(elements)),
+	}
+	s.Add(elements...)
+	return s
+}
+
+// Add adds the elements that are not yet in the set, and returns the new length of the set.
+func (s *Float32Set) Add(elements ...float32) int {
+	if s.index == nil {
+		s.index = make(map[uint32]int, len(elements))
+	}
+	for _, v := range elements {
+		key := float32SetKey(v)
+		if _, ok := s.index[key]; ok {
+			continue
+		}
+		s.index[key] = len(s.elems)
+		s.elems = append(s.elems, v)
+		if s.removed != nil {
+			s.removed = append(s.removed, false)
+		}
+	}
+	return s.Len()
+}
+
+// Remove removes the elements from the set, and returns the new length of the set.
+func (s *Float32Set) Remove(elements ...float32) int {
+	for _, v := range elements {
+		key := float32SetKey(v)
+		k, ok := s.index[key]
+		if !ok {
+			continue
+		}
+		delete(s.index, key)
+		if s.removed == nil {
+			s.removed = make([]bool, len(s.elems))
+		}
+		s.removed[k] = true
+		s.holes++
+	}
+	if s.holes > 0 && s.holes*2 >= len(s.elems) {
+		s.compact()
+	}
+	return s.Len()
+}
+
+// Has reports whether the element is in the set.
+func (s *Float32Set) Has(element float32) bool {
+	_, ok := s.index[float32SetKey(element)]
+	return ok
+}
+
+// Len returns the number of elements in the set.
+func (s *Float32Set) Len() int {
+	return len(s.index)
+}
+
+// Clear removes all elements from the set.
+func (s *Float32Set) Clear() {
+	*s = Float32Set{}
+}
+
+// Clone creates a copy of the set.
+func (s *Float32Set) Clone() *Float32Set {
+	r := &Float32Set{
+		index: make(map[uint32]int, s.Len()),
+		elems: make([]float32, 0, s.Len()),
+	}
+	s.Range(func(v float32) bool {
+		r.index[float32SetKey(v)] = len(r.elems)
+		r.elems = append(r.elems, v)
+		return true
+	})
+	return r
+}
+
+// Range calls fn for each element in insertion order; if fn returns false, range stops the iteration.
+func (s *Float32Set) Range(fn func(v float32) bool) {
+	for k, v := range s.elems {
+		if s.removed != nil && s.removed[k] {
+			continue
+		}
+		if !fn(v) {
+			return
+		}
+	}
+}
+
+// RangeSorted calls fn for each element in ascending order; if fn returns false, range stops the iteration.
+func (s *Float32Set) RangeSorted(fn func(v float32) bool) {
+	for _, v := range s.SortedSlice() {
+		if !fn(v) {
+			return
+		}
+	}
+}
+
+// Slice returns the elements in insertion order.
+func (s *Float32Set) Slice() []float32 {
+	r := make([]float32, 0, s.Len())
+	s.Range(func(v float32) bool {
+		r = append(r, v)
+		return true
+	})
+	return r
+}
+
+// SortedSlice returns the elements in ascending order, NaN first.
+func (s *Float32Set) SortedSlice() []float32 {
+	r := s.Slice()
+	sort.Slice(r, func(i, j int) bool { return compareFloat32(r[i], r[j]) < 0 })
+	return r
+}
+
+// Union returns a new set: s ∪ others...
+func (s *Float32Set) Union(others ...*Float32Set) *Float32Set {
+	r := s.Clone()
+	for _, o := range others {
+		o.Range(func(v float32) bool {
+			r.Add(v)
+			return true
+		})
+	}
+	return r
+}
+
+// Intersect returns a new set: s ∩ others...
+func (s *Float32Set) Intersect(others ...*Float32Set) *Float32Set {
+	r := NewFloat32Set()
+	s.Range(func(v float32) bool {
+		for _, o := range others {
+			if !o.Has(v) {
+				return true
+			}
+		}
+		r.Add(v)
+		return true
+	})
+	return r
+}
+
+// Difference returns a new set: s - others...
+func (s *Float32Set) Difference(others ...*Float32Set) *Float32Set {
+	r := NewFloat32Set()
+	s.Range(func(v float32) bool {
+		for _, o := range others {
+			if o.Has(v) {
+				return true
+			}
+		}
+		r.Add(v)
+		return true
+	})
+	return r
+}
+
+// SymmetricDifference returns a new set of the elements that are in exactly one of the two sets: s ∆ other.
+func (s *Float32Set) SymmetricDifference(other *Float32Set) *Float32Set {
+	r := s.Difference(other)
+	other.Range(func(v float32) bool {
+		if !s.Has(v) {
+			r.Add(v)
+		}
+		return true
+	})
+	return r
+}
+
+// IsSubset reports whether every element of s is also in other: s ⊆ other.
+func (s *Float32Set) IsSubset(other *Float32Set) bool {
+	if s.Len() > other.Len() {
+		return false
+	}
+	ok := true
+	s.Range(func(v float32) bool {
+		ok = other.Has(v)
+		return ok
+	})
+	return ok
+}
+
+// IsSuperset reports whether every element of other is also in s: s ⊇ other.
+func (s *Float32Set) IsSuperset(other *Float32Set) bool {
+	return other.IsSubset(s)
+}
+
+// Equal reports whether the two sets contain the same elements, regardless of the order.
+func (s *Float32Set) Equal(other *Float32Set) bool {
+	return s.Len() == other.Len() && s.IsSubset(other)
+}
+
+func (s *Float32Set) compact() {
+	elems := s.elems[:0]
+	for k, v := range s.elems {
+		if s.removed[k] {
+			continue
+		}
+		s.index[float32SetKey(v)] = len(elems)
+		elems = append(elems, v)
+	}
+	s.elems = elems
+	s.removed = nil
+	s.holes = 0
+}
+
+// float32SetKey returns the index key of v, which is the same for all NaNs and for ±0.
+func float32SetKey(v float32) uint32 {
+	key, _ := float32PolicyKey(v, FloatPolicy{NaN: NaNEqual})
+	return key
+}
@@ -0,0 +1,373 @@
+package ameda
+
+import (
+	"sync"
+	"sync/atomic"
+)
+
+// SyncFloat32s is a float32 slice that is safe for concurrent use.
+// NOTE:
+//
+//	The zero value is an empty slice ready to use.
+//	Point reads such as Len and At use the read lock without copying the elements.
+//	Callbacks are run on a snapshot, so they can call the methods of the same SyncFloat32s.
+type SyncFloat32s struct {
+	mu   sync.RWMutex
+	data []float32
+	snap atomic.Value // []float32: read-only copy of data, nil after modification
+}
+
+// NewSyncFloat32s creates a SyncFloat32s with a copy of the elements.
+func NewSyncFloat32s(elements ...float32) *SyncFloat32s {
+	return &SyncFloat32s{data: Float32sCopy(elements)}
+}
+
+// Snapshot returns a read-only copy of the current elements.
+// NOTE:
+//
+//	The result is shared with other callers until the next modification, so it must not be changed.
+//	While there is no modification, it is an atomic read without locking.
+func (s *SyncFloat32s) Snapshot() []float32 {
+	if snap, _ := s.snap.Load().([]float32); snap != nil {
+		return snap
+	}
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	snap := Float32sCopy(s.data)
+	s.snap.Store(snap)
+	return snap
+}
+
+// Slice returns a copy of the current elements that can be changed.
+func (s *SyncFloat32s) Slice() []float32 {
+	return Float32sCopy(s.Snapshot())
+}
+
+// Len returns the number of elements.
+func (s *SyncFloat32s) Len() int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return len(s.data)
+}
+
+// At returns the element at the index, which can be negative and counted from the end.
+func (s *SyncFloat32s) At(index int) (v float32, ok bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return Float32sAt(s.data, index)
+}
+
+// Includes determines whether the slice includes a certain element.
+func (s *SyncFloat32s) Includes(valueToFind float32, fromIndex ...int) bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return Float32sIncludes(s.data, valueToFind, fromIndex...)
+}
+
+// IndexOf returns the first index at which a given element can be found, or -1 if it is not present.
+func (s *SyncFloat32s) IndexOf(searchElement float32, fromIndex ...int) int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return Float32sIndexOf(s.data, searchElement, fromIndex...)
+}
+
+// LastIndexOf returns the last index at which a given element can be found, or -1 if it is not present.
+func (s *SyncFloat32s) LastIndexOf(searchElement float32, fromIndex ...int) int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return Float32sLastIndexOf(s.data, searchElement, fromIndex...)
+}
+
+// Range calls fn for each element of a snapshot in order, until fn returns false.
+func (s *SyncFloat32s) Range(fn func(k int, v float32) bool) {
+	for k, v := range s.Snapshot() {
+		if !fn(k, v) {
+			return
+		}
+	}
+}
+
+// Every tests whether all elements of a snapshot pass the test implemented by fn.
+func (s *SyncFloat32s) Every(fn func(f []float32, k int, v float32) bool) bool {
+	return Float32sEvery(s.Snapshot(), fn)
+}
+
+// Some tests whether at least one element of a snapshot passes the test implemented by fn.
+func (s *SyncFloat32s) Some(fn func(f []float32, k int, v float32) bool) bool {
+	return Float32sSome(s.Snapshot(), fn)
+}
+
+// Find returns the key-value of the first element of a snapshot that satisfies fn.
+// If no element satisfies fn, -1 is returned.
+func (s *SyncFloat32s) Find(fn func(f []float32, k int, v float32) bool) (k int, v float32) {
+	return Float32sFind(s.Snapshot(), fn)
+}
+
+// Filter creates a new slice with the elements of a snapshot that pass the test implemented by fn.
+func (s *SyncFloat32s) Filter(fn func(f []float32, k int, v float32) bool) []float32 {
+	return Float32sFilter(s.Snapshot(), fn)
+}
+
+// Map creates a new slice with the results of calling fn on every element of a snapshot.
+func (s *SyncFloat32s) Map(fn func(f []float32, k int, v float32) float32) []float32 {
+	return Float32sMap(s.Snapshot(), fn)
+}
+
+// Reduce executes fn on each element of a snapshot, resulting in a single output value.
+func (s *SyncFloat32s) Reduce(fn func(f []float32, k int, v, accumulator float32) float32, initialValue ...float32) float32 {
+	return Float32sReduce(s.Snapshot(), fn, initialValue...)
+}
+
+// Set changes the element at the index, which can be negative and counted from the end,
+// and reports whether the index is in range.
+func (s *SyncFloat32s) Set(index int, value float32) bool {
+	s.mu.Lock()
+	defer s.unlock()
+	k, ok := atIndex(len(s.data), index)
+	if ok {
+		s.data[k] = value
+	}
+	return ok
+}
+
+// Push adds one or more elements to the end, and returns the new length.
+func (s *SyncFloat32s) Push(element ...float32) int {
+	s.mu.Lock()
+	defer s.unlock()
+	return Float32sPush(&s.data, element...)
+}
+
+// PushDistinct adds one or more new elements that do not exist at the end, and returns the new length.
+func (s *SyncFloat32s) PushDistinct(element ...float32) int {
+	s.mu.Lock()
+	defer s.unlock()
+	s.data = Float32sPushDistinct(s.data, element...)
+	return len(s.data)
+}
+
+// Pop removes the last element and returns that element.
+func (s *SyncFloat32s) Pop() (float32, bool) {
+	s.mu.Lock()
+	defer s.unlock()
+	return Float32sPop(&s.data)
+}
+
+// Shift removes the first element and returns that element.
+func (s *SyncFloat32s) Shift() (float32, bool) {
+	s.mu.Lock()
+	defer s.unlock()
+	return Float32sShift(&s.data)
+}
+
+// Unshift adds one or more elements to the beginning, and returns the new length.
+func (s *SyncFloat32s) Unshift(element ...float32) int {
+	s.mu.Lock()
+	defer s.unlock()
+	return Float32sUnshift(&s.data, element...)
+}
+
+// UnshiftDistinct adds one or more new elements that do not exist to the beginning, and returns the new length.
+func (s *SyncFloat32s) UnshiftDistinct(element ...float32) int {
+	s.mu.Lock()
+	defer s.unlock()
+	return Float32sUnshiftDistinct(&s.data, element...)
+}
+
+// Splice removes deleteCount elements from start and adds the items in their place.
+func (s *SyncFloat32s) Splice(start, deleteCount int, items ...float32) {
+	s.mu.Lock()
+	defer s.unlock()
+	Float32sSplice(&s.data, start, deleteCount, items...)
+}
+
+// RemoveFirst removes the first matched elements, and returns the new length.
+func (s *SyncFloat32s) RemoveFirst(elements ...float32) int {
+	s.mu.Lock()
+	defer s.unlock()
+	return Float32sRemoveFirst(&s.data, elements...)
+}
+
+// RemoveEvery removes all the elements, and returns the new length.
+func (s *SyncFloat32s) RemoveEvery(elements ...float32) int {
+	s.mu.Lock()
+	defer s.unlock()
+	return Float32sRemoveEvery(&s.data, elements...)
+}
+
+// Distinct removes the duplicate elements, and returns the count of each element.
+func (s *SyncFloat32s) Distinct() (distinctCount map[float32]int) {
+	s.mu.Lock()
+	defer s.unlock()
+	return Float32sDistinct(&s.data, true)
+}
+
+// Fill changes all elements from start to end to a static value.
+func (s *SyncFloat32s) Fill(value float32, start int, end ...int) {
+	s.mu.Lock()
+	defer s.unlock()
+	Float32sFill(s.data, value, start, end...)
+}
+
+// CopyWithin copies part of the slice to another location in the same slice.
+func (s *SyncFloat32s) CopyWithin(target, start int, end ...int) {
+	s.mu.Lock()
+	defer s.unlock()
+	Float32sCopyWithin(s.data, target, start, end...)
+}
+
+// Reverse reverses the elements in place.
+func (s *SyncFloat32s) Reverse() {
+	s.mu.Lock()
+	defer s.unlock()
+	Float32sReverse(s.data)
+}
+
+// Shuffle randomizes the order of the elements in place.
+func (s *SyncFloat32s) Shuffle(r Randomizer) {
+	s.mu.Lock()
+	defer s.unlock()
+	Float32sShuffle(s.data, r)
+}
+
+// ApplyPatch applies the edit script to the elements.
+func (s *SyncFloat32s) ApplyPatch(patch []Float32sEdit) error {
+	s.mu.Lock()
+	defer s.unlock()
+	return Float32sApplyPatch(&s.data, patch)
+}
+
+// Update calls fn with the pointer of the elements under the write lock.
+// NOTE:
+//
+//	fn must not call the methods of the same SyncFloat32s, and must not retain the slice.
+func (s *SyncFloat32s) Update(fn func(p *[]float32)) {
+	s.mu.Lock()
+	defer s.unlock()
+	fn(&s.data)
+}
+
+// Clear removes all elements.
+func (s *SyncFloat32s) Clear() {
+	s.mu.Lock()
+	defer s.unlock()
+	s.data = nil
+}
+
+// unlock invalidates the snapshot and releases the write lock.
+func (s *SyncFloat32s) unlock() {
+	s.snap.Store([]float32(nil))
+	s.mu.Unlock()
+}
+
+// SyncFloat32Set is a float32 set that is safe for concurrent use.
+// NOTE:
+//
+//	The zero value is an empty set ready to use.
+//	Len and Has use the read lock without copying the elements.
+//	Callbacks are run on a snapshot, so they can call the methods of the same SyncFloat32Set.
+type SyncFloat32Set struct {
+	mu   sync.RWMutex
+	set  *Float32Set
+	snap atomic.Value // []float32: read-only elements in insertion order, nil after modification
+}
+
+// NewSyncFloat32Set creates a SyncFloat32Set with the elements.
+func NewSyncFloat32Set(elements ...float32) *SyncFloat32Set {
+	return &SyncFloat32Set{set: NewFloat32Set(elements...)}
+}
+
+// Snapshot returns the read-only elements in insertion order.
+// NOTE:
+//
+//	The result is shared with other callers until the next modification, so it must not be changed.
+//	While there is no modification, it is an atomic read without locking.
+func (s *SyncFloat32Set) Snapshot() []float32 {
+	if snap, _ := s.snap.Load().([]float32); snap != nil {
+		return snap
+	}
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	var snap []float32
+	if s.set != nil {
+		snap = s.set.Slice()
+	}
+	if snap == nil {
+		snap = []float32{}
+	}
+	s.snap.Store(snap)
+	return snap
+}
+
+// Add adds the elements that are not yet in the set, and returns the new length of the set.
+func (s *SyncFloat32Set) Add(elements ...float32) int {
+	s.mu.Lock()
+	defer s.unlock()
+	if s.set == nil {
+		s.set = NewFloat32Set()
+	}
+	return s.set.Add(elements...)
+}
+
+// Remove removes the elements from the set, and returns the new length of the set.
+func (s *SyncFloat32Set) Remove(elements ...float32) int {
+	s.mu.Lock()
+	defer s.unlock()
+	if s.set == nil {
+		return 0
+	}
+	return s.set.Remove(elements...)
+}
+
+// Has reports whether the element is in the set.
+func (s *SyncFloat32Set) Has(element float32) bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.set != nil && s.set.Has(element)
+}
+
+// Len returns the number of elements in the set.
+func (s *SyncFloat32Set) Len() int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	if s.set == nil {
+		return 0
+	}
+	return s.set.Len()
+}
+
+// Clear removes all elements.
+func (s *SyncFloat32Set) Clear() {
+	s.mu.Lock()
+	defer s.unlock()
+	s.set = nil
+}
+
+// Range calls fn for each element of a snapshot in insertion order, until fn returns false.
+func (s *SyncFloat32Set) Range(fn func(v float32) bool) {
+	for _, v := range s.Snapshot() {
+		if !fn(v) {
+			return
+		}
+	}
+}
+
+// Slice returns a copy of the elements in insertion order.
+func (s *SyncFloat32Set) Slice() []float32 {
+	return Float32sCopy(s.Snapshot())
+}
+
+// SortedSlice returns a copy of the elements in ascending order.
+func (s *SyncFloat32Set) SortedSlice() []float32 {
+	return NewFloat32Set(s.Snapshot()...).SortedSlice()
+}
+
+// Clone returns a Float32Set with the current elements.
+func (s *SyncFloat32Set) Clone() *Float32Set {
+	return NewFloat32Set(s.Snapshot()...)
+}
+
+// unlock invalidates the snapshot and releases the write lock.
+func (s *SyncFloat32Set) unlock() {
+	s.snap.Store([]float32(nil))
+	s.mu.Unlock()
+}
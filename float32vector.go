@@ -0,0 +1,273 @@
+package ameda
+
+import (
+	"errors"
+	"math"
+)
+
+// Float32sAdd returns a new slice of the element-wise sum: a[k] + b[k].
+// NOTE:
+//
+//	If the lengths are different, returns an error.
+func Float32sAdd(a, b []float32) ([]float32, error) {
+	if len(a) != len(b) {
+		return nil, errLengthMismatch
+	}
+	r := make([]float32, len(a))
+	b = b[:len(a)]
+	k := 0
+	for ; k+4 <= len(a); k += 4 {
+		r[k] = a[k] + b[k]
+		r[k+1] = a[k+1] + b[k+1]
+		r[k+2] = a[k+2] + b[k+2]
+		r[k+3] = a[k+3] + b[k+3]
+	}
+	for ; k < len(a); k++ {
+		r[k] = a[k] + b[k]
+	}
+	return r, nil
+}
+
+// Float32sSub returns a new slice of the element-wise difference: a[k] - b[k].
+// NOTE:
+//
+//	If the lengths are different, returns an error.
+func Float32sSub(a, b []float32) ([]float32, error) {
+	if len(a) != len(b) {
+		return nil, errLengthMismatch
+	}
+	r := make([]float32, len(a))
+	b = b[:len(a)]
+	k := 0
+	for ; k+4 <= len(a); k += 4 {
+		r[k] = a[k] - b[k]
+		r[k+1] = a[k+1] - b[k+1]
+		r[k+2] = a[k+2] - b[k+2]
+		r[k+3] = a[k+3] - b[k+3]
+	}
+	for ; k < len(a); k++ {
+		r[k] = a[k] - b[k]
+	}
+	return r, nil
+}
+
+// Float32sMul returns a new slice of the element-wise product: a[k] * b[k].
+// NOTE:
+//
+//	If the lengths are different, returns an error.
+func Float32sMul(a, b []float32) ([]float32, error) {
+	if len(a) != len(b) {
+		return nil, errLengthMismatch
+	}
+	r := make([]float32, len(a))
+	b = b[:len(a)]
+	k := 0
+	for ; k+4 <= len(a); k += 4 {
+		r[k] = a[k] * b[k]
+		r[k+1] = a[k+1] * b[k+1]
+		r[k+2] = a[k+2] * b[k+2]
+		r[k+3] = a[k+3] * b[k+3]
+	}
+	for ; k < len(a); k++ {
+		r[k] = a[k] * b[k]
+	}
+	return r, nil
+}
+
+// Float32sDiv returns a new slice of the element-wise quotient: a[k] / b[k].
+// NOTE:
+//
+//	If the lengths are different, returns an error.
+//	Division by zero follows IEEE 754, i.e. results in ±Inf or NaN.
+func Float32sDiv(a, b []float32) ([]float32, error) {
+	if len(a) != len(b) {
+		return nil, errLengthMismatch
+	}
+	r := make([]float32, len(a))
+	b = b[:len(a)]
+	k := 0
+	for ; k+4 <= len(a); k += 4 {
+		r[k] = a[k] / b[k]
+		r[k+1] = a[k+1] / b[k+1]
+		r[k+2] = a[k+2] / b[k+2]
+		r[k+3] = a[k+3] / b[k+3]
+	}
+	for ; k < len(a); k++ {
+		r[k] = a[k] / b[k]
+	}
+	return r, nil
+}
+
+// Float32sScale returns a new slice with every element multiplied by c.
+func Float32sScale(f []float32, c float32) []float32 {
+	r := make([]float32, len(f))
+	k := 0
+	for ; k+4 <= len(f); k += 4 {
+		r[k] = f[k] * c
+		r[k+1] = f[k+1] * c
+		r[k+2] = f[k+2] * c
+		r[k+3] = f[k+3] * c
+	}
+	for ; k < len(f); k++ {
+		r[k] = f[k] * c
+	}
+	return r
+}
+
+// Float32sDot returns the dot product of the two vectors: Σ a[k] * b[k].
+// NOTE:
+//
+//	If the lengths are different, returns an error.
+//	Like the other sums and products of float32 vectors, it is accumulated in float64.
+func Float32sDot(a, b []float32) (float32, error) {
+	if len(a) != len(b) {
+		return 0, errLengthMismatch
+	}
+	return float32(float32Dot(a, b)), nil
+}
+
+// Float32sNormL1 returns the L1 (Manhattan) norm of the vector: Σ |f[k]|.
+func Float32sNormL1(f []float32) float32 {
+	var s0, s1, s2, s3 float64
+	k := 0
+	for ; k+4 <= len(f); k += 4 {
+		s0 += math.Abs(float64(f[k]))
+		s1 += math.Abs(float64(f[k+1]))
+		s2 += math.Abs(float64(f[k+2]))
+		s3 += math.Abs(float64(f[k+3]))
+	}
+	for ; k < len(f); k++ {
+		s0 += math.Abs(float64(f[k]))
+	}
+	return float32(s0 + s1 + s2 + s3)
+}
+
+// Float32sNormL2 returns the L2 (Euclidean) norm of the vector: √(Σ f[k]²).
+func Float32sNormL2(f []float32) float32 {
+	return float32(math.Sqrt(float32Dot(f, f)))
+}
+
+// Float32sNormInf returns the infinity (maximum) norm of the vector: max |f[k]|.
+func Float32sNormInf(f []float32) float32 {
+	var m float32
+	for _, v := range f {
+		if v < 0 {
+			v = -v
+		}
+		if v > m || v != v {
+			m = v
+		}
+	}
+	return m
+}
+
+// Float32sNormalize returns a new slice of the unit vector with the same direction: f / ‖f‖₂.
+// NOTE:
+//
+//	If the norm is zero, returns an error.
+func Float32sNormalize(f []float32) ([]float32, error) {
+	n := Float32sNormL2(f)
+	if n == 0 {
+		return nil, errors.New("zero vector cannot be normalized")
+	}
+	r := make([]float32, len(f))
+	for k, v := range f {
+		r[k] = v / n
+	}
+	return r, nil
+}
+
+// Float32sCosineSimilarity returns the cosine of the angle between the two vectors: a·b / (‖a‖₂ ‖b‖₂).
+// NOTE:
+//
+//	If the lengths are different or any vector is zero, returns an error.
+func Float32sCosineSimilarity(a, b []float32) (float32, error) {
+	if len(a) != len(b) {
+		return 0, errLengthMismatch
+	}
+	na, nb := math.Sqrt(float32Dot(a, a)), math.Sqrt(float32Dot(b, b))
+	if na == 0 || nb == 0 {
+		return 0, errors.New("cosine similarity is undefined for zero vector")
+	}
+	return float32(float32Dot(a, b) / na / nb), nil
+}
+
+// Float32sCumSum returns a new slice of the cumulative sums: r[k] = f[0] + ... + f[k].
+func Float32sCumSum(f []float32) []float32 {
+	r := make([]float32, len(f))
+	var acc float64
+	for k, v := range f {
+		acc += float64(v)
+		r[k] = float32(acc)
+	}
+	return r
+}
+
+// Float32sCumProd returns a new slice of the cumulative products: r[k] = f[0] * ... * f[k].
+func Float32sCumProd(f []float32) []float32 {
+	r := make([]float32, len(f))
+	acc := 1.0
+	for k, v := range f {
+		acc *= float64(v)
+		r[k] = float32(acc)
+	}
+	return r
+}
+
+// Float32sAdjacentDiff returns a new slice of the differences between adjacent elements: r[k] = f[k+1] - f[k].
+// The result has one element less than f.
+func Float32sAdjacentDiff(f []float32) []float32 {
+	if len(f) < 2 {
+		return []float32{}
+	}
+	r := make([]float32, len(f)-1)
+	for k := range r {
+		r[k] = f[k+1] - f[k]
+	}
+	return r
+}
+
+// Float32sArgmin returns the index of the first minimum element.
+// NOTE:
+//
+//	NaN is ignored; if there is no element other than NaN, returns -1
+func Float32sArgmin(f []float32) int {
+	idx := -1
+	for k, v := range f {
+		if v == v && (idx < 0 || v < f[idx]) {
+			idx = k
+		}
+	}
+	return idx
+}
+
+// Float32sArgmax returns the index of the first maximum element.
+// NOTE:
+//
+//	NaN is ignored; if there is no element other than NaN, returns -1
+func Float32sArgmax(f []float32) int {
+	idx := -1
+	for k, v := range f {
+		if v == v && (idx < 0 || v > f[idx]) {
+			idx = k
+		}
+	}
+	return idx
+}
+
+// float32Dot accumulates in float64, so the result is not rounded until the caller converts it.
+func float32Dot(a, b []float32) float64 {
+	var s0, s1, s2, s3 float64
+	b = b[:len(a)]
+	k := 0
+	for ; k+4 <= len(a); k += 4 {
+		s0 += float64(a[k]) * float64(b[k])
+		s1 += float64(a[k+1]) * float64(b[k+1])
+		s2 += float64(a[k+2]) * float64(b[k+2])
+		s3 += float64(a[k+3]) * float64(b[k+3])
+	}
+	for ; k < len(a); k++ {
+		s0 += float64(a[k]) * float64(b[k])
+	}
+	return s0 + s1 + s2 + s3
+}
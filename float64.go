@@ -218,3 +218,86 @@ func Float64ToUint64Ptr(v float64) (*uint64, error) {
 	r, err := Float64ToUint64(v)
 	return &r, err
 }
+
+// Float64AlmostEqual reports whether a and b are equal within the absolute tolerance absTol
+// or the relative tolerance relTol, i.e. |a-b| <= max(absTol, relTol*max(|a|, |b|)).
+// NOTE:
+//
+//	NaN is not equal to any value, and an infinity is only equal to itself.
+func Float64AlmostEqual(a, b, absTol, relTol float64) bool {
+	if a == b {
+		return true
+	}
+	if a != a || b != b || math.IsInf(a, 0) || math.IsInf(b, 0) {
+		return false
+	}
+	diff := math.Abs(a - b)
+	if diff <= absTol {
+		return true
+	}
+	return diff <= relTol*math.Max(math.Abs(a), math.Abs(b))
+}
+
+// Float64ULPDistance returns the number of representable float64 values between a and b,
+// i.e. the distance in units in the last place.
+// NOTE:
+//
+//	+0 and -0 are at distance 0;
+//	if a or b is NaN, the maximum uint64 is returned.
+func Float64ULPDistance(a, b float64) uint64 {
+	if a == b {
+		return 0
+	}
+	if a != a || b != b {
+		return math.MaxUint64
+	}
+	x, y := orderedFloat64Bits(a), orderedFloat64Bits(b)
+	if x > y {
+		return x - y
+	}
+	return y - x
+}
+
+// orderedFloat64Bits maps the bits of f to an unsigned integer in the same order as the floats, with +0 and -0 merged.
+func orderedFloat64Bits(f float64) uint64 {
+	const signBit = 1 << (64 - 1)
+	bits := math.Float64bits(f)
+	if bits&signBit != 0 {
+		return signBit - bits&^signBit
+	}
+	return signBit + bits
+}
+
+// Float64EqualWith reports whether a and b are equal according to the policy.
+func Float64EqualWith(a, b float64, policy FloatPolicy) bool {
+	if a != a || b != b {
+		return policy.NaN.nanMatch(a != a, b != b)
+	}
+	if a != b {
+		return false
+	}
+	return a != 0 || policy.SignedZero == SignedZeroEqual || math.Signbit(a) == math.Signbit(b)
+}
+
+// Float64CompareWith compares a and b in a total order, and returns -1 if a < b, 0 if a == b, or +1 if a > b.
+// NOTE:
+//
+//	NaN is less than any other value and equal to NaN, like sort.Float64s;
+//	-0 is less than +0 if policy.SignedZero is SignedZeroDistinct.
+func Float64CompareWith(a, b float64, policy FloatPolicy) int {
+	if c := compareFloat64(a, b); c != 0 || a != 0 || b != 0 || policy.SignedZero == SignedZeroEqual {
+		return c
+	}
+	return compareBool(!math.Signbit(a), !math.Signbit(b))
+}
+
+// float64PolicyKey returns the map key of v according to the policy, or false if v is not equal to any value.
+func float64PolicyKey(v float64, policy FloatPolicy) (uint64, bool) {
+	switch {
+	case v != v:
+		return math.MaxUint64, policy.NaN == NaNEqual
+	case v == 0 && policy.SignedZero == SignedZeroEqual:
+		return 0, true
+	}
+	return math.Float64bits(v), true
+}
@@ -0,0 +1,164 @@
+package ameda
+
+import "sort"
+
+// Float64Counter is a multiset that maps each float64 element to its count.
+// NOTE:
+//
+//	Only positive counts are kept.
+//	It has the same underlying type as the result of Float64sDistinct and Float64sIntersect,
+//	so they can be converted to each other directly.
+type Float64Counter map[float64]int
+
+// Float64CounterItem is an element with its count.
+type Float64CounterItem struct {
+	Element float64
+	Count   int
+}
+
+// NewFloat64Counter creates a counter that counts the elements.
+func NewFloat64Counter(elements ...float64) Float64Counter {
+	c := make(Float64Counter, len(elements))
+	c.Add(elements...)
+	return c
+}
+
+// Float64sDistinctCounter is like Float64sDistinct, but returns a counter.
+func Float64sDistinctCounter(f *[]float64, changeSlice bool) Float64Counter {
+	return Float64Counter(Float64sDistinct(f, changeSlice))
+}
+
+// Float64sIntersectCounter is like Float64sIntersect, but returns a counter, which is empty but not nil if there is no common element.
+func Float64sIntersectCounter(f ...[]float64) Float64Counter {
+	if c := Float64sIntersect(f...); c != nil {
+		return Float64Counter(c)
+	}
+	return make(Float64Counter)
+}
+
+// Add increases the count of each element by 1.
+func (c Float64Counter) Add(elements ...float64) {
+	for _, v := range elements {
+		c[v]++
+	}
+}
+
+// AddN increases the count of the element by n, and returns the new count.
+// NOTE:
+//
+//	If the count is no longer positive, the element is removed.
+func (c Float64Counter) AddN(element float64, n int) int {
+	n += c[element]
+	if n <= 0 {
+		delete(c, element)
+		return 0
+	}
+	c[element] = n
+	return n
+}
+
+// Subtract decreases the count of each element by 1.
+// NOTE:
+//
+//	If the count is no longer positive, the element is removed.
+func (c Float64Counter) Subtract(elements ...float64) {
+	for _, v := range elements {
+		c.AddN(v, -1)
+	}
+}
+
+// Get returns the count of the element.
+func (c Float64Counter) Get(element float64) int {
+	return c[element]
+}
+
+// Total returns the sum of all counts.
+func (c Float64Counter) Total() int {
+	var n int
+	for _, v := range c {
+		n += v
+	}
+	return n
+}
+
+// Clone creates a copy of the counter.
+func (c Float64Counter) Clone() Float64Counter {
+	r := make(Float64Counter, len(c))
+	for k, v := range c {
+		r[k] = v
+	}
+	return r
+}
+
+// Union returns a new counter with the maximum count of each element: c ∪ others...
+func (c Float64Counter) Union(others ...Float64Counter) Float64Counter {
+	r := c.Clone()
+	for _, o := range others {
+		for k, v := range o {
+			if v > r[k] {
+				r[k] = v
+			}
+		}
+	}
+	return r
+}
+
+// Intersect returns a new counter with the minimum count of each element: c ∩ others...
+func (c Float64Counter) Intersect(others ...Float64Counter) Float64Counter {
+	r := make(Float64Counter, len(c))
+L:
+	for k, v := range c {
+		for _, o := range others {
+			v2 := o[k]
+			if v2 <= 0 {
+				continue L
+			}
+			if v > v2 {
+				v = v2
+			}
+		}
+		r[k] = v
+	}
+	return r
+}
+
+// MostCommon returns the k most common elements from the most to the least common,
+// elements with equal counts are in ascending order.
+// NOTE:
+//
+//	If k < 0, returns all elements.
+func (c Float64Counter) MostCommon(k int) []Float64CounterItem {
+	items := make([]Float64CounterItem, 0, len(c))
+	for e, n := range c {
+		items = append(items, Float64CounterItem{Element: e, Count: n})
+	}
+	sort.Slice(items, func(i, j int) bool {
+		if items[i].Count != items[j].Count {
+			return items[i].Count > items[j].Count
+		}
+		return items[i].Element < items[j].Element
+	})
+	if k >= 0 && k < len(items) {
+		items = items[:k]
+	}
+	return items
+}
+
+// SortedElements returns the distinct elements in ascending order.
+func (c Float64Counter) SortedElements() []float64 {
+	r := make([]float64, 0, len(c))
+	for k := range c {
+		r = append(r, k)
+	}
+	sort.Slice(r, func(i, j int) bool { return r[i] < r[j] })
+	return r
+}
+
+// RangeSorted calls fn for each element in ascending order; if fn returns false, range stops the iteration.
+func (c Float64Counter) RangeSorted(fn func(element float64, count int) bool) {
+	for _, k := range c.SortedElements() {
+		if !fn(k, c[k]) {
+			return
+		}
+	}
+}
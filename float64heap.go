@@ -0,0 +1,298 @@
+package ameda
+
+// Float64Heap is a binary heap (priority queue) of float64 elements.
+// NOTE:
+//
+//	The element that is less than others is popped first, e.g. the minimum for a min-heap.
+//	It is not safe for concurrent use.
+type Float64Heap struct {
+	data  []float64
+	less  func(a, b float64) bool
+	limit int
+}
+
+// NewFloat64MinHeap creates a min-heap with the elements in O(n).
+// This method does not change the existing slice.
+func NewFloat64MinHeap(elements ...float64) *Float64Heap {
+	return NewFloat64Heap(nil, elements...)
+}
+
+// NewFloat64MaxHeap creates a max-heap with the elements in O(n).
+// This method does not change the existing slice.
+func NewFloat64MaxHeap(elements ...float64) *Float64Heap {
+	return NewFloat64Heap(func(a, b float64) bool { return b < a }, elements...)
+}
+
+// NewFloat64Heap creates a heap ordered by less with the elements in O(n).
+// This method does not change the existing slice.
+// @less
+//
+//	Reports whether the element a must be popped before the element b. If nil, it is a min-heap.
+func NewFloat64Heap(less func(a, b float64) bool, elements ...float64) *Float64Heap {
+	if less == nil {
+		less = func(a, b float64) bool { return a < b }
+	}
+	h := &Float64Heap{data: Float64sCopy(elements), less: less}
+	for k := len(h.data)/2 - 1; k >= 0; k-- {
+		h.down(k)
+	}
+	return h
+}
+
+// NewFloat64TopK creates a bounded heap that keeps at most k elements, the greatest ones ordered by less.
+// When it is full, pushing an element evicts the least one.
+// @less
+//
+//	Reports whether the element a is less than the element b. If nil, it is a min-heap.
+func NewFloat64TopK(k int, less func(a, b float64) bool) *Float64Heap {
+	if k < 1 {
+		k = 1
+	}
+	if less == nil {
+		less = func(a, b float64) bool { return a < b }
+	}
+	return &Float64Heap{data: make([]float64, 0, k), less: less, limit: k}
+}
+
+// Len returns the number of elements in the heap.
+func (h *Float64Heap) Len() int {
+	return len(h.data)
+}
+
+// Push adds the elements to the heap in O(log n) each, and returns the new length of the heap.
+func (h *Float64Heap) Push(elements ...float64) int {
+	for _, v := range elements {
+		if h.limit > 0 && len(h.data) >= h.limit {
+			if h.less(h.data[0], v) {
+				h.data[0] = v
+				h.down(0)
+			}
+			continue
+		}
+		h.data = append(h.data, v)
+		h.up(len(h.data) - 1)
+	}
+	return len(h.data)
+}
+
+// Pop removes the first element of the heap in O(log n) and returns that element.
+func (h *Float64Heap) Pop() (v float64, ok bool) {
+	n := len(h.data) - 1
+	if n < 0 {
+		return v, false
+	}
+	v = h.data[0]
+	h.data[0] = h.data[n]
+	h.data[n] = 0
+	h.data = h.data[:n]
+	if n > 0 {
+		h.down(0)
+	}
+	return v, true
+}
+
+// Peek returns the first element of the heap without removing it.
+func (h *Float64Heap) Peek() (v float64, ok bool) {
+	if len(h.data) == 0 {
+		return v, false
+	}
+	return h.data[0], true
+}
+
+// Slice returns a copy of the elements in the heap order.
+func (h *Float64Heap) Slice() []float64 {
+	return Float64sCopy(h.data)
+}
+
+// Sorted returns a copy of the elements in the popping order.
+func (h *Float64Heap) Sorted() []float64 {
+	c := &Float64Heap{data: Float64sCopy(h.data), less: h.less}
+	r := make([]float64, 0, len(c.data))
+	for {
+		v, ok := c.Pop()
+		if !ok {
+			return r
+		}
+		r = append(r, v)
+	}
+}
+
+func (h *Float64Heap) up(k int) {
+	for k > 0 {
+		parent := (k - 1) / 2
+		if !h.less(h.data[k], h.data[parent]) {
+			return
+		}
+		h.data[k], h.data[parent] = h.data[parent], h.data[k]
+		k = parent
+	}
+}
+
+func (h *Float64Heap) down(k int) {
+	n := len(h.data)
+	for {
+		child := 2*k + 1
+		if child >= n {
+			return
+		}
+		if right := child + 1; right < n && h.less(h.data[right], h.data[child]) {
+			child = right
+		}
+		if !h.less(h.data[child], h.data[k]) {
+			return
+		}
+		h.data[k], h.data[child] = h.data[child], h.data[k]
+		k = child
+	}
+}
+
+// Float64IndexedHeap is a binary heap of integer keys with float64 priorities,
+// whose priorities can be updated (e.g. decrease-key) in O(log n).
+// NOTE:
+//
+//	The key with the priority that is less than others is popped first.
+//	It is not safe for concurrent use.
+type Float64IndexedHeap struct {
+	keys  []int
+	prios []float64
+	pos   map[int]int // key -> position in the heap
+	less  func(a, b float64) bool
+}
+
+// NewFloat64IndexedHeap creates an empty indexed heap ordered by less.
+// @less
+//
+//	Reports whether the priority a must be popped before the priority b. If nil, it is a min-heap.
+func NewFloat64IndexedHeap(less func(a, b float64) bool) *Float64IndexedHeap {
+	if less == nil {
+		less = func(a, b float64) bool { return a < b }
+	}
+	return &Float64IndexedHeap{pos: make(map[int]int), less: less}
+}
+
+// Len returns the number of keys in the heap.
+func (h *Float64IndexedHeap) Len() int {
+	return len(h.keys)
+}
+
+// Has reports whether the key is in the heap.
+func (h *Float64IndexedHeap) Has(key int) bool {
+	_, ok := h.pos[key]
+	return ok
+}
+
+// Priority returns the priority of the key.
+func (h *Float64IndexedHeap) Priority(key int) (priority float64, ok bool) {
+	k, ok := h.pos[key]
+	if !ok {
+		return priority, false
+	}
+	return h.prios[k], true
+}
+
+// Push adds the key with the priority, or updates the priority if the key is already in the heap.
+func (h *Float64IndexedHeap) Push(key int, priority float64) {
+	if !h.Update(key, priority) {
+		h.pos[key] = len(h.keys)
+		h.keys = append(h.keys, key)
+		h.prios = append(h.prios, priority)
+		h.up(len(h.keys) - 1)
+	}
+}
+
+// Update changes the priority of the key (e.g. decrease-key), and reports whether the key is in the heap.
+func (h *Float64IndexedHeap) Update(key int, priority float64) bool {
+	k, ok := h.pos[key]
+	if !ok {
+		return false
+	}
+	h.prios[k] = priority
+	h.fix(k)
+	return true
+}
+
+// Remove removes the key from the heap, and reports whether the key was in the heap.
+func (h *Float64IndexedHeap) Remove(key int) bool {
+	k, ok := h.pos[key]
+	if !ok {
+		return false
+	}
+	h.removeAt(k)
+	return true
+}
+
+// Peek returns the first key and its priority without removing it.
+func (h *Float64IndexedHeap) Peek() (key int, priority float64, ok bool) {
+	if len(h.keys) == 0 {
+		return -1, priority, false
+	}
+	return h.keys[0], h.prios[0], true
+}
+
+// Pop removes the first key from the heap and returns the key and its priority.
+func (h *Float64IndexedHeap) Pop() (key int, priority float64, ok bool) {
+	key, priority, ok = h.Peek()
+	if ok {
+		h.removeAt(0)
+	}
+	return
+}
+
+func (h *Float64IndexedHeap) removeAt(k int) {
+	n := len(h.keys) - 1
+	delete(h.pos, h.keys[k])
+	if k != n {
+		h.keys[k], h.prios[k] = h.keys[n], h.prios[n]
+		h.pos[h.keys[k]] = k
+	}
+	h.prios[n] = 0
+	h.keys, h.prios = h.keys[:n], h.prios[:n]
+	if k != n {
+		h.fix(k)
+	}
+}
+
+func (h *Float64IndexedHeap) fix(k int) {
+	if !h.down(k) {
+		h.up(k)
+	}
+}
+
+func (h *Float64IndexedHeap) swap(i, j int) {
+	h.keys[i], h.keys[j] = h.keys[j], h.keys[i]
+	h.prios[i], h.prios[j] = h.prios[j], h.prios[i]
+	h.pos[h.keys[i]] = i
+	h.pos[h.keys[j]] = j
+}
+
+func (h *Float64IndexedHeap) up(k int) {
+	for k > 0 {
+		parent := (k - 1) / 2
+		if !h.less(h.prios[k], h.prios[parent]) {
+			return
+		}
+		h.swap(k, parent)
+		k = parent
+	}
+}
+
+// down reports whether the element is moved.
+func (h *Float64IndexedHeap) down(k int) bool {
+	k0 := k
+	n := len(h.keys)
+	for {
+		child := 2*k + 1
+		if child >= n {
+			break
+		}
+		if right := child + 1; right < n && h.less(h.prios[right], h.prios[child]) {
+			child = right
+		}
+		if !h.less(h.prios[child], h.prios[k]) {
+			break
+		}
+		h.swap(k, child)
+		k = child
+	}
+	return k > k0
+}
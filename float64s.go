@@ -1,5 +1,11 @@
 package ameda
 
+import (
+	"fmt"
+	"math"
+	"sort"
+)
+
 // OneFloat64 try to return the first element, otherwise return zero value.
 func OneFloat64(f []float64) float64 {
 	if len(f) > 0 {
@@ -278,6 +284,10 @@ func Float64sFind(f []float64, fn func(f []float64, k int, v float64) bool) (k i
 // @fromIndex
 //
 //	The index to start the search at. Defaults to 0.
+//
+// NOTE:
+//
+//	NaN can never be found, see Float64sIncludesWith for the NaN policy.
 func Float64sIncludes(f []float64, valueToFind float64, fromIndex ...int) bool {
 	return Float64sIndexOf(f, valueToFind, fromIndex...) > -1
 }
@@ -286,6 +296,10 @@ func Float64sIncludes(f []float64, valueToFind float64, fromIndex ...int) bool {
 // @fromIndex
 //
 //	The index to start the search at. Defaults to 0.
+//
+// NOTE:
+//
+//	NaN can never be found, see Float64sIndexOfWith for the NaN policy.
 func Float64sIndexOf(f []float64, searchElement float64, fromIndex ...int) int {
 	idx := getFromIndex(len(f), fromIndex...)
 	for k, v := range f[idx:] {
@@ -300,6 +314,10 @@ func Float64sIndexOf(f []float64, searchElement float64, fromIndex ...int) int {
 // @fromIndex
 //
 //	The index to start the search at. Defaults to 0.
+//
+// NOTE:
+//
+//	NaN can never be found, see Float64sLastIndexOfWith for the NaN policy.
 func Float64sLastIndexOf(f []float64, searchElement float64, fromIndex ...int) int {
 	idx := getFromIndex(len(f), fromIndex...)
 	for k := len(f) - 1; k >= idx; k-- {
@@ -473,26 +491,20 @@ func Float64sSplice(f *[]float64, start, deleteCount int, items ...float64) {
 	if deleteCount < 0 {
 		deleteCount = 0
 	}
-	start, end, _ := fixRange(len(a), start, start+1+deleteCount)
-	deleteCount = end - start - 1
-	for k := 0; k < len(items); k++ {
-		if deleteCount > 0 {
-			// replace
-			a[start] = items[k]
-			deleteCount--
-			start++
-		} else {
-			// insert
-			lastSlice := Float64sCopy(a[start:])
-			items = items[k:]
-			a = append(a[:start], items...)
-			a = append(a[:start+len(items)], lastSlice...)
-			*f = a[:len(a):len(a)]
-			return
-		}
+	start = fixIndex(len(a), start, true)
+	if rest := len(a) - start; deleteCount > rest {
+		deleteCount = rest
 	}
-	if deleteCount > 0 {
-		a = append(a[:start], a[start+1+deleteCount:]...)
+	// replace
+	n := copy(a[start:start+deleteCount], items)
+	if n == len(items) {
+		// delete the rest
+		a = append(a[:start+n], a[start+deleteCount:]...)
+	} else {
+		// insert the rest
+		lastSlice := Float64sCopy(a[start+deleteCount:])
+		a = append(a[:start+deleteCount], items[n:]...)
+		a = append(a, lastSlice...)
 	}
 	*f = a[:len(a):len(a)]
 }
@@ -574,8 +586,27 @@ func Float64sRemoveEvery(p *[]float64, elements ...float64) int {
 	return n
 }
 
+// Float64sConcat is used to merge two or more slices.
+// This method does not change the existing slices, but instead returns a new slice.
+func Float64sConcat(f ...[]float64) []float64 {
+	var totalLen int
+	for _, v := range f {
+		totalLen += len(v)
+	}
+	ret := make([]float64, totalLen)
+	dst := ret
+	for _, v := range f {
+		n := copy(dst, v)
+		dst = dst[n:]
+	}
+	return ret
+}
+
 // Float64sIntersect calculates intersection of two or more slices,
 // and returns the count of each element.
+// NOTE:
+//
+//	Each NaN is a separate map key and +0 is merged with -0, see Float64sIntersectWith for the NaN and signed-zero policy.
 func Float64sIntersect(f ...[]float64) (intersectCount map[float64]int) {
 	if len(f) == 0 {
 		return nil
@@ -609,6 +640,9 @@ L:
 
 // Float64sDistinct calculates the count of each different element,
 // and only saves these different elements in place if changeSlice is true.
+// NOTE:
+//
+//	Each NaN is a separate map key and +0 is merged with -0, see Float64sDistinctWith for the NaN and signed-zero policy.
 func Float64sDistinct(f *[]float64, changeSlice bool) (distinctCount map[float64]int) {
 	if !changeSlice {
 		return float64sDistinct(*f, nil)
@@ -705,3 +739,769 @@ func Float64SetDifference(set1, set2 []float64, others ...[]float64) []float64 {
 	}
 	return r
 }
+
+// Float64sIsSorted reports whether the slice is sorted in ascending order.
+func Float64sIsSorted(f []float64) bool {
+	for k := 1; k < len(f); k++ {
+		if f[k] < f[k-1] {
+			return false
+		}
+	}
+	return true
+}
+
+// Float64SortedSetUnion calculates between multiple sorted collections: set1 ∪ set2 ∪ others...
+// NOTE:
+//
+//	Every set must be sorted in ascending order (see Float64sIsSorted), and the result is sorted too.
+//	It merges the sets in O(n+m) without building any map.
+//	This method does not change the existing slices, but instead returns a new slice.
+func Float64SortedSetUnion(set1, set2 []float64, others ...[]float64) []float64 {
+	r := float64SortedSetUnion(set1, set2)
+	for _, set := range others {
+		r = float64SortedSetUnion(r, set)
+	}
+	return r
+}
+
+// Float64SortedSetIntersect calculates between multiple sorted collections: set1 ∩ set2 ∩ others...
+// NOTE:
+//
+//	Every set must be sorted in ascending order (see Float64sIsSorted), and the result is sorted too.
+//	It merges the sets in O(n+m) without building any map.
+//	This method does not change the existing slices, but instead returns a new slice.
+func Float64SortedSetIntersect(set1, set2 []float64, others ...[]float64) []float64 {
+	r := float64SortedSetIntersect(set1, set2)
+	for _, set := range others {
+		r = float64SortedSetIntersect(r, set)
+	}
+	return r
+}
+
+// Float64SortedSetDifference calculates between multiple sorted collections: set1 - set2 - others...
+// NOTE:
+//
+//	Every set must be sorted in ascending order (see Float64sIsSorted), and the result is sorted too.
+//	It merges the sets in O(n+m) without building any map.
+//	This method does not change the existing slices, but instead returns a new slice.
+func Float64SortedSetDifference(set1, set2 []float64, others ...[]float64) []float64 {
+	r := float64SortedSetDifference(set1, set2)
+	for _, set := range others {
+		r = float64SortedSetDifference(r, set)
+	}
+	return r
+}
+
+// Float64SortedSetSymmetricDifference calculates between multiple sorted collections: set1 ∆ set2 ∆ others...
+// NOTE:
+//
+//	Every set must be sorted in ascending order (see Float64sIsSorted), and the result is sorted too.
+//	It merges the sets in O(n+m) without building any map.
+//	This method does not change the existing slices, but instead returns a new slice.
+func Float64SortedSetSymmetricDifference(set1, set2 []float64, others ...[]float64) []float64 {
+	r := float64SortedSetSymmetricDifference(set1, set2)
+	for _, set := range others {
+		r = float64SortedSetSymmetricDifference(r, set)
+	}
+	return r
+}
+
+// Float64SortedSetIsSubset reports whether every element of sub is also in set: sub ⊆ set.
+// NOTE:
+//
+//	Both sets must be sorted in ascending order (see Float64sIsSorted).
+func Float64SortedSetIsSubset(sub, set []float64) bool {
+	j := 0
+	for k := 0; k < len(sub); {
+		if j >= len(set) || sub[k] < set[j] {
+			return false
+		}
+		if set[j] < sub[k] {
+			j++
+			continue
+		}
+		k++
+	}
+	return true
+}
+
+func float64SortedSetUnion(a, b []float64) []float64 {
+	r := make([]float64, 0, len(a)+len(b))
+	i, j := 0, 0
+	for i < len(a) || j < len(b) {
+		var v float64
+		switch {
+		case j >= len(b) || i < len(a) && a[i] < b[j]:
+			v = a[i]
+			i++
+		case i >= len(a) || b[j] < a[i]:
+			v = b[j]
+			j++
+		default:
+			v = a[i]
+			i++
+			j++
+		}
+		if n := len(r); n == 0 || r[n-1] != v {
+			r = append(r, v)
+		}
+	}
+	return r
+}
+
+func float64SortedSetIntersect(a, b []float64) []float64 {
+	n := len(a)
+	if len(b) < n {
+		n = len(b)
+	}
+	r := make([]float64, 0, n)
+	i, j := 0, 0
+	for i < len(a) && j < len(b) {
+		switch {
+		case a[i] < b[j]:
+			i++
+		case b[j] < a[i]:
+			j++
+		default:
+			v := a[i]
+			if n := len(r); n == 0 || r[n-1] != v {
+				r = append(r, v)
+			}
+			i++
+			j++
+		}
+	}
+	return r
+}
+
+func float64SortedSetDifference(a, b []float64) []float64 {
+	r := make([]float64, 0, len(a))
+	j := 0
+	for _, v := range a {
+		for j < len(b) && b[j] < v {
+			j++
+		}
+		if j < len(b) && b[j] == v {
+			continue
+		}
+		if n := len(r); n == 0 || r[n-1] != v {
+			r = append(r, v)
+		}
+	}
+	return r
+}
+
+func float64SortedSetSymmetricDifference(a, b []float64) []float64 {
+	r := make([]float64, 0, len(a)+len(b))
+	i, j := 0, 0
+	for i < len(a) || j < len(b) {
+		var v float64
+		switch {
+		case j >= len(b) || i < len(a) && a[i] < b[j]:
+			v = a[i]
+			i++
+		case i >= len(a) || b[j] < a[i]:
+			v = b[j]
+			j++
+		default:
+			v = a[i]
+			for i < len(a) && a[i] == v {
+				i++
+			}
+			for j < len(b) && b[j] == v {
+				j++
+			}
+			continue
+		}
+		if n := len(r); n == 0 || r[n-1] != v {
+			r = append(r, v)
+		}
+	}
+	return r
+}
+
+// Float64sEdit is an edit run of the edit script produced by Float64sDiff.
+type Float64sEdit struct {
+	Kind   DiffKind
+	Values []float64
+}
+
+// Float64sDiff computes the shortest edit script that turns slice a into slice b, see also DiffFunc.
+// This method does not change the existing slices, but instead returns the runs of edits.
+// NOTE:
+//
+//	The elements are compared bitwise, so NaN equals NaN with the same bits, and -0 does not equal +0.
+func Float64sDiff(a, b []float64) []Float64sEdit {
+	spans := DiffFunc(len(a), len(b), func(i, j int) bool { return math.Float64bits(a[i]) == math.Float64bits(b[j]) })
+	r := make([]Float64sEdit, len(spans))
+	for k, span := range spans {
+		r[k].Kind = span.Kind
+		if span.Kind == DiffInsert {
+			r[k].Values = Float64sCopy(b[span.B : span.B+span.Len])
+		} else {
+			r[k].Values = Float64sCopy(a[span.A : span.A+span.Len])
+		}
+	}
+	return r
+}
+
+// Float64sApplyPatch changes the contents of an slice in place by applying the edit script produced by Float64sDiff.
+// NOTE:
+//
+//	If the slice does not match the equal and delete runs of the patch, it returns an error
+//	and the slice will not be modified.
+func Float64sApplyPatch(p *[]float64, patch []Float64sEdit) error {
+	a := *p
+	r := make([]float64, 0, len(a))
+	pos := 0
+	for _, e := range patch {
+		switch e.Kind {
+		case DiffInsert:
+			r = append(r, e.Values...)
+		case DiffEqual, DiffDelete:
+			if pos+len(e.Values) > len(a) {
+				return fmt.Errorf("patch mismatch: %s run exceeds the slice length %d", e.Kind, len(a))
+			}
+			for k, v := range e.Values {
+				if math.Float64bits(a[pos+k]) != math.Float64bits(v) {
+					return fmt.Errorf("patch mismatch: %s run at index %d", e.Kind, pos+k)
+				}
+			}
+			if e.Kind == DiffEqual {
+				r = append(r, e.Values...)
+			}
+			pos += len(e.Values)
+		default:
+			return fmt.Errorf("patch mismatch: unknown edit kind %d", e.Kind)
+		}
+	}
+	if pos != len(a) {
+		return fmt.Errorf("patch mismatch: the slice has %d unpatched elements", len(a)-pos)
+	}
+	n := len(r)
+	*p = r[:n:n]
+	return nil
+}
+
+// Float64sShuffle randomizes the order of elements in place.
+// @r
+//
+//	The source of random numbers, nil means the global source of package math/rand.
+func Float64sShuffle(f []float64, r Randomizer) {
+	shuffleIndex(len(f), len(f), r, func(x, y int) { f[x], f[y] = f[y], f[x] })
+}
+
+// Float64sSample returns k elements randomly selected from the slice without replacement.
+// If k is greater than the length of the slice, all the elements are returned in random order.
+// This method does not change the existing slice, but instead returns a new slice.
+// @r
+//
+//	The source of random numbers, nil means the global source of package math/rand.
+func Float64sSample(f []float64, k int, r Randomizer) []float64 {
+	if k <= 0 {
+		return []float64{}
+	}
+	a := Float64sCopy(f)
+	shuffleIndex(len(a), k, r, func(x, y int) { a[x], a[y] = a[y], a[x] })
+	if k > len(a) {
+		k = len(a)
+	}
+	return a[:k:k]
+}
+
+// Float64sSampleWithReplacement returns k elements randomly selected from the slice with replacement.
+// This method does not change the existing slice, but instead returns a new slice.
+// @r
+//
+//	The source of random numbers, nil means the global source of package math/rand.
+func Float64sSampleWithReplacement(f []float64, k int, r Randomizer) []float64 {
+	if k <= 0 || len(f) == 0 {
+		return []float64{}
+	}
+	r = getRandomizer(r)
+	ret := make([]float64, k)
+	for n := range ret {
+		ret[n] = f[r.Intn(len(f))]
+	}
+	return ret
+}
+
+// Float64sWeightedChoice returns the key-value of an element randomly selected
+// with the probability proportional to its weight.
+// @weights
+//
+//	The weights of the elements with the same indexes, must be finite and non-negative.
+//
+// @r
+//
+//	The source of random numbers, nil means the global source of package math/rand.
+//
+// NOTE:
+//
+//	If the slice is empty, or the weights are invalid, returns an error and k = -1
+func Float64sWeightedChoice(f []float64, weights []float64, r Randomizer) (k int, v float64, err error) {
+	k, err = weightedIndex(len(f), weights, r)
+	if err != nil {
+		return k, v, err
+	}
+	return k, f[k], nil
+}
+
+// Float64sReservoirSample returns k elements randomly selected from a stream of unknown length,
+// in a single pass and with O(k) memory.
+// @next
+//
+//	Returns the next element of the stream, or ok = false at the end of the stream.
+//
+// @r
+//
+//	The source of random numbers, nil means the global source of package math/rand.
+func Float64sReservoirSample(next func() (v float64, ok bool), k int, r Randomizer) []float64 {
+	if k <= 0 {
+		return []float64{}
+	}
+	r = getRandomizer(r)
+	ret := make([]float64, 0, k)
+	for seen := 0; ; seen++ {
+		v, ok := next()
+		if !ok {
+			return ret
+		}
+		switch slot := reservoirIndex(seen, k, r); {
+		case slot == len(ret):
+			ret = append(ret, v)
+		case slot >= 0:
+			ret[slot] = v
+		}
+	}
+}
+
+// Float64sAt returns the element at the given index.
+// @index
+//
+//	Zero-based index of the element. If negative, index will be counted from the end.
+//
+// NOTE:
+//
+//	If the index is out of range, ok = false
+func Float64sAt(f []float64, index int) (v float64, ok bool) {
+	index, ok = atIndex(len(f), index)
+	if !ok {
+		return v, false
+	}
+	return f[index], true
+}
+
+// Float64sWith returns a copy of the slice with the element at the given index replaced with the value.
+// @index
+//
+//	Zero-based index of the element. If negative, index will be counted from the end.
+//
+// NOTE:
+//
+//	If the index is out of range, ok = false
+func Float64sWith(f []float64, index int, value float64) (ret []float64, ok bool) {
+	ret = Float64sCopy(f)
+	index, ok = atIndex(len(f), index)
+	if ok {
+		ret[index] = value
+	}
+	return ret, ok
+}
+
+// Float64sFindIndex returns the index of the first element in the provided slice that satisfies the provided testing function.
+// NOTE:
+//
+//	If not found, returns -1
+func Float64sFindIndex(f []float64, fn func(f []float64, k int, v float64) bool) int {
+	k, _ := Float64sFind(f, fn)
+	return k
+}
+
+// Float64sFindLast returns the key-value of the last element in the provided slice that satisfies the provided testing function.
+// NOTE:
+//
+//	If not found, k = -1
+func Float64sFindLast(f []float64, fn func(f []float64, k int, v float64) bool) (k int, v float64) {
+	for k := len(f) - 1; k >= 0; k-- {
+		if fn(f, k, f[k]) {
+			return k, f[k]
+		}
+	}
+	return -1, v
+}
+
+// Float64sFindLastIndex returns the index of the last element in the provided slice that satisfies the provided testing function.
+// NOTE:
+//
+//	If not found, returns -1
+func Float64sFindLastIndex(f []float64, fn func(f []float64, k int, v float64) bool) int {
+	k, _ := Float64sFindLast(f, fn)
+	return k
+}
+
+// Float64sFlat creates a new slice with all sub-slice elements concatenated into it.
+func Float64sFlat(f [][]float64) []float64 {
+	return Float64sConcat(f...)
+}
+
+// Float64sFlatMap creates a new slice populated with the results of calling a provided function
+// on every element in the calling slice, and then flattening the results.
+func Float64sFlatMap(f []float64, fn func(f []float64, k int, v float64) []float64) []float64 {
+	ret := make([]float64, 0, len(f))
+	for k, v := range f {
+		ret = append(ret, fn(f, k, v)...)
+	}
+	return ret
+}
+
+// Float64sToSorted returns a copy of the slice sorted stably.
+// @less
+//
+//	Reports whether the element a must sort before the element b. If nil, sorts in ascending order.
+func Float64sToSorted(f []float64, less func(a, b float64) bool) []float64 {
+	ret := Float64sCopy(f)
+	if less == nil {
+		less = func(a, b float64) bool { return a < b }
+	}
+	sort.SliceStable(ret, func(x, y int) bool { return less(ret[x], ret[y]) })
+	return ret
+}
+
+// Float64sToReversed returns a copy of the slice in reversed order.
+func Float64sToReversed(f []float64) []float64 {
+	ret := Float64sCopy(f)
+	Float64sReverse(ret)
+	return ret
+}
+
+// Float64sToSpliced returns a copy of the slice with some elements removed and/or replaced at a given index,
+// see also Float64sSplice.
+func Float64sToSpliced(f []float64, start, deleteCount int, items ...float64) []float64 {
+	ret := Float64sCopy(f)
+	Float64sSplice(&ret, start, deleteCount, items...)
+	return ret
+}
+
+// Float64sKeys returns an iterator of the indexes of the slice.
+// NOTE:
+//
+//	The iterator returns ok = false when it is exhausted.
+func Float64sKeys(f []float64) (next func() (k int, ok bool)) {
+	k := -1
+	return func() (int, bool) {
+		if k+1 >= len(f) {
+			return -1, false
+		}
+		k++
+		return k, true
+	}
+}
+
+// Float64sValues returns an iterator of the elements of the slice.
+// NOTE:
+//
+//	The iterator returns ok = false when it is exhausted.
+func Float64sValues(f []float64) (next func() (v float64, ok bool)) {
+	k := -1
+	return func() (v float64, ok bool) {
+		if k+1 >= len(f) {
+			return v, false
+		}
+		k++
+		return f[k], true
+	}
+}
+
+// Float64sEntries returns an iterator of the key-value pairs of the slice.
+// NOTE:
+//
+//	The iterator returns ok = false when it is exhausted.
+func Float64sEntries(f []float64) (next func() (k int, v float64, ok bool)) {
+	k := -1
+	return func() (int, float64, bool) {
+		if k+1 >= len(f) {
+			var v float64
+			return -1, v, false
+		}
+		k++
+		return k, f[k], true
+	}
+}
+
+// Float64sGroup groups the elements of the slice according to the string keys returned by the provided function.
+func Float64sGroup(f []float64, fn func(f []float64, k int, v float64) string) map[string][]float64 {
+	ret := make(map[string][]float64)
+	for k, v := range f {
+		key := fn(f, k, v)
+		ret[key] = append(ret[key], v)
+	}
+	return ret
+}
+
+// Float64sGroupToMap groups the elements of the slice according to the keys returned by the provided function.
+// NOTE:
+//
+//	The keys must be comparable.
+func Float64sGroupToMap(f []float64, fn func(f []float64, k int, v float64) interface{}) map[interface{}][]float64 {
+	ret := make(map[interface{}][]float64)
+	for k, v := range f {
+		key := fn(f, k, v)
+		ret[key] = append(ret[key], v)
+	}
+	return ret
+}
+
+// Float64sEqual reports whether a and b have the same length and the same elements in the same order.
+// NOTE:
+//
+//	The elements are compared by ==, so NaN is not equal to NaN;
+//	see Float64sAlmostEqual and Float64sEqualULP for the explicit NaN policy.
+func Float64sEqual(a, b []float64) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for k, v := range a {
+		if v != b[k] {
+			return false
+		}
+	}
+	return true
+}
+
+// Float64sCompare compares a and b lexicographically, and returns -1 if a < b, 0 if a == b, or +1 if a > b.
+// NOTE:
+//
+//	A slice that is a prefix of another slice is less than it.
+//	NaN is less than any other value and equal to NaN, like sort.Float64s.
+func Float64sCompare(a, b []float64) int {
+	for k := 0; k < len(a) && k < len(b); k++ {
+		if c := compareFloat64(a[k], b[k]); c != 0 {
+			return c
+		}
+	}
+	return compareInt(len(a), len(b))
+}
+
+// Float64sHasPrefix reports whether the slice f begins with prefix.
+func Float64sHasPrefix(f, prefix []float64) bool {
+	return len(f) >= len(prefix) && Float64sEqual(f[:len(prefix)], prefix)
+}
+
+// Float64sHasSuffix reports whether the slice f ends with suffix.
+func Float64sHasSuffix(f, suffix []float64) bool {
+	return len(f) >= len(suffix) && Float64sEqual(f[len(f)-len(suffix):], suffix)
+}
+
+func compareFloat64(a, b float64) int {
+	aNaN, bNaN := a != a, b != b
+	switch {
+	case aNaN || bNaN:
+		return compareBool(!aNaN, !bNaN)
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+	return 0
+}
+
+// Float64sAlmostEqual reports whether a and b have the same length, and each pair of elements
+// is equal within the tolerances (see Float64AlmostEqual).
+// @nan
+//
+//	How NaN elements are regarded.
+func Float64sAlmostEqual(a, b []float64, absTol, relTol float64, nan NaNPolicy) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for k, v := range a {
+		if v != v || b[k] != b[k] {
+			if !nan.nanMatch(v != v, b[k] != b[k]) {
+				return false
+			}
+			continue
+		}
+		if !Float64AlmostEqual(v, b[k], absTol, relTol) {
+			return false
+		}
+	}
+	return true
+}
+
+// Float64sEqualULP reports whether a and b have the same length, and each pair of elements
+// is at most maxULP units in the last place apart (see Float64ULPDistance).
+// @nan
+//
+//	How NaN elements are regarded.
+func Float64sEqualULP(a, b []float64, maxULP uint64, nan NaNPolicy) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for k, v := range a {
+		if v != v || b[k] != b[k] {
+			if !nan.nanMatch(v != v, b[k] != b[k]) {
+				return false
+			}
+			continue
+		}
+		if Float64ULPDistance(v, b[k]) > maxULP {
+			return false
+		}
+	}
+	return true
+}
+
+// Float64sIncludesWith determines whether the slice includes a certain element according to the policy.
+func Float64sIncludesWith(f []float64, valueToFind float64, policy FloatPolicy, fromIndex ...int) bool {
+	return Float64sIndexOfWith(f, valueToFind, policy, fromIndex...) > -1
+}
+
+// Float64sIndexOfWith returns the first index at which a given element can be found according to the policy,
+// or -1 if it is not present.
+func Float64sIndexOfWith(f []float64, searchElement float64, policy FloatPolicy, fromIndex ...int) int {
+	idx := getFromIndex(len(f), fromIndex...)
+	for k, v := range f[idx:] {
+		if Float64EqualWith(searchElement, v, policy) {
+			return k + idx
+		}
+	}
+	return -1
+}
+
+// Float64sLastIndexOfWith returns the last index at which a given element can be found according to the policy,
+// or -1 if it is not present.
+func Float64sLastIndexOfWith(f []float64, searchElement float64, policy FloatPolicy, fromIndex ...int) int {
+	idx := getFromIndex(len(f), fromIndex...)
+	for k := len(f) - 1; k >= idx; k-- {
+		if Float64EqualWith(searchElement, f[k], policy) {
+			return k
+		}
+	}
+	return -1
+}
+
+// Float64sDistinctWith calculates the different elements according to the policy in the order of their first appearance,
+// and the count of each of them.
+// NOTE:
+//
+//	The different elements are saved in place if changeSlice is true, otherwise a new slice is returned.
+//	Each NaN is a different element unless policy.NaN is NaNEqual.
+func Float64sDistinctWith(f *[]float64, changeSlice bool, policy FloatPolicy) (distinct []float64, counts []int) {
+	src := *f
+	if changeSlice {
+		distinct = src[:0]
+	} else {
+		distinct = make([]float64, 0, len(src))
+	}
+	index := make(map[uint64]int, len(src))
+	for _, v := range src {
+		key, ok := float64PolicyKey(v, policy)
+		if ok {
+			if k, found := index[key]; found {
+				counts[k]++
+				continue
+			}
+			index[key] = len(distinct)
+		}
+		distinct = append(distinct, v)
+		counts = append(counts, 1)
+	}
+	if changeSlice {
+		n := len(distinct)
+		*f = distinct[:n:n]
+	}
+	return distinct, counts
+}
+
+// Float64sIntersectWith calculates intersection of two or more slices according to the policy,
+// and returns the common elements in the order of the first slice, and the minimum count of each of them.
+func Float64sIntersectWith(policy FloatPolicy, f ...[]float64) (elements []float64, counts []int) {
+	if len(f) == 0 {
+		return nil, nil
+	}
+	elements, counts = Float64sDistinctWith(&f[0], false, policy)
+	for _, other := range f[1:] {
+		otherCounts := float64PolicyCounts(other, policy)
+		n := 0
+		for k, v := range elements {
+			key, ok := float64PolicyKey(v, policy)
+			if !ok || otherCounts[key] == 0 {
+				continue
+			}
+			elements[n] = v
+			counts[n] = minInt(counts[k], otherCounts[key])
+			n++
+		}
+		elements, counts = elements[:n], counts[:n]
+	}
+	return elements, counts
+}
+
+// Float64SetUnionWith calculates between multiple collections according to the policy: set1 ∪ set2 ∪ others...
+// This method does not change the existing slices, but instead returns a new slice.
+func Float64SetUnionWith(policy FloatPolicy, set1, set2 []float64, others ...[]float64) []float64 {
+	all := Float64sConcat(append([][]float64{set1, set2}, others...)...)
+	r, _ := Float64sDistinctWith(&all, true, policy)
+	return r
+}
+
+// Float64SetIntersectWith calculates between multiple collections according to the policy: set1 ∩ set2 ∩ others...
+// This method does not change the existing slices, but instead returns a new slice.
+func Float64SetIntersectWith(policy FloatPolicy, set1, set2 []float64, others ...[]float64) []float64 {
+	r, _ := Float64sIntersectWith(policy, append([][]float64{set1, set2}, others...)...)
+	return r
+}
+
+// Float64SetDifferenceWith calculates between multiple collections according to the policy: set1 - set2 - others...
+// This method does not change the existing slices, but instead returns a new slice.
+func Float64SetDifferenceWith(policy FloatPolicy, set1, set2 []float64, others ...[]float64) []float64 {
+	m := make(map[uint64]struct{})
+	for _, set := range append([][]float64{set2}, others...) {
+		for _, v := range set {
+			if key, ok := float64PolicyKey(v, policy); ok {
+				m[key] = struct{}{}
+			}
+		}
+	}
+	r, _ := Float64sDistinctWith(&set1, false, policy)
+	n := 0
+	for _, v := range r {
+		if key, ok := float64PolicyKey(v, policy); ok {
+			if _, found := m[key]; found {
+				continue
+			}
+		}
+		r[n] = v
+		n++
+	}
+	return r[:n]
+}
+
+// Float64sIsSortedWith reports whether the slice is sorted in ascending order of Float64CompareWith.
+func Float64sIsSortedWith(f []float64, policy FloatPolicy) bool {
+	for k := 1; k < len(f); k++ {
+		if Float64CompareWith(f[k], f[k-1], policy) < 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// Float64sToSortedWith returns a copy of the slice sorted stably in ascending order of Float64CompareWith,
+// so NaN elements come first.
+func Float64sToSortedWith(f []float64, policy FloatPolicy) []float64 {
+	ret := Float64sCopy(f)
+	sort.SliceStable(ret, func(x, y int) bool { return Float64CompareWith(ret[x], ret[y], policy) < 0 })
+	return ret
+}
+
+func float64PolicyCounts(f []float64, policy FloatPolicy) map[uint64]int {
+	m := make(map[uint64]int, len(f))
+	for _, v := range f {
+		if key, ok := float64PolicyKey(v, policy); ok {
+			m[key]++
+		}
+	}
+	return m
+}
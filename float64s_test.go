@@ -0,0 +1,86 @@
+package ameda
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFloat64sCompare(t *testing.T) {
+	nan := math.NaN()
+	assert.False(t, Float64sEqual([]float64{1, nan}, []float64{1, nan}))
+	assert.True(t, Float64sEqual([]float64{0}, []float64{math.Copysign(0, -1)}))
+	assert.Equal(t, -1, Float64sCompare([]float64{nan}, []float64{math.Inf(-1)}))
+	assert.Equal(t, 0, Float64sCompare([]float64{1, nan}, []float64{1, nan}))
+	assert.Equal(t, 1, Float64sCompare([]float64{2}, []float64{1, 5}))
+
+	a := []float64{1, 100, nan}
+	b := []float64{1.0000001, 100.001, nan}
+	assert.False(t, Float64sAlmostEqual(a, b, 1e-6, 1e-6, NaNEqual))
+	assert.True(t, Float64sAlmostEqual(a, b, 1e-6, 1e-5, NaNEqual))
+	assert.False(t, Float64sAlmostEqual(a, b, 1e-6, 1e-5, NaNNotEqual))
+	assert.True(t, Float64sAlmostEqual(a[:2], b[:2], 1e-2, 0, NaNNotEqual))
+	assert.False(t, Float64sAlmostEqual([]float64{nan}, []float64{1}, 1, 1, NaNEqual))
+	assert.True(t, Float64AlmostEqual(math.Inf(1), math.Inf(1), 0, 0))
+	assert.False(t, Float64AlmostEqual(math.Inf(1), math.MaxFloat64, 1, 1))
+
+	assert.Equal(t, uint64(0), Float64ULPDistance(0, math.Copysign(0, -1)))
+	assert.Equal(t, uint64(1), Float64ULPDistance(1, math.Nextafter(1, 2)))
+	assert.Equal(t, uint64(2), Float64ULPDistance(math.SmallestNonzeroFloat64, -math.SmallestNonzeroFloat64))
+	assert.Equal(t, uint64(1), Float64ULPDistance(math.MaxFloat64, math.Inf(1)))
+	assert.Equal(t, uint64(math.MaxUint64), Float64ULPDistance(nan, nan))
+	assert.Equal(t, uint32(1), Float32ULPDistance(1, math.Nextafter32(1, 0)))
+	assert.True(t, Float64sEqualULP([]float64{a[0] / 10 * 3, nan}, []float64{0.3, nan}, 1, NaNEqual))
+	assert.False(t, Float64sEqualULP([]float64{a[0] / 10 * 3}, []float64{0.3}, 0, NaNEqual))
+	assert.True(t, Float32sEqualULP([]float32{1}, []float32{math.Nextafter32(1, 2)}, 1, NaNNotEqual))
+}
+
+func TestFloat64sPolicy(t *testing.T) {
+	nan, negZero := math.NaN(), math.Copysign(0, -1)
+	ieee := FloatPolicy{}
+	strict := FloatPolicy{NaN: NaNEqual, SignedZero: SignedZeroDistinct}
+	f := []float64{1, nan, 0, negZero, nan, 1}
+
+	assert.Equal(t, -1, Float64sIndexOf(f, nan))
+	assert.Equal(t, -1, Float64sIndexOfWith(f, nan, ieee))
+	assert.Equal(t, 1, Float64sIndexOfWith(f, nan, strict))
+	assert.Equal(t, 4, Float64sLastIndexOfWith(f, nan, strict))
+	assert.Equal(t, 2, Float64sIndexOfWith(f, negZero, ieee))
+	assert.Equal(t, 3, Float64sIndexOfWith(f, negZero, strict))
+	assert.True(t, Float64sIncludesWith(f, nan, strict, 2))
+	assert.False(t, Float64sIncludesWith(f, nan, strict, 5))
+
+	distinct, counts := Float64sDistinctWith(&f, false, ieee)
+	assert.Equal(t, 4, len(distinct))
+	assert.Equal(t, []int{2, 1, 2, 1}, counts)
+	assert.Equal(t, 6, len(f))
+	distinct, counts = Float64sDistinctWith(&f, true, strict)
+	assert.Equal(t, []int{2, 2, 1, 1}, counts)
+	assert.Equal(t, distinct, f)
+	assert.True(t, math.IsNaN(f[1]))
+	assert.True(t, math.Signbit(f[3]))
+
+	elements, counts := Float64sIntersectWith(strict, []float64{nan, nan, negZero, 2}, []float64{nan, 2, 0, 2})
+	assert.Equal(t, 2, len(elements))
+	assert.True(t, math.IsNaN(elements[0]))
+	assert.Equal(t, []int{1, 1}, counts)
+	elements, _ = Float64sIntersectWith(ieee, []float64{nan, negZero}, []float64{nan, 0})
+	assert.Equal(t, []float64{negZero}, elements)
+
+	assert.Equal(t, 3, len(Float64SetUnionWith(strict, []float64{nan, 0}, []float64{nan, negZero})))
+	assert.Equal(t, 3, len(Float64SetUnionWith(ieee, []float64{nan, 0}, []float64{nan, negZero})))
+	assert.Equal(t, []float64{0}, Float64SetIntersectWith(ieee, []float64{0, 1}, []float64{negZero}))
+	assert.Equal(t, 0, len(Float64SetIntersectWith(strict, []float64{0, 1}, []float64{negZero})))
+	assert.Equal(t, []float64{1}, Float64SetDifferenceWith(strict, []float64{nan, 1, nan}, []float64{nan}))
+	assert.Equal(t, 2, len(Float64SetDifferenceWith(ieee, []float64{nan, 1, nan}, []float64{nan}, []float64{1})))
+
+	sorted := Float64sToSortedWith([]float64{1, 0, nan, negZero, -1}, strict)
+	assert.True(t, math.IsNaN(sorted[0]))
+	assert.Equal(t, []float64{-1, 0, 0, 1}, sorted[1:])
+	assert.True(t, math.Signbit(sorted[2]))
+	assert.True(t, Float64sIsSortedWith(sorted, strict))
+	assert.False(t, Float64sIsSortedWith([]float64{0, negZero}, strict))
+	assert.True(t, Float64sIsSortedWith([]float64{0, negZero}, ieee))
+	assert.True(t, Float32EqualWith(float32(nan), float32(nan), strict))
+}
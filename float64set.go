@@ -0,0 +1,231 @@
+package ameda
+
+import "sort"
+
+// Float64Set is a set of float64 elements that remembers the insertion order.
+// NOTE:
+//
+//	The zero value is an empty set ready to use.
+//	All NaNs are the same element, so the set holds at most one NaN, and +0 is the same element as -0.
+//	It is not safe for concurrent use.
+type Float64Set struct {
+	index   map[uint64]int // setKey(element) -> position in elems
+	elems   []float64
+	removed []bool
+	holes   int
+}
+
+// NewFloat64Set creates a set from the elements, see also Float64Set.Slice.
+func NewFloat64Set(elements ...float64) *Float64Set {
+	s := &Float64Set{
+		index: make(map[uint64]int, len(elements)),
+		elems: make([]float64, 0, len(elements)),
+	}
+	s.Add(elements...)
+	return s
+}
+
+// Add adds the elements that are not yet in the set, and returns the new length of the set.
+func (s *Float64Set) Add(elements ...float64) int {
+	if s.index == nil {
+		s.index = make(map[uint64]int, len(elements))
+	}
+	for _, v := range elements {
+		key := float64SetKey(v)
+		if _, ok := s.index[key]; ok {
+			continue
+		}
+		s.index[key] = len(s.elems)
+		s.elems = append(s.elems, v)
+		if s.removed != nil {
+			s.removed = append(s.removed, false)
+		}
+	}
+	return s.Len()
+}
+
+// Remove removes the elements from the set, and returns the new length of the set.
+func (s *Float64Set) Remove(elements ...float64) int {
+	for _, v := range elements {
+		key := float64SetKey(v)
+		k, ok := s.index[key]
+		if !ok {
+			continue
+		}
+		delete(s.index, key)
+		if s.removed == nil {
+			s.removed = make([]bool, len(s.elems))
+		}
+		s.removed[k] = true
+		s.holes++
+	}
+	if s.holes > 0 && s.holes*2 >= len(s.elems) {
+		s.compact()
+	}
+	return s.Len()
+}
+
+// Has reports whether the element is in the set.
+func (s *Float64Set) Has(element float64) bool {
+	_, ok := s.index[float64SetKey(element)]
+	return ok
+}
+
+// Len returns the number of elements in the set.
+func (s *Float64Set) Len() int {
+	return len(s.index)
+}
+
+// Clear removes all elements from the set.
+func (s *Float64Set) Clear() {
+	*s = Float64Set{}
+}
+
+// Clone creates a copy of the set.
+func (s *Float64Set) Clone() *Float64Set {
+	r := &Float64Set{
+		index: make(map[uint64]int, s.Len()),
+		elems: make([]float64, 0, s.Len()),
+	}
+	s.Range(func(v float64) bool {
+		r.index[float64SetKey(v)] = len(r.elems)
+		r.elems = append(r.elems, v)
+		return true
+	})
+	return r
+}
+
+// Range calls fn for each element in insertion order; if fn returns false, range stops the iteration.
+func (s *Float64Set) Range(fn func(v float64) bool) {
+	for k, v := range s.elems {
+		if s.removed != nil && s.removed[k] {
+			continue
+		}
+		if !fn(v) {
+			return
+		}
+	}
+}
+
+// RangeSorted calls fn for each element in ascending order; if fn returns false, range stops the iteration.
+func (s *Float64Set) RangeSorted(fn func(v float64) bool) {
+	for _, v := range s.SortedSlice() {
+		if !fn(v) {
+			return
+		}
+	}
+}
+
+// Slice returns the elements in insertion order.
+func (s *Float64Set) Slice() []float64 {
+	r := make([]float64, 0, s.Len())
+	s.Range(func(v float64) bool {
+		r = append(r, v)
+		return true
+	})
+	return r
+}
+
+// SortedSlice returns the elements in ascending order, NaN first.
+func (s *Float64Set) SortedSlice() []float64 {
+	r := s.Slice()
+	sort.Slice(r, func(i, j int) bool { return compareFloat64(r[i], r[j]) < 0 })
+	return r
+}
+
+// Union returns a new set: s ∪ others...
+func (s *Float64Set) Union(others ...*Float64Set) *Float64Set {
+	r := s.Clone()
+	for _, o := range others {
+		o.Range(func(v float64) bool {
+			r.Add(v)
+			return true
+		})
+	}
+	return r
+}
+
+// Intersect returns a new set: s ∩ others...
+func (s *Float64Set) Intersect(others ...*Float64Set) *Float64Set {
+	r := NewFloat64Set()
+	s.Range(func(v float64) bool {
+		for _, o := range others {
+			if !o.Has(v) {
+				return true
+			}
+		}
+		r.Add(v)
+		return true
+	})
+	return r
+}
+
+// Difference returns a new set: s - others...
+func (s *Float64Set) Difference(others ...*Float64Set) *Float64Set {
+	r := NewFloat64Set()
+	s.Range(func(v float64) bool {
+		for _, o := range others {
+			if o.Has(v) {
+				return true
+			}
+		}
+		r.Add(v)
+		return true
+	})
+	return r
+}
+
+// SymmetricDifference returns a new set of the elements that are in exactly one of the two sets: s ∆ other.
+func (s *Float64Set) SymmetricDifference(other *Float64Set) *Float64Set {
+	r := s.Difference(other)
+	other.Range(func(v float64) bool {
+		if !s.Has(v) {
+			r.Add(v)
+		}
+		return true
+	})
+	return r
+}
+
+// IsSubset reports whether every element of s is also in other: s ⊆ other.
+func (s *Float64Set) IsSubset(other *Float64Set) bool {
+	if s.Len() > other.Len() {
+		return false
+	}
+	ok := true
+	s.Range(func(v float64) bool {
+		ok = other.Has(v)
+		return ok
+	})
+	return ok
+}
+
+// IsSuperset reports whether every element of other is also in s: s ⊇ other.
+func (s *Float64Set) IsSuperset(other *Float64Set) bool {
+	return other.IsSubset(s)
+}
+
+// Equal reports whether the two sets contain the same elements, regardless of the order.
+func (s *Float64Set) Equal(other *Float64Set) bool {
+	return s.Len() == other.Len() && s.IsSubset(other)
+}
+
+func (s *Float64Set) compact() {
+	elems := s.elems[:0]
+	for k, v := range s.elems {
+		if s.removed[k] {
+			continue
+		}
+		s.index[float64SetKey(v)] = len(elems)
+		elems = append(elems, v)
+	}
+	s.elems = elems
+	s.removed = nil
+	s.holes = 0
+}
+
+// float64SetKey returns the index key of v, which is the same for all NaNs and for ±0.
+func float64SetKey(v float64) uint64 {
+	key, _ := float64PolicyKey(v, FloatPolicy{NaN: NaNEqual})
+	return key
+}
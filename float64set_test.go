@@ -0,0 +1,56 @@
+package ameda
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFloat64SetNaN(t *testing.T) {
+	nan := math.NaN()
+	s := NewFloat64Set(nan, nan, 1, math.Float64frombits(0x7ff8000000000123))
+	assert.Equal(t, 2, s.Len())
+	assert.Equal(t, 2, len(s.Slice()))
+	assert.True(t, math.IsNaN(s.Slice()[0]))
+	assert.True(t, s.Has(nan))
+	assert.True(t, s.Has(-nan))
+	assert.Equal(t, 2, s.Add(nan))
+	assert.True(t, math.IsNaN(s.SortedSlice()[0]))
+
+	assert.Equal(t, 1, s.Remove(nan))
+	assert.False(t, s.Has(nan))
+	assert.Equal(t, []float64{1}, s.Slice())
+
+	// ±0 are the same element
+	s = NewFloat64Set(0, math.Copysign(0, -1))
+	assert.Equal(t, 1, s.Len())
+	assert.True(t, s.Has(math.Copysign(0, -1)))
+
+	// Len matches Slice after the compaction
+	s = NewFloat64Set(nan, 1, 2, 3, 4)
+	assert.Equal(t, 3, s.Remove(1, 2))
+	assert.Equal(t, 4, s.Add(nan, 5))
+	assert.Equal(t, 4, len(s.Slice()))
+	assert.True(t, s.Has(nan))
+	assert.Equal(t, 3, s.Remove(nan))
+	assert.Equal(t, []float64{3, 4, 5}, s.Slice())
+
+	a, b := NewFloat64Set(nan, 1), NewFloat64Set(1, nan)
+	assert.True(t, a.Equal(b))
+	assert.Equal(t, 2, a.Union(b).Len())
+	assert.Equal(t, 2, a.Intersect(b).Len())
+	assert.Equal(t, 0, a.Difference(b).Len())
+	assert.Equal(t, 2, a.Clone().Len())
+	assert.True(t, a.Clone().Has(nan))
+}
+
+func TestFloat32SetNaN(t *testing.T) {
+	nan := float32(math.NaN())
+	s := NewFloat32Set(nan, nan, 1)
+	assert.Equal(t, 2, s.Len())
+	assert.Equal(t, 2, len(s.Slice()))
+	assert.True(t, s.Has(nan))
+	assert.Equal(t, 1, s.Remove(nan))
+	assert.Equal(t, []float32{1}, s.Slice())
+}
@@ -0,0 +1,373 @@
+package ameda
+
+import (
+	"sync"
+	"sync/atomic"
+)
+
+// SyncFloat64s is a float64 slice that is safe for concurrent use.
+// NOTE:
+//
+//	The zero value is an empty slice ready to use.
+//	Point reads such as Len and At use the read lock without copying the elements.
+//	Callbacks are run on a snapshot, so they can call the methods of the same SyncFloat64s.
+type SyncFloat64s struct {
+	mu   sync.RWMutex
+	data []float64
+	snap atomic.Value // []float64: read-only copy of data, nil after modification
+}
+
+// NewSyncFloat64s creates a SyncFloat64s with a copy of the elements.
+func NewSyncFloat64s(elements ...float64) *SyncFloat64s {
+	return &SyncFloat64s{data: Float64sCopy(elements)}
+}
+
+// Snapshot returns a read-only copy of the current elements.
+// NOTE:
+//
+//	The result is shared with other callers until the next modification, so it must not be changed.
+//	While there is no modification, it is an atomic read without locking.
+func (s *SyncFloat64s) Snapshot() []float64 {
+	if snap, _ := s.snap.Load().([]float64); snap != nil {
+		return snap
+	}
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	snap := Float64sCopy(s.data)
+	s.snap.Store(snap)
+	return snap
+}
+
+// Slice returns a copy of the current elements that can be changed.
+func (s *SyncFloat64s) Slice() []float64 {
+	return Float64sCopy(s.Snapshot())
+}
+
+// Len returns the number of elements.
+func (s *SyncFloat64s) Len() int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return len(s.data)
+}
+
+// At returns the element at the index, which can be negative and counted from the end.
+func (s *SyncFloat64s) At(index int) (v float64, ok bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return Float64sAt(s.data, index)
+}
+
+// Includes determines whether the slice includes a certain element.
+func (s *SyncFloat64s) Includes(valueToFind float64, fromIndex ...int) bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return Float64sIncludes(s.data, valueToFind, fromIndex...)
+}
+
+// IndexOf returns the first index at which a given element can be found, or -1 if it is not present.
+func (s *SyncFloat64s) IndexOf(searchElement float64, fromIndex ...int) int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return Float64sIndexOf(s.data, searchElement, fromIndex...)
+}
+
+// LastIndexOf returns the last index at which a given element can be found, or -1 if it is not present.
+func (s *SyncFloat64s) LastIndexOf(searchElement float64, fromIndex ...int) int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return Float64sLastIndexOf(s.data, searchElement, fromIndex...)
+}
+
+// Range calls fn for each element of a snapshot in order, until fn returns false.
+func (s *SyncFloat64s) Range(fn func(k int, v float64) bool) {
+	for k, v := range s.Snapshot() {
+		if !fn(k, v) {
+			return
+		}
+	}
+}
+
+// Every tests whether all elements of a snapshot pass the test implemented by fn.
+func (s *SyncFloat64s) Every(fn func(f []float64, k int, v float64) bool) bool {
+	return Float64sEvery(s.Snapshot(), fn)
+}
+
+// Some tests whether at least one element of a snapshot passes the test implemented by fn.
+func (s *SyncFloat64s) Some(fn func(f []float64, k int, v float64) bool) bool {
+	return Float64sSome(s.Snapshot(), fn)
+}
+
+// Find returns the key-value of the first element of a snapshot that satisfies fn.
+// If no element satisfies fn, -1 is returned.
+func (s *SyncFloat64s) Find(fn func(f []float64, k int, v float64) bool) (k int, v float64) {
+	return Float64sFind(s.Snapshot(), fn)
+}
+
+// Filter creates a new slice with the elements of a snapshot that pass the test implemented by fn.
+func (s *SyncFloat64s) Filter(fn func(f []float64, k int, v float64) bool) []float64 {
+	return Float64sFilter(s.Snapshot(), fn)
+}
+
+// Map creates a new slice with the results of calling fn on every element of a snapshot.
+func (s *SyncFloat64s) Map(fn func(f []float64, k int, v float64) float64) []float64 {
+	return Float64sMap(s.Snapshot(), fn)
+}
+
+// Reduce executes fn on each element of a snapshot, resulting in a single output value.
+func (s *SyncFloat64s) Reduce(fn func(f []float64, k int, v, accumulator float64) float64, initialValue ...float64) float64 {
+	return Float64sReduce(s.Snapshot(), fn, initialValue...)
+}
+
+// Set changes the element at the index, which can be negative and counted from the end,
+// and reports whether the index is in range.
+func (s *SyncFloat64s) Set(index int, value float64) bool {
+	s.mu.Lock()
+	defer s.unlock()
+	k, ok := atIndex(len(s.data), index)
+	if ok {
+		s.data[k] = value
+	}
+	return ok
+}
+
+// Push adds one or more elements to the end, and returns the new length.
+func (s *SyncFloat64s) Push(element ...float64) int {
+	s.mu.Lock()
+	defer s.unlock()
+	return Float64sPush(&s.data, element...)
+}
+
+// PushDistinct adds one or more new elements that do not exist at the end, and returns the new length.
+func (s *SyncFloat64s) PushDistinct(element ...float64) int {
+	s.mu.Lock()
+	defer s.unlock()
+	s.data = Float64sPushDistinct(s.data, element...)
+	return len(s.data)
+}
+
+// Pop removes the last element and returns that element.
+func (s *SyncFloat64s) Pop() (float64, bool) {
+	s.mu.Lock()
+	defer s.unlock()
+	return Float64sPop(&s.data)
+}
+
+// Shift removes the first element and returns that element.
+func (s *SyncFloat64s) Shift() (float64, bool) {
+	s.mu.Lock()
+	defer s.unlock()
+	return Float64sShift(&s.data)
+}
+
+// Unshift adds one or more elements to the beginning, and returns the new length.
+func (s *SyncFloat64s) Unshift(element ...float64) int {
+	s.mu.Lock()
+	defer s.unlock()
+	return Float64sUnshift(&s.data, element...)
+}
+
+// UnshiftDistinct adds one or more new elements that do not exist to the beginning, and returns the new length.
+func (s *SyncFloat64s) UnshiftDistinct(element ...float64) int {
+	s.mu.Lock()
+	defer s.unlock()
+	return Float64sUnshiftDistinct(&s.data, element...)
+}
+
+// Splice removes deleteCount elements from start and adds the items in their place.
+func (s *SyncFloat64s) Splice(start, deleteCount int, items ...float64) {
+	s.mu.Lock()
+	defer s.unlock()
+	Float64sSplice(&s.data, start, deleteCount, items...)
+}
+
+// RemoveFirst removes the first matched elements, and returns the new length.
+func (s *SyncFloat64s) RemoveFirst(elements ...float64) int {
+	s.mu.Lock()
+	defer s.unlock()
+	return Float64sRemoveFirst(&s.data, elements...)
+}
+
+// RemoveEvery removes all the elements, and returns the new length.
+func (s *SyncFloat64s) RemoveEvery(elements ...float64) int {
+	s.mu.Lock()
+	defer s.unlock()
+	return Float64sRemoveEvery(&s.data, elements...)
+}
+
+// Distinct removes the duplicate elements, and returns the count of each element.
+func (s *SyncFloat64s) Distinct() (distinctCount map[float64]int) {
+	s.mu.Lock()
+	defer s.unlock()
+	return Float64sDistinct(&s.data, true)
+}
+
+// Fill changes all elements from start to end to a static value.
+func (s *SyncFloat64s) Fill(value float64, start int, end ...int) {
+	s.mu.Lock()
+	defer s.unlock()
+	Float64sFill(s.data, value, start, end...)
+}
+
+// CopyWithin copies part of the slice to another location in the same slice.
+func (s *SyncFloat64s) CopyWithin(target, start int, end ...int) {
+	s.mu.Lock()
+	defer s.unlock()
+	Float64sCopyWithin(s.data, target, start, end...)
+}
+
+// Reverse reverses the elements in place.
+func (s *SyncFloat64s) Reverse() {
+	s.mu.Lock()
+	defer s.unlock()
+	Float64sReverse(s.data)
+}
+
+// Shuffle randomizes the order of the elements in place.
+func (s *SyncFloat64s) Shuffle(r Randomizer) {
+	s.mu.Lock()
+	defer s.unlock()
+	Float64sShuffle(s.data, r)
+}
+
+// ApplyPatch applies the edit script to the elements.
+func (s *SyncFloat64s) ApplyPatch(patch []Float64sEdit) error {
+	s.mu.Lock()
+	defer s.unlock()
+	return Float64sApplyPatch(&s.data, patch)
+}
+
+// Update calls fn with the pointer of the elements under the write lock.
+// NOTE:
+//
+//	fn must not call the methods of the same SyncFloat64s, and must not retain the slice.
+func (s *SyncFloat64s) Update(fn func(p *[]float64)) {
+	s.mu.Lock()
+	defer s.unlock()
+	fn(&s.data)
+}
+
+// Clear removes all elements.
+func (s *SyncFloat64s) Clear() {
+	s.mu.Lock()
+	defer s.unlock()
+	s.data = nil
+}
+
+// unlock invalidates the snapshot and releases the write lock.
+func (s *SyncFloat64s) unlock() {
+	s.snap.Store([]float64(nil))
+	s.mu.Unlock()
+}
+
+// SyncFloat64Set is a float64 set that is safe for concurrent use.
+// NOTE:
+//
+//	The zero value is an empty set ready to use.
+//	Len and Has use the read lock without copying the elements.
+//	Callbacks are run on a snapshot, so they can call the methods of the same SyncFloat64Set.
+type SyncFloat64Set struct {
+	mu   sync.RWMutex
+	set  *Float64Set
+	snap atomic.Value // []float64: read-only elements in insertion order, nil after modification
+}
+
+// NewSyncFloat64Set creates a SyncFloat64Set with the elements.
+func NewSyncFloat64Set(elements ...float64) *SyncFloat64Set {
+	return &SyncFloat64Set{set: NewFloat64Set(elements...)}
+}
+
+// Snapshot returns the read-only elements in insertion order.
+// NOTE:
+//
+//	The result is shared with other callers until the next modification, so it must not be changed.
+//	While there is no modification, it is an atomic read without locking.
+func (s *SyncFloat64Set) Snapshot() []float64 {
+	if snap, _ := s.snap.Load().([]float64); snap != nil {
+		return snap
+	}
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	var snap []float64
+	if s.set != nil {
+		snap = s.set.Slice()
+	}
+	if snap == nil {
+		snap = []float64{}
+	}
+	s.snap.Store(snap)
+	return snap
+}
+
+// Add adds the elements that are not yet in the set, and returns the new length of the set.
+func (s *SyncFloat64Set) Add(elements ...float64) int {
+	s.mu.Lock()
+	defer s.unlock()
+	if s.set == nil {
+		s.set = NewFloat64Set()
+	}
+	return s.set.Add(elements...)
+}
+
+// Remove removes the elements from the set, and returns the new length of the set.
+func (s *SyncFloat64Set) Remove(elements ...float64) int {
+	s.mu.Lock()
+	defer s.unlock()
+	if s.set == nil {
+		return 0
+	}
+	return s.set.Remove(elements...)
+}
+
+// Has reports whether the element is in the set.
+func (s *SyncFloat64Set) Has(element float64) bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.set != nil && s.set.Has(element)
+}
+
+// Len returns the number of elements in the set.
+func (s *SyncFloat64Set) Len() int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	if s.set == nil {
+		return 0
+	}
+	return s.set.Len()
+}
+
+// Clear removes all elements.
+func (s *SyncFloat64Set) Clear() {
+	s.mu.Lock()
+	defer s.unlock()
+	s.set = nil
+}
+
+// Range calls fn for each element of a snapshot in insertion order, until fn returns false.
+func (s *SyncFloat64Set) Range(fn func(v float64) bool) {
+	for _, v := range s.Snapshot() {
+		if !fn(v) {
+			return
+		}
+	}
+}
+
+// Slice returns a copy of the elements in insertion order.
+func (s *SyncFloat64Set) Slice() []float64 {
+	return Float64sCopy(s.Snapshot())
+}
+
+// SortedSlice returns a copy of the elements in ascending order.
+func (s *SyncFloat64Set) SortedSlice() []float64 {
+	return NewFloat64Set(s.Snapshot()...).SortedSlice()
+}
+
+// Clone returns a Float64Set with the current elements.
+func (s *SyncFloat64Set) Clone() *Float64Set {
+	return NewFloat64Set(s.Snapshot()...)
+}
+
+// unlock invalidates the snapshot and releases the write lock.
+func (s *SyncFloat64Set) unlock() {
+	s.snap.Store([]float64(nil))
+	s.mu.Unlock()
+}
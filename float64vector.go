@@ -0,0 +1,271 @@
+package ameda
+
+import (
+	"errors"
+	"math"
+)
+
+// Float64sAdd returns a new slice of the element-wise sum: a[k] + b[k].
+// NOTE:
+//
+//	If the lengths are different, returns an error.
+func Float64sAdd(a, b []float64) ([]float64, error) {
+	if len(a) != len(b) {
+		return nil, errLengthMismatch
+	}
+	r := make([]float64, len(a))
+	b = b[:len(a)]
+	k := 0
+	for ; k+4 <= len(a); k += 4 {
+		r[k] = a[k] + b[k]
+		r[k+1] = a[k+1] + b[k+1]
+		r[k+2] = a[k+2] + b[k+2]
+		r[k+3] = a[k+3] + b[k+3]
+	}
+	for ; k < len(a); k++ {
+		r[k] = a[k] + b[k]
+	}
+	return r, nil
+}
+
+// Float64sSub returns a new slice of the element-wise difference: a[k] - b[k].
+// NOTE:
+//
+//	If the lengths are different, returns an error.
+func Float64sSub(a, b []float64) ([]float64, error) {
+	if len(a) != len(b) {
+		return nil, errLengthMismatch
+	}
+	r := make([]float64, len(a))
+	b = b[:len(a)]
+	k := 0
+	for ; k+4 <= len(a); k += 4 {
+		r[k] = a[k] - b[k]
+		r[k+1] = a[k+1] - b[k+1]
+		r[k+2] = a[k+2] - b[k+2]
+		r[k+3] = a[k+3] - b[k+3]
+	}
+	for ; k < len(a); k++ {
+		r[k] = a[k] - b[k]
+	}
+	return r, nil
+}
+
+// Float64sMul returns a new slice of the element-wise product: a[k] * b[k].
+// NOTE:
+//
+//	If the lengths are different, returns an error.
+func Float64sMul(a, b []float64) ([]float64, error) {
+	if len(a) != len(b) {
+		return nil, errLengthMismatch
+	}
+	r := make([]float64, len(a))
+	b = b[:len(a)]
+	k := 0
+	for ; k+4 <= len(a); k += 4 {
+		r[k] = a[k] * b[k]
+		r[k+1] = a[k+1] * b[k+1]
+		r[k+2] = a[k+2] * b[k+2]
+		r[k+3] = a[k+3] * b[k+3]
+	}
+	for ; k < len(a); k++ {
+		r[k] = a[k] * b[k]
+	}
+	return r, nil
+}
+
+// Float64sDiv returns a new slice of the element-wise quotient: a[k] / b[k].
+// NOTE:
+//
+//	If the lengths are different, returns an error.
+//	Division by zero follows IEEE 754, i.e. results in ±Inf or NaN.
+func Float64sDiv(a, b []float64) ([]float64, error) {
+	if len(a) != len(b) {
+		return nil, errLengthMismatch
+	}
+	r := make([]float64, len(a))
+	b = b[:len(a)]
+	k := 0
+	for ; k+4 <= len(a); k += 4 {
+		r[k] = a[k] / b[k]
+		r[k+1] = a[k+1] / b[k+1]
+		r[k+2] = a[k+2] / b[k+2]
+		r[k+3] = a[k+3] / b[k+3]
+	}
+	for ; k < len(a); k++ {
+		r[k] = a[k] / b[k]
+	}
+	return r, nil
+}
+
+// Float64sScale returns a new slice with every element multiplied by c.
+func Float64sScale(f []float64, c float64) []float64 {
+	r := make([]float64, len(f))
+	k := 0
+	for ; k+4 <= len(f); k += 4 {
+		r[k] = f[k] * c
+		r[k+1] = f[k+1] * c
+		r[k+2] = f[k+2] * c
+		r[k+3] = f[k+3] * c
+	}
+	for ; k < len(f); k++ {
+		r[k] = f[k] * c
+	}
+	return r
+}
+
+// Float64sDot returns the dot product of the two vectors: Σ a[k] * b[k].
+// NOTE:
+//
+//	If the lengths are different, returns an error.
+func Float64sDot(a, b []float64) (float64, error) {
+	if len(a) != len(b) {
+		return 0, errLengthMismatch
+	}
+	return float64Dot(a, b), nil
+}
+
+// Float64sNormL1 returns the L1 (Manhattan) norm of the vector: Σ |f[k]|.
+func Float64sNormL1(f []float64) float64 {
+	var s0, s1, s2, s3 float64
+	k := 0
+	for ; k+4 <= len(f); k += 4 {
+		s0 += math.Abs(f[k])
+		s1 += math.Abs(f[k+1])
+		s2 += math.Abs(f[k+2])
+		s3 += math.Abs(f[k+3])
+	}
+	for ; k < len(f); k++ {
+		s0 += math.Abs(f[k])
+	}
+	return s0 + s1 + s2 + s3
+}
+
+// Float64sNormL2 returns the L2 (Euclidean) norm of the vector: √(Σ f[k]²).
+func Float64sNormL2(f []float64) float64 {
+	return math.Sqrt(float64Dot(f, f))
+}
+
+// Float64sNormInf returns the infinity (maximum) norm of the vector: max |f[k]|.
+func Float64sNormInf(f []float64) float64 {
+	var m float64
+	for _, v := range f {
+		if v < 0 {
+			v = -v
+		}
+		if v > m || v != v {
+			m = v
+		}
+	}
+	return m
+}
+
+// Float64sNormalize returns a new slice of the unit vector with the same direction: f / ‖f‖₂.
+// NOTE:
+//
+//	If the norm is zero, returns an error.
+func Float64sNormalize(f []float64) ([]float64, error) {
+	n := Float64sNormL2(f)
+	if n == 0 {
+		return nil, errors.New("zero vector cannot be normalized")
+	}
+	r := make([]float64, len(f))
+	for k, v := range f {
+		r[k] = v / n
+	}
+	return r, nil
+}
+
+// Float64sCosineSimilarity returns the cosine of the angle between the two vectors: a·b / (‖a‖₂ ‖b‖₂).
+// NOTE:
+//
+//	If the lengths are different or any vector is zero, returns an error.
+func Float64sCosineSimilarity(a, b []float64) (float64, error) {
+	if len(a) != len(b) {
+		return 0, errLengthMismatch
+	}
+	na, nb := Float64sNormL2(a), Float64sNormL2(b)
+	if na == 0 || nb == 0 {
+		return 0, errors.New("cosine similarity is undefined for zero vector")
+	}
+	return float64Dot(a, b) / na / nb, nil
+}
+
+// Float64sCumSum returns a new slice of the cumulative sums: r[k] = f[0] + ... + f[k].
+func Float64sCumSum(f []float64) []float64 {
+	r := make([]float64, len(f))
+	var acc float64
+	for k, v := range f {
+		acc += v
+		r[k] = acc
+	}
+	return r
+}
+
+// Float64sCumProd returns a new slice of the cumulative products: r[k] = f[0] * ... * f[k].
+func Float64sCumProd(f []float64) []float64 {
+	r := make([]float64, len(f))
+	var acc float64 = 1
+	for k, v := range f {
+		acc *= v
+		r[k] = acc
+	}
+	return r
+}
+
+// Float64sAdjacentDiff returns a new slice of the differences between adjacent elements: r[k] = f[k+1] - f[k].
+// The result has one element less than f.
+func Float64sAdjacentDiff(f []float64) []float64 {
+	if len(f) < 2 {
+		return []float64{}
+	}
+	r := make([]float64, len(f)-1)
+	for k := range r {
+		r[k] = f[k+1] - f[k]
+	}
+	return r
+}
+
+// Float64sArgmin returns the index of the first minimum element.
+// NOTE:
+//
+//	NaN is ignored; if there is no element other than NaN, returns -1
+func Float64sArgmin(f []float64) int {
+	idx := -1
+	for k, v := range f {
+		if v == v && (idx < 0 || v < f[idx]) {
+			idx = k
+		}
+	}
+	return idx
+}
+
+// Float64sArgmax returns the index of the first maximum element.
+// NOTE:
+//
+//	NaN is ignored; if there is no element other than NaN, returns -1
+func Float64sArgmax(f []float64) int {
+	idx := -1
+	for k, v := range f {
+		if v == v && (idx < 0 || v > f[idx]) {
+			idx = k
+		}
+	}
+	return idx
+}
+
+func float64Dot(a, b []float64) float64 {
+	var s0, s1, s2, s3 float64
+	b = b[:len(a)]
+	k := 0
+	for ; k+4 <= len(a); k += 4 {
+		s0 += a[k] * b[k]
+		s1 += a[k+1] * b[k+1]
+		s2 += a[k+2] * b[k+2]
+		s3 += a[k+3] * b[k+3]
+	}
+	for ; k < len(a); k++ {
+		s0 += a[k] * b[k]
+	}
+	return s0 + s1 + s2 + s3
+}
@@ -0,0 +1,79 @@
+package ameda
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFloat64sElementWise(t *testing.T) {
+	a := []float64{1, 2, 3, 4, 5}
+	b := []float64{5, 4, 3, 2, 1}
+	r, err := Float64sAdd(a, b)
+	assert.NoError(t, err)
+	assert.Equal(t, []float64{6, 6, 6, 6, 6}, r)
+	r, _ = Float64sSub(a, b)
+	assert.Equal(t, []float64{-4, -2, 0, 2, 4}, r)
+	r, _ = Float64sMul(a, b)
+	assert.Equal(t, []float64{5, 8, 9, 8, 5}, r)
+	r, _ = Float64sDiv(a, b)
+	assert.Equal(t, []float64{0.2, 0.5, 1, 2, 5}, r)
+	assert.Equal(t, []float64{2, 4, 6, 8, 10}, Float64sScale(a, 2))
+
+	_, err = Float64sAdd(a, b[1:])
+	assert.Error(t, err)
+	_, err = Float64sDot(a, nil)
+	assert.Error(t, err)
+}
+
+func TestFloat64sNorm(t *testing.T) {
+	a := []float64{3, -4, 0, 0, 0, 0}
+	dot, err := Float64sDot(a, a)
+	assert.NoError(t, err)
+	assert.Equal(t, 25.0, dot)
+	assert.Equal(t, 7.0, Float64sNormL1(a))
+	assert.Equal(t, 5.0, Float64sNormL2(a))
+	assert.Equal(t, 4.0, Float64sNormInf(a))
+	assert.True(t, math.IsNaN(Float64sNormInf([]float64{1, math.NaN()})))
+
+	n, err := Float64sNormalize(a)
+	assert.NoError(t, err)
+	assert.Equal(t, []float64{0.6, -0.8, 0, 0, 0, 0}, n)
+	_, err = Float64sNormalize([]float64{0, 0})
+	assert.Error(t, err)
+
+	c, err := Float64sCosineSimilarity([]float64{1, 0}, []float64{1, 1})
+	assert.NoError(t, err)
+	assert.InDelta(t, math.Sqrt2/2, c, 1e-12)
+	_, err = Float64sCosineSimilarity([]float64{1, 0}, []float64{0, 0})
+	assert.Error(t, err)
+}
+
+func TestFloat64sCumulative(t *testing.T) {
+	a := []float64{1, 2, 3, 4}
+	assert.Equal(t, []float64{1, 3, 6, 10}, Float64sCumSum(a))
+	assert.Equal(t, []float64{1, 2, 6, 24}, Float64sCumProd(a))
+	assert.Equal(t, []float64{1, 1, 1}, Float64sAdjacentDiff(a))
+	assert.Equal(t, []float64{}, Float64sAdjacentDiff(a[:1]))
+
+	b := []float64{math.NaN(), 3, -1, 7, -1, 7}
+	assert.Equal(t, 2, Float64sArgmin(b))
+	assert.Equal(t, 3, Float64sArgmax(b))
+	assert.Equal(t, -1, Float64sArgmax([]float64{math.NaN()}))
+}
+
+func TestFloat32sAccumulator(t *testing.T) {
+	// 1<<24 + 1 rounds to 1<<24 in float32, so the sums must be accumulated in float64
+	a := []float32{1 << 24, 0, 0, 0, 1, 0, 0, 0, 1}
+	ones := []float32{1, 1, 1, 1, 1, 1, 1, 1, 1}
+	d, err := Float32sDot(a, ones)
+	assert.NoError(t, err)
+	assert.Equal(t, float32(1<<24+2), d)
+	assert.Equal(t, float32(1<<24+2), Float32sNormL1(a))
+	assert.Equal(t, float32(1<<24+2), Float32sCumSum(a)[8])
+	assert.Equal(t, float32(5), Float32sNormL2([]float32{3, 4}))
+	c, err := Float32sCosineSimilarity([]float32{1, 0}, []float32{1, 1})
+	assert.NoError(t, err)
+	assert.Equal(t, float32(math.Sqrt2/2), c)
+}
@@ -0,0 +1,229 @@
+package ameda
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"math/bits"
+	"strings"
+)
+
+// HashIDConfig is the configuration of HashID.
+type HashIDConfig struct {
+	// Salt shuffles the alphabet, so the IDs of different salts are different.
+	Salt string
+	// Alphabet is the characters of the IDs, that must contain at least 3 unique characters.
+	// Defaults to 0-9a-zA-Z.
+	Alphabet string
+	// MinLength is the minimum length of the IDs, that are padded if shorter.
+	MinLength int
+	// Blocklist is the words that must not appear in the IDs, case-insensitively.
+	// NOTE:
+	//
+	//	Words with less than 3 characters are ignored.
+	Blocklist []string
+}
+
+// HashID encodes one or more unsigned integers into a short, non-sequential and reversible ID,
+// that is suitable for exposing database IDs in URLs, like Hashids and Sqids.
+// NOTE:
+//
+//	It is an obfuscation, not an encryption: do not use it for secrets.
+//	It is safe for concurrent use.
+type HashID struct {
+	alphabet  []byte
+	minLength int
+	blocklist []string
+}
+
+var (
+	errHashIDInvalid     = errors.New("invalid hash id")
+	errHashIDMaxAttempts = errors.New("reached max attempts to avoid the blocklist")
+)
+
+// NewHashID creates a HashID with the config.
+func NewHashID(config HashIDConfig) (*HashID, error) {
+	alphabet := config.Alphabet
+	if alphabet == "" {
+		alphabet = digits
+	}
+	if len(alphabet) < 3 {
+		return nil, fmt.Errorf("alphabet must contain at least 3 characters, got %d", len(alphabet))
+	}
+	var seen [256]bool
+	for k := 0; k < len(alphabet); k++ {
+		if seen[alphabet[k]] {
+			return nil, fmt.Errorf("duplicate character %q in alphabet", alphabet[k])
+		}
+		seen[alphabet[k]] = true
+	}
+	if config.MinLength < 0 {
+		return nil, fmt.Errorf("min length must not be negative, got %d", config.MinLength)
+	}
+	h := &HashID{
+		alphabet:  saltShuffle([]byte(alphabet), config.Salt),
+		minLength: config.MinLength,
+	}
+	for _, word := range config.Blocklist {
+		if len(word) >= 3 {
+			h.blocklist = append(h.blocklist, strings.ToLower(word))
+		}
+	}
+	return h, nil
+}
+
+// Encode returns the ID of the numbers.
+// NOTE:
+//
+//	It returns "" if there is no number;
+//	the error is only returned if no ID can avoid the blocklist.
+func (h *HashID) Encode(numbers ...uint64) (string, error) {
+	if len(numbers) == 0 {
+		return "", nil
+	}
+	for increment := 0; increment <= len(h.alphabet); increment++ {
+		id := h.encode(numbers, increment)
+		if !h.isBlocked(id) {
+			return string(id), nil
+		}
+	}
+	return "", errHashIDMaxAttempts
+}
+
+// Decode returns the numbers of the ID.
+// NOTE:
+//
+//	The ID is verified by encoding the numbers again,
+//	so a tampered or non-canonical ID is rejected with an error.
+func (h *HashID) Decode(id string) ([]uint64, error) {
+	if id == "" {
+		return nil, nil
+	}
+	offset := bytes.IndexByte(h.alphabet, id[0])
+	if offset < 0 {
+		return nil, errHashIDInvalid
+	}
+	alph := rotateReverse(h.alphabet, offset)
+	var numbers []uint64
+	rest := id[1:]
+	for rest != "" {
+		chunk := rest
+		sep := strings.IndexByte(rest, alph[0])
+		if sep >= 0 {
+			chunk, rest = rest[:sep], rest[sep+1:]
+		} else {
+			rest = ""
+		}
+		if chunk == "" {
+			break // the padding
+		}
+		n, ok := parseUintInDict(alph[1:], chunk)
+		if !ok {
+			return nil, errHashIDInvalid
+		}
+		numbers = append(numbers, n)
+		if sep >= 0 {
+			consistentShuffle(alph)
+		}
+	}
+	if numbers == nil {
+		return nil, errHashIDInvalid
+	}
+	if canonical, err := h.Encode(numbers...); err != nil || canonical != id {
+		return nil, errHashIDInvalid
+	}
+	return numbers, nil
+}
+
+func (h *HashID) encode(numbers []uint64, increment int) []byte {
+	size := uint64(len(h.alphabet))
+	offset := uint64(len(numbers))
+	for k, n := range numbers {
+		offset += uint64(h.alphabet[n%size]) + uint64(k)
+	}
+	alph := rotateReverse(h.alphabet, int((offset+uint64(increment))%size))
+	// The first character of the rotated alphabet is the prefix, that is the last one after reversing.
+	id := []byte{alph[len(alph)-1]}
+	for k, n := range numbers {
+		id = appendUintByDict(id, alph[1:], n)
+		if k < len(numbers)-1 {
+			id = append(id, alph[0])
+			consistentShuffle(alph)
+		}
+	}
+	if len(id) < h.minLength {
+		id = append(id, alph[0])
+		for len(id) < h.minLength {
+			consistentShuffle(alph)
+			n := minInt(h.minLength-len(id), len(alph))
+			id = append(id, alph[:n]...)
+		}
+	}
+	return id
+}
+
+func (h *HashID) isBlocked(id []byte) bool {
+	if len(h.blocklist) == 0 {
+		return false
+	}
+	lower := strings.ToLower(string(id))
+	for _, word := range h.blocklist {
+		if strings.Contains(lower, word) {
+			return true
+		}
+	}
+	return false
+}
+
+// rotateReverse returns a copy of the alphabet rotated left by offset and then reversed,
+// so that the prefix character alphabet[offset] is the last one.
+func rotateReverse(alphabet []byte, offset int) []byte {
+	n := len(alphabet)
+	r := make([]byte, n)
+	for k := range r {
+		r[n-1-k] = alphabet[(offset+k)%n]
+	}
+	return r
+}
+
+// consistentShuffle shuffles the alphabet in place deterministically.
+func consistentShuffle(alph []byte) {
+	n := len(alph)
+	for i, j := 0, n-1; j > 0; i, j = i+1, j-1 {
+		r := (i*j + int(alph[i]) + int(alph[j])) % n
+		alph[i], alph[r] = alph[r], alph[i]
+	}
+}
+
+// saltShuffle shuffles the alphabet in place deterministically by the salt, and returns it.
+func saltShuffle(alph []byte, salt string) []byte {
+	if salt == "" {
+		return alph
+	}
+	for i, v, p := len(alph)-1, 0, 0; i > 0; i-- {
+		v %= len(salt)
+		p += int(salt[v])
+		j := (int(salt[v]) + v + p) % i
+		alph[i], alph[j] = alph[j], alph[i]
+		v++
+	}
+	return alph
+}
+
+// parseUintInDict is like ParseUintByDict without allocation, and reports false if s is invalid or overflows.
+func parseUintInDict(dict []byte, s string) (uint64, bool) {
+	base := uint64(len(dict))
+	var n uint64
+	for k := 0; k < len(s); k++ {
+		d := bytes.IndexByte(dict, s[k])
+		if d < 0 {
+			return 0, false
+		}
+		hi, lo := bits.Mul64(n, base)
+		n = lo + uint64(d)
+		if hi != 0 || n < lo {
+			return 0, false
+		}
+	}
+	return n, true
+}
@@ -0,0 +1,97 @@
+package ameda
+
+import (
+	"math"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHashID(t *testing.T) {
+	_, err := NewHashID(HashIDConfig{Alphabet: "ab"})
+	assert.EqualError(t, err, "alphabet must contain at least 3 characters, got 2")
+	_, err = NewHashID(HashIDConfig{Alphabet: "abca"})
+	assert.EqualError(t, err, `duplicate character 'a' in alphabet`)
+	_, err = NewHashID(HashIDConfig{MinLength: -1})
+	assert.Error(t, err)
+
+	h, err := NewHashID(HashIDConfig{Salt: "my salt"})
+	assert.NoError(t, err)
+	seen := map[string]bool{}
+	for n := uint64(0); n < 1000; n++ {
+		id, err := h.Encode(n)
+		assert.NoError(t, err)
+		assert.False(t, seen[id], id)
+		seen[id] = true
+		numbers, err := h.Decode(id)
+		assert.NoError(t, err)
+		assert.Equal(t, []uint64{n}, numbers)
+	}
+	numbers := []uint64{0, 1, 2, math.MaxUint64, 42}
+	id, err := h.Encode(numbers...)
+	assert.NoError(t, err)
+	got, err := h.Decode(id)
+	assert.NoError(t, err)
+	assert.Equal(t, numbers, got)
+
+	other, _ := NewHashID(HashIDConfig{Salt: "other salt"})
+	id2, _ := other.Encode(numbers...)
+	assert.NotEqual(t, id, id2)
+	_, err = h.Decode(id2)
+	assert.Error(t, err)
+
+	// tampered IDs are rejected
+	tampered := 0
+	for k := 0; k < len(id); k++ {
+		for _, c := range []byte("0aZ") {
+			if id[k] == c {
+				continue
+			}
+			b := []byte(id)
+			b[k] = c
+			if _, err := h.Decode(string(b)); err != nil {
+				tampered++
+			}
+		}
+	}
+	assert.True(t, tampered > len(id)*3*9/10, "%d of %d", tampered, len(id)*3)
+	_, err = h.Decode(id + "-")
+	assert.EqualError(t, err, "invalid hash id")
+
+	empty, err := h.Encode()
+	assert.NoError(t, err)
+	assert.Equal(t, "", empty)
+}
+
+func TestHashIDMinLengthAndBlocklist(t *testing.T) {
+	h, _ := NewHashID(HashIDConfig{MinLength: 10, Alphabet: "abcdefghij"})
+	for n := uint64(0); n < 200; n++ {
+		id, err := h.Encode(n, n*7)
+		assert.NoError(t, err)
+		assert.True(t, len(id) >= 10)
+		numbers, err := h.Decode(id)
+		assert.NoError(t, err)
+		assert.Equal(t, []uint64{n, n * 7}, numbers)
+	}
+
+	plain, _ := NewHashID(HashIDConfig{})
+	var blocked []string
+	for n := uint64(0); len(blocked) < 5; n++ {
+		id, _ := plain.Encode(n)
+		if len(id) >= 3 {
+			blocked = append(blocked, strings.ToUpper(id[:3]))
+		}
+	}
+	h, _ = NewHashID(HashIDConfig{Blocklist: append(blocked, "xy")})
+	for n := uint64(0); n < 500; n++ {
+		id, err := h.Encode(n)
+		assert.NoError(t, err)
+		for _, word := range blocked {
+			assert.NotContains(t, strings.ToLower(id), strings.ToLower(word))
+		}
+		numbers, err := h.Decode(id)
+		assert.NoError(t, err)
+		assert.Equal(t, []uint64{n}, numbers)
+	}
+}
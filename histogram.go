@@ -0,0 +1,324 @@
+package ameda
+
+import (
+	"errors"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Histogram is the distribution of samples over contiguous bins.
+type Histogram struct {
+	// Edges are the bin edges in strictly ascending order, len(Edges) == len(Counts)+1.
+	// The bin k is [Edges[k], Edges[k+1]), except that the last bin also includes its upper edge.
+	Edges []float64
+	// Counts are the number of samples in each bin.
+	Counts []int
+	// Underflow is the number of samples less than the first edge.
+	Underflow int
+	// Overflow is the number of samples greater than the last edge.
+	Overflow int
+	// NaN is the number of NaN samples.
+	NaN int
+}
+
+// NewHistogram creates an empty histogram with the bin edges, see also
+// FixedWidthEdges, LogEdges and QuantileEdges.
+// NOTE:
+//
+//	If there are less than 2 edges, or they are not finite and strictly ascending, returns an error.
+func NewHistogram(edges []float64) (*Histogram, error) {
+	if len(edges) < 2 {
+		return nil, errors.New("histogram needs at least 2 edges")
+	}
+	for k, v := range edges {
+		if math.IsNaN(v) || math.IsInf(v, 0) {
+			return nil, errors.New("histogram edges must be finite")
+		}
+		if k > 0 && v <= edges[k-1] {
+			return nil, errors.New("histogram edges must be strictly ascending")
+		}
+	}
+	return &Histogram{
+		Edges:  Float64sCopy(edges),
+		Counts: make([]int, len(edges)-1),
+	}, nil
+}
+
+// Add counts the samples.
+func (h *Histogram) Add(samples ...float64) {
+	last := len(h.Edges) - 1
+	for _, v := range samples {
+		switch {
+		case v != v:
+			h.NaN++
+		case v < h.Edges[0]:
+			h.Underflow++
+		case v > h.Edges[last]:
+			h.Overflow++
+		case v == h.Edges[last]:
+			h.Counts[last-1]++
+		default:
+			// the first edge greater than v
+			k := sort.Search(len(h.Edges), func(i int) bool { return h.Edges[i] > v })
+			h.Counts[k-1]++
+		}
+	}
+}
+
+// Total returns the number of samples in the bins, excluding the underflow, overflow and NaN samples.
+func (h *Histogram) Total() int {
+	var n int
+	for _, c := range h.Counts {
+		n += c
+	}
+	return n
+}
+
+// Cumulative returns the cumulative counts, r[k] is the number of samples in the bins 0 to k.
+func (h *Histogram) Cumulative() []int {
+	r := make([]int, len(h.Counts))
+	var acc int
+	for k, c := range h.Counts {
+		acc += c
+		r[k] = acc
+	}
+	return r
+}
+
+// CDF returns the cumulative distribution, r[k] is the fraction of the samples in the bins 0 to k,
+// i.e. not greater than the upper edge of bin k.
+// NOTE:
+//
+//	The fractions are relative to Total(), so the last one is 1 unless the histogram is empty.
+func (h *Histogram) CDF() []float64 {
+	r := make([]float64, len(h.Counts))
+	total := h.Total()
+	if total == 0 {
+		return r
+	}
+	for k, c := range h.Cumulative() {
+		r[k] = float64(c) / float64(total)
+	}
+	return r
+}
+
+// Render renders the histogram as ASCII text for the terminal, one bin per line, e.g.
+//
+//	[0, 10)  ######   3
+//	[10, 20] ######## 4
+//
+// @width
+//
+//	The length of the longest bar, in characters.
+func (h *Histogram) Render(width int) string {
+	if width < 1 {
+		width = 1
+	}
+	labels := make([]string, len(h.Counts))
+	var labelWidth, max int
+	for k, c := range h.Counts {
+		closing := ")"
+		if k == len(h.Counts)-1 {
+			closing = "]"
+		}
+		labels[k] = "[" + formatEdge(h.Edges[k]) + ", " + formatEdge(h.Edges[k+1]) + closing
+		if len(labels[k]) > labelWidth {
+			labelWidth = len(labels[k])
+		}
+		if c > max {
+			max = c
+		}
+	}
+	var sb strings.Builder
+	for k, c := range h.Counts {
+		bar := 0
+		if max > 0 {
+			bar = int(math.Round(float64(c) * float64(width) / float64(max)))
+		}
+		sb.WriteString(labels[k])
+		sb.WriteString(strings.Repeat(" ", labelWidth-len(labels[k])+1))
+		sb.WriteString(strings.Repeat("#", bar))
+		sb.WriteString(strings.Repeat(" ", width-bar+1))
+		sb.WriteString(strconv.Itoa(c))
+		sb.WriteByte('\n')
+	}
+	return sb.String()
+}
+
+func formatEdge(v float64) string {
+	return strconv.FormatFloat(v, 'g', 6, 64)
+}
+
+// FixedWidthEdges returns the edges of bins of the same width that cover [min, max].
+// NOTE:
+//
+//	If min == max, the range is extended by 0.5 on both sides.
+func FixedWidthEdges(min, max float64, bins int) ([]float64, error) {
+	if bins < 1 {
+		return nil, errors.New("the number of bins must be positive")
+	}
+	if math.IsNaN(min) || math.IsNaN(max) || math.IsInf(min, 0) || math.IsInf(max, 0) || min > max {
+		return nil, errors.New("invalid histogram range")
+	}
+	if min == max {
+		min, max = min-0.5, max+0.5
+	}
+	edges := make([]float64, bins+1)
+	width := (max - min) / float64(bins)
+	for k := range edges {
+		edges[k] = min + float64(k)*width
+	}
+	edges[bins] = max
+	return edges, nil
+}
+
+// LogEdges returns the edges of bins that cover [min, max] with the same width in logarithmic scale,
+// e.g. for latency samples.
+// NOTE:
+//
+//	min must be positive. If min == max, the range is extended to [min/2, max*2].
+func LogEdges(min, max float64, bins int) ([]float64, error) {
+	if !(min > 0) {
+		return nil, errors.New("the minimum of logarithmic bins must be positive")
+	}
+	if min == max {
+		min, max = min/2, max*2
+	}
+	edges, err := FixedWidthEdges(math.Log(min), math.Log(max), bins)
+	if err != nil {
+		return nil, err
+	}
+	for k, v := range edges {
+		edges[k] = math.Exp(v)
+	}
+	edges[0], edges[bins] = min, max
+	return edges, nil
+}
+
+// QuantileEdges returns the edges of bins that contain nearly the same number of samples (equal-frequency bins).
+// NOTE:
+//
+//	The NaN and infinite samples are ignored, like the range of Float64sHistogramFixedWidth.
+//	The duplicate edges are merged, so there may be fewer bins than expected if many samples are equal.
+func QuantileEdges(samples []float64, bins int) ([]float64, error) {
+	if bins < 1 {
+		return nil, errors.New("the number of bins must be positive")
+	}
+	sorted := Float64sFilter(samples, func(f []float64, k int, v float64) bool { return !math.IsNaN(v) && !math.IsInf(v, 0) })
+	if len(sorted) == 0 {
+		return nil, errors.New("no sample to compute quantiles")
+	}
+	sort.Float64s(sorted)
+	if sorted[0] == sorted[len(sorted)-1] {
+		return FixedWidthEdges(sorted[0], sorted[0], 1)
+	}
+	edges := make([]float64, 0, bins+1)
+	for k := 0; k <= bins; k++ {
+		v := quantileSorted(sorted, float64(k)/float64(bins))
+		if n := len(edges); n == 0 || v > edges[n-1] {
+			edges = append(edges, v)
+		}
+	}
+	return edges, nil
+}
+
+// quantileSorted returns the q-quantile of the sorted samples with linear interpolation.
+func quantileSorted(sorted []float64, q float64) float64 {
+	pos := q * float64(len(sorted)-1)
+	lo := int(math.Floor(pos))
+	if lo >= len(sorted)-1 {
+		return sorted[len(sorted)-1]
+	}
+	frac := pos - float64(lo)
+	return sorted[lo] + (sorted[lo+1]-sorted[lo])*frac
+}
+
+// Float64sHistogram counts the samples into the bins with the edges, see NewHistogram.
+func Float64sHistogram(f []float64, edges []float64) (*Histogram, error) {
+	h, err := NewHistogram(edges)
+	if err != nil {
+		return nil, err
+	}
+	h.Add(f...)
+	return h, nil
+}
+
+// Float64sHistogramFixedWidth counts the samples into bins of the same width that cover all the samples.
+func Float64sHistogramFixedWidth(f []float64, bins int) (*Histogram, error) {
+	min, max, ok := float64sRange(f, false)
+	if !ok {
+		return nil, errors.New("no sample to compute the range")
+	}
+	edges, err := FixedWidthEdges(min, max, bins)
+	if err != nil {
+		return nil, err
+	}
+	return Float64sHistogram(f, edges)
+}
+
+// Float64sHistogramLog counts the samples into bins of the same width in logarithmic scale
+// that cover all the positive samples; the non-positive samples are counted as underflow.
+func Float64sHistogramLog(f []float64, bins int) (*Histogram, error) {
+	min, max, ok := float64sRange(f, true)
+	if !ok {
+		return nil, errors.New("no positive sample to compute the range")
+	}
+	edges, err := LogEdges(min, max, bins)
+	if err != nil {
+		return nil, err
+	}
+	return Float64sHistogram(f, edges)
+}
+
+// Float64sHistogramQuantile counts the samples into bins that contain nearly the same number of samples,
+// see QuantileEdges.
+func Float64sHistogramQuantile(f []float64, bins int) (*Histogram, error) {
+	edges, err := QuantileEdges(f, bins)
+	if err != nil {
+		return nil, err
+	}
+	return Float64sHistogram(f, edges)
+}
+
+// Int64sHistogram is like Float64sHistogram, but for int64 samples.
+func Int64sHistogram(i []int64, edges []float64) (*Histogram, error) {
+	return Float64sHistogram(Int64sToFloat64s(i), edges)
+}
+
+// Int64sHistogramFixedWidth is like Float64sHistogramFixedWidth, but for int64 samples.
+func Int64sHistogramFixedWidth(i []int64, bins int) (*Histogram, error) {
+	return Float64sHistogramFixedWidth(Int64sToFloat64s(i), bins)
+}
+
+// Int64sHistogramLog is like Float64sHistogramLog, but for int64 samples.
+func Int64sHistogramLog(i []int64, bins int) (*Histogram, error) {
+	return Float64sHistogramLog(Int64sToFloat64s(i), bins)
+}
+
+// Int64sHistogramQuantile is like Float64sHistogramQuantile, but for int64 samples.
+func Int64sHistogramQuantile(i []int64, bins int) (*Histogram, error) {
+	return Float64sHistogramQuantile(Int64sToFloat64s(i), bins)
+}
+
+// float64sRange returns the minimum and maximum finite samples, ignoring NaN,
+// and non-positive samples if positive is true.
+func float64sRange(f []float64, positive bool) (min, max float64, ok bool) {
+	for _, v := range f {
+		if v != v || math.IsInf(v, 0) || positive && v <= 0 {
+			continue
+		}
+		if !ok {
+			min, max, ok = v, v, true
+			continue
+		}
+		if v < min {
+			min = v
+		}
+		if v > max {
+			max = v
+		}
+	}
+	return
+}
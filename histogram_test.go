@@ -0,0 +1,77 @@
+package ameda
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHistogram(t *testing.T) {
+	_, err := NewHistogram([]float64{1})
+	assert.Error(t, err)
+	_, err = NewHistogram([]float64{1, 1})
+	assert.Error(t, err)
+
+	h, err := Float64sHistogram([]float64{-1, 0, 1, 5, 9.9, 10, 20, math.NaN()}, []float64{0, 5, 10})
+	assert.NoError(t, err)
+	assert.Equal(t, []int{2, 3}, h.Counts)
+	assert.Equal(t, 1, h.Underflow)
+	assert.Equal(t, 1, h.Overflow)
+	assert.Equal(t, 1, h.NaN)
+	assert.Equal(t, 5, h.Total())
+	assert.Equal(t, []int{2, 5}, h.Cumulative())
+	assert.Equal(t, []float64{0.4, 1}, h.CDF())
+	assert.Equal(t, "[0, 5)  #####    2\n[5, 10] ######## 3\n", h.Render(8))
+}
+
+func TestHistogramRender(t *testing.T) {
+	h, _ := Int64sHistogramFixedWidth([]int64{1, 2, 2, 3, 3, 3, 3, 9}, 2)
+	assert.Equal(t, []float64{1, 5, 9}, h.Edges)
+	assert.Equal(t, []int{7, 1}, h.Counts)
+	assert.Equal(t, "[1, 5) ####### 7\n[5, 9] #       1\n", h.Render(7))
+}
+
+func TestHistogramLog(t *testing.T) {
+	edges, err := LogEdges(1, 1000, 3)
+	assert.NoError(t, err)
+	assert.InDeltaSlice(t, []float64{1, 10, 100, 1000}, edges, 1e-9)
+	_, err = LogEdges(0, 1000, 3)
+	assert.Error(t, err)
+
+	h, err := Int64sHistogramLog([]int64{0, 1, 5, 50, 500, 1000}, 3)
+	assert.NoError(t, err)
+	assert.Equal(t, []int{2, 1, 2}, h.Counts)
+	assert.Equal(t, 1, h.Underflow)
+}
+
+func TestHistogramQuantile(t *testing.T) {
+	samples := []float64{1, 2, 3, 4, 5, 6, 7, 8, 9}
+	edges, err := QuantileEdges(samples, 4)
+	assert.NoError(t, err)
+	assert.Equal(t, []float64{1, 3, 5, 7, 9}, edges)
+	h, err := Float64sHistogramQuantile(samples, 4)
+	assert.NoError(t, err)
+	assert.Equal(t, []int{2, 2, 2, 3}, h.Counts)
+
+	edges, err = QuantileEdges([]float64{1, 1, 1, 1, 2}, 4)
+	assert.NoError(t, err)
+	assert.Equal(t, []float64{1, 2}, edges)
+	_, err = QuantileEdges([]float64{math.NaN()}, 4)
+	assert.Error(t, err)
+	_, err = QuantileEdges([]float64{math.Inf(1), math.Inf(-1)}, 4)
+	assert.Error(t, err)
+
+	// the infinite samples are ignored by the edges, and counted as underflow or overflow
+	samples = append(samples, math.Inf(-1), math.Inf(1), math.NaN())
+	edges, err = QuantileEdges(samples, 4)
+	assert.NoError(t, err)
+	assert.Equal(t, []float64{1, 3, 5, 7, 9}, edges)
+	h, err = Float64sHistogramQuantile(samples, 4)
+	assert.NoError(t, err)
+	assert.Equal(t, []int{2, 2, 2, 3}, h.Counts)
+	assert.Equal(t, 1, h.Underflow)
+	assert.Equal(t, 1, h.Overflow)
+	_, err = Float64sHistogramFixedWidth(samples, 4)
+	assert.NoError(t, err)
+}
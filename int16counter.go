@@ -0,0 +1,164 @@
+package ameda
+
+import "sort"
+
+// Int16Counter is a multiset that maps each int16 element to its count.
+// NOTE:
+//
+//	Only positive counts are kept.
+//	It has the same underlying type as the result of Int16sDistinct and Int16sIntersect,
+//	so they can be converted to each other directly.
+type Int16Counter map[int16]int
+
+// Int16CounterItem is an element with its count.
+type Int16CounterItem struct {
+	Element int16
+	Count   int
+}
+
+// NewInt16Counter creates a counter that counts the elements.
+func NewInt16Counter(elements ...int16) Int16Counter {
+	c := make(Int16Counter, len(elements))
+	c.Add(elements...)
+	return c
+}
+
+// Int16sDistinctCounter is like Int16sDistinct, but returns a counter.
+func Int16sDistinctCounter(i *[]int16, changeSlice bool) Int16Counter {
+	return Int16Counter(Int16sDistinct(i, changeSlice))
+}
+
+// Int16sIntersectCounter is like Int16sIntersect, but returns a counter, which is empty but not nil if there is no common element.
+func Int16sIntersectCounter(i ...[]int16) Int16Counter {
+	if c := Int16sIntersect(i...); c != nil {
+		return Int16Counter(c)
+	}
+	return make(Int16Counter)
+}
+
+// Add increases the count of each element by 1.
+func (c Int16Counter) Add(elements ...int16) {
+	for _, v := range elements {
+		c[v]++
+	}
+}
+
+// AddN increases the count of the element by n, and returns the new count.
+// NOTE:
+//
+//	If the count is no longer positive, the element is removed.
+func (c Int16Counter) AddN(element int16, n int) int {
+	n += c[element]
+	if n <= 0 {
+		delete(c, element)
+		return 0
+	}
+	c[element] = n
+	return n
+}
+
+// Subtract decreases the count of each element by 1.
+// NOTE:
+//
+//	If the count is no longer positive, the element is removed.
+func (c Int16Counter) Subtract(elements ...int16) {
+	for _, v := range elements {
+		c.AddN(v, -1)
+	}
+}
+
+// Get returns the count of the element.
+func (c Int16Counter) Get(element int16) int {
+	return c[element]
+}
+
+// Total returns the sum of all counts.
+func (c Int16Counter) Total() int {
+	var n int
+	for _, v := range c {
+		n += v
+	}
+	return n
+}
+
+// Clone creates a copy of the counter.
+func (c Int16Counter) Clone() Int16Counter {
+	r := make(Int16Counter, len(c))
+	for k, v := range c {
+		r[k] = v
+	}
+	return r
+}
+
+// Union returns a new counter with the maximum count of each element: c ∪ others...
+func (c Int16Counter) Union(others ...Int16Counter) Int16Counter {
+	r := c.Clone()
+	for _, o := range others {
+		for k, v := range o {
+			if v > r[k] {
+				r[k] = v
+			}
+		}
+	}
+	return r
+}
+
+// Intersect returns a new counter with the minimum count of each element: c ∩ others...
+func (c Int16Counter) Intersect(others ...Int16Counter) Int16Counter {
+	r := make(Int16Counter, len(c))
+L:
+	for k, v := range c {
+		for _, o := range others {
+			v2 := o[k]
+			if v2 <= 0 {
+				continue L
+			}
+			if v > v2 {
+				v = v2
+			}
+		}
+		r[k] = v
+	}
+	return r
+}
+
+// MostCommon returns the k most common elements from the most to the least common,
+// elements with equal counts are in ascending order.
+// NOTE:
+//
+//	If k < 0, returns all elements.
+func (c Int16Counter) MostCommon(k int) []Int16CounterItem {
+	items := make([]Int16CounterItem, 0, len(c))
+	for e, n := range c {
+		items = append(items, Int16CounterItem{Element: e, Count: n})
+	}
+	sort.Slice(items, func(i, j int) bool {
+		if items[i].Count != items[j].Count {
+			return items[i].Count > items[j].Count
+		}
+		return items[i].Element < items[j].Element
+	})
+	if k >= 0 && k < len(items) {
+		items = items[:k]
+	}
+	return items
+}
+
+// SortedElements returns the distinct elements in ascending order.
+func (c Int16Counter) SortedElements() []int16 {
+	r := make([]int16, 0, len(c))
+	for k := range c {
+		r = append(r, k)
+	}
+	sort.Slice(r, func(i, j int) bool { return r[i] < r[j] })
+	return r
+}
+
+// RangeSorted calls fn for each element in ascending order; if fn returns false, range stops the iteration.
+func (c Int16Counter) RangeSorted(fn func(element int16, count int) bool) {
+	for _, k := range c.SortedElements() {
+		if !fn(k, c[k]) {
+			return
+		}
+	}
+}
@@ -0,0 +1,373 @@
+package ameda
+
+import (
+	"sync"
+	"sync/atomic"
+)
+
+// SyncInt16s is an int16 slice that is safe for concurrent use.
+// NOTE:
+//
+//	The zero value is an empty slice ready to use.
+//	Point reads such as Len and At use the read lock without copying the elements.
+//	Callbacks are run on a snapshot, so they can call the methods of the same SyncInt16s.
+type SyncInt16s struct {
+	mu   sync.RWMutex
+	data []int16
+	snap atomic.Value // []int16: read-only copy of data, nil after modification
+}
+
+// NewSyncInt16s creates a SyncInt16s with a copy of the elements.
+func NewSyncInt16s(elements ...int16) *SyncInt16s {
+	return &SyncInt16s{data: Int16sCopy(elements)}
+}
+
+// Snapshot returns a read-only copy of the current elements.
+// NOTE:
+//
+//	The result is shared with other callers until the next modification, so it must not be changed.
+//	While there is no modification, it is an atomic read without locking.
+func (s *SyncInt16s) Snapshot() []int16 {
+	if snap, _ := s.snap.Load().([]int16); snap != nil {
+		return snap
+	}
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	snap := Int16sCopy(s.data)
+	s.snap.Store(snap)
+	return snap
+}
+
+// Slice returns a copy of the current elements that can be changed.
+func (s *SyncInt16s) Slice() []int16 {
+	return Int16sCopy(s.Snapshot())
+}
+
+// Len returns the number of elements.
+func (s *SyncInt16s) Len() int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return len(s.data)
+}
+
+// At returns the element at the index, which can be negative and counted from the end.
+func (s *SyncInt16s) At(index int) (v int16, ok bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return Int16sAt(s.data, index)
+}
+
+// Includes determines whether the slice includes a certain element.
+func (s *SyncInt16s) Includes(valueToFind int16, fromIndex ...int) bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return Int16sIncludes(s.data, valueToFind, fromIndex...)
+}
+
+// IndexOf returns the first index at which a given element can be found, or -1 if it is not present.
+func (s *SyncInt16s) IndexOf(searchElement int16, fromIndex ...int) int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return Int16sIndexOf(s.data, searchElement, fromIndex...)
+}
+
+// LastIndexOf returns the last index at which a given element can be found, or -1 if it is not present.
+func (s *SyncInt16s) LastIndexOf(searchElement int16, fromIndex ...int) int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return Int16sLastIndexOf(s.data, searchElement, fromIndex...)
+}
+
+// Range calls fn for each element of a snapshot in order, until fn returns false.
+func (s *SyncInt16s) Range(fn func(k int, v int16) bool) {
+	for k, v := range s.Snapshot() {
+		if !fn(k, v) {
+			return
+		}
+	}
+}
+
+// Every tests whether all elements of a snapshot pass the test implemented by fn.
+func (s *SyncInt16s) Every(fn func(i []int16, k int, v int16) bool) bool {
+	return Int16sEvery(s.Snapshot(), fn)
+}
+
+// Some tests whether at least one element of a snapshot passes the test implemented by fn.
+func (s *SyncInt16s) Some(fn func(i []int16, k int, v int16) bool) bool {
+	return Int16sSome(s.Snapshot(), fn)
+}
+
+// Find returns the key-value of the first element of a snapshot that satisfies fn.
+// If no element satisfies fn, -1 is returned.
+func (s *SyncInt16s) Find(fn func(i []int16, k int, v int16) bool) (k int, v int16) {
+	return Int16sFind(s.Snapshot(), fn)
+}
+
+// Filter creates a new slice with the elements of a snapshot that pass the test implemented by fn.
+func (s *SyncInt16s) Filter(fn func(i []int16, k int, v int16) bool) []int16 {
+	return Int16sFilter(s.Snapshot(), fn)
+}
+
+// Map creates a new slice with the results of calling fn on every element of a snapshot.
+func (s *SyncInt16s) Map(fn func(i []int16, k int, v int16) int16) []int16 {
+	return Int16sMap(s.Snapshot(), fn)
+}
+
+// Reduce executes fn on each element of a snapshot, resulting in a single output value.
+func (s *SyncInt16s) Reduce(fn func(i []int16, k int, v, accumulator int16) int16, initialValue ...int16) int16 {
+	return Int16sReduce(s.Snapshot(), fn, initialValue...)
+}
+
+// Set changes the element at the index, which can be negative and counted from the end,
+// and reports whether the index is in range.
+func (s *SyncInt16s) Set(index int, value int16) bool {
+	s.mu.Lock()
+	defer s.unlock()
+	k, ok := atIndex(len(s.data), index)
+	if ok {
+		s.data[k] = value
+	}
+	return ok
+}
+
+// Push adds one or more elements to the end, and returns the new length.
+func (s *SyncInt16s) Push(element ...int16) int {
+	s.mu.Lock()
+	defer s.unlock()
+	return Int16sPush(&s.data, element...)
+}
+
+// PushDistinct adds one or more new elements that do not exist at the end, and returns the new length.
+func (s *SyncInt16s) PushDistinct(element ...int16) int {
+	s.mu.Lock()
+	defer s.unlock()
+	s.data = Int16sPushDistinct(s.data, element...)
+	return len(s.data)
+}
+
+// Pop removes the last element and returns that element.
+func (s *SyncInt16s) Pop() (int16, bool) {
+	s.mu.Lock()
+	defer s.unlock()
+	return Int16sPop(&s.data)
+}
+
+// Shift removes the first element and returns that element.
+func (s *SyncInt16s) Shift() (int16, bool) {
+	s.mu.Lock()
+	defer s.unlock()
+	return Int16sShift(&s.data)
+}
+
+// Unshift adds one or more elements to the beginning, and returns the new length.
+func (s *SyncInt16s) Unshift(element ...int16) int {
+	s.mu.Lock()
+	defer s.unlock()
+	return Int16sUnshift(&s.data, element...)
+}
+
+// UnshiftDistinct adds one or more new elements that do not exist to the beginning, and returns the new length.
+func (s *SyncInt16s) UnshiftDistinct(element ...int16) int {
+	s.mu.Lock()
+	defer s.unlock()
+	return Int16sUnshiftDistinct(&s.data, element...)
+}
+
+// Splice removes deleteCount elements from start and adds the items in their place.
+func (s *SyncInt16s) Splice(start, deleteCount int, items ...int16) {
+	s.mu.Lock()
+	defer s.unlock()
+	Int16sSplice(&s.data, start, deleteCount, items...)
+}
+
+// RemoveFirst removes the first matched elements, and returns the new length.
+func (s *SyncInt16s) RemoveFirst(elements ...int16) int {
+	s.mu.Lock()
+	defer s.unlock()
+	return Int16sRemoveFirst(&s.data, elements...)
+}
+
+// RemoveEvery removes all the elements, and returns the new length.
+func (s *SyncInt16s) RemoveEvery(elements ...int16) int {
+	s.mu.Lock()
+	defer s.unlock()
+	return Int16sRemoveEvery(&s.data, elements...)
+}
+
+// Distinct removes the duplicate elements, and returns the count of each element.
+func (s *SyncInt16s) Distinct() (distinctCount map[int16]int) {
+	s.mu.Lock()
+	defer s.unlock()
+	return Int16sDistinct(&s.data, true)
+}
+
+// Fill changes all elements from start to end to a static value.
+func (s *SyncInt16s) Fill(value int16, start int, end ...int) {
+	s.mu.Lock()
+	defer s.unlock()
+	Int16sFill(s.data, value, start, end...)
+}
+
+// CopyWithin copies part of the slice to another location in the same slice.
+func (s *SyncInt16s) CopyWithin(target, start int, end ...int) {
+	s.mu.Lock()
+	defer s.unlock()
+	Int16sCopyWithin(s.data, target, start, end...)
+}
+
+// Reverse reverses the elements in place.
+func (s *SyncInt16s) Reverse() {
+	s.mu.Lock()
+	defer s.unlock()
+	Int16sReverse(s.data)
+}
+
+// Shuffle randomizes the order of the elements in place.
+func (s *SyncInt16s) Shuffle(r Randomizer) {
+	s.mu.Lock()
+	defer s.unlock()
+	Int16sShuffle(s.data, r)
+}
+
+// ApplyPatch applies the edit script to the elements.
+func (s *SyncInt16s) ApplyPatch(patch []Int16sEdit) error {
+	s.mu.Lock()
+	defer s.unlock()
+	return Int16sApplyPatch(&s.data, patch)
+}
+
+// Update calls fn with the pointer of the elements under the write lock.
+// NOTE:
+//
+//	fn must not call the methods of the same SyncInt16s, and must not retain the slice.
+func (s *SyncInt16s) Update(fn func(p *[]int16)) {
+	s.mu.Lock()
+	defer s.unlock()
+	fn(&s.data)
+}
+
+// Clear removes all elements.
+func (s *SyncInt16s) Clear() {
+	s.mu.Lock()
+	defer s.unlock()
+	s.data = nil
+}
+
+// unlock invalidates the snapshot and releases the write lock.
+func (s *SyncInt16s) unlock() {
+	s.snap.Store([]int16(nil))
+	s.mu.Unlock()
+}
+
+// SyncInt16Set is an int16 set that is safe for concurrent use.
+// NOTE:
+//
+//	The zero value is an empty set ready to use.
+//	Len and Has use the read lock without copying the elements.
+//	Callbacks are run on a snapshot, so they can call the methods of the same SyncInt16Set.
+type SyncInt16Set struct {
+	mu   sync.RWMutex
+	set  *Int16Set
+	snap atomic.Value // []int16: read-only elements in insertion order, nil after modification
+}
+
+// NewSyncInt16Set creates a SyncInt16Set with the elements.
+func NewSyncInt16Set(elements ...int16) *SyncInt16Set {
+	return &SyncInt16Set{set: NewInt16Set(elements...)}
+}
+
+// Snapshot returns the read-only elements in insertion order.
+// NOTE:
+//
+//	The result is shared with other callers until the next modification, so it must not be changed.
+//	While there is no modification, it is an atomic read without locking.
+func (s *SyncInt16Set) Snapshot() []int16 {
+	if snap, _ := s.snap.Load().([]int16); snap != nil {
+		return snap
+	}
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	var snap []int16
+	if s.set != nil {
+		snap = s.set.Slice()
+	}
+	if snap == nil {
+		snap = []int16{}
+	}
+	s.snap.Store(snap)
+	return snap
+}
+
+// Add adds the elements that are not yet in the set, and returns the new length of the set.
+func (s *SyncInt16Set) Add(elements ...int16) int {
+	s.mu.Lock()
+	defer s.unlock()
+	if s.set == nil {
+		s.set = NewInt16Set()
+	}
+	return s.set.Add(elements...)
+}
+
+// Remove removes the elements from the set, and returns the new length of the set.
+func (s *SyncInt16Set) Remove(elements ...int16) int {
+	s.mu.Lock()
+	defer s.unlock()
+	if s.set == nil {
+		return 0
+	}
+	return s.set.Remove(elements...)
+}
+
+// Has reports whether the element is in the set.
+func (s *SyncInt16Set) Has(element int16) bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.set != nil && s.set.Has(element)
+}
+
+// Len returns the number of elements in the set.
+func (s *SyncInt16Set) Len() int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	if s.set == nil {
+		return 0
+	}
+	return s.set.Len()
+}
+
+// Clear removes all elements.
+func (s *SyncInt16Set) Clear() {
+	s.mu.Lock()
+	defer s.unlock()
+	s.set = nil
+}
+
+// Range calls fn for each element of a snapshot in insertion order, until fn returns false.
+func (s *SyncInt16Set) Range(fn func(v int16) bool) {
+	for _, v := range s.Snapshot() {
+		if !fn(v) {
+			return
+		}
+	}
+}
+
+// Slice returns a copy of the elements in insertion order.
+func (s *SyncInt16Set) Slice() []int16 {
+	return Int16sCopy(s.Snapshot())
+}
+
+// SortedSlice returns a copy of the elements in ascending order.
+func (s *SyncInt16Set) SortedSlice() []int16 {
+	return NewInt16Set(s.Snapshot()...).SortedSlice()
+}
+
+// Clone returns an Int16Set with the current elements.
+func (s *SyncInt16Set) Clone() *Int16Set {
+	return NewInt16Set(s.Snapshot()...)
+}
+
+// unlock invalidates the snapshot and releases the write lock.
+func (s *SyncInt16Set) unlock() {
+	s.snap.Store([]int16(nil))
+	s.mu.Unlock()
+}
@@ -0,0 +1,164 @@
+package ameda
+
+import "sort"
+
+// Int32Counter is a multiset that maps each int32 element to its count.
+// NOTE:
+//
+//	Only positive counts are kept.
+//	It has the same underlying type as the result of Int32sDistinct and Int32sIntersect,
+//	so they can be converted to each other directly.
+type Int32Counter map[int32]int
+
+// Int32CounterItem is an element with its count.
+type Int32CounterItem struct {
+	Element int32
+	Count   int
+}
+
+// NewInt32Counter creates a counter that counts the elements.
+func NewInt32Counter(elements ...int32) Int32Counter {
+	c := make(Int32Counter, len(elements))
+	c.Add(elements...)
+	return c
+}
+
+// Int32sDistinctCounter is like Int32sDistinct, but returns a counter.
+func Int32sDistinctCounter(i *[]int32, changeSlice bool) Int32Counter {
+	return Int32Counter(Int32sDistinct(i, changeSlice))
+}
+
+// Int32sIntersectCounter is like Int32sIntersect, but returns a counter, which is empty but not nil if there is no common element.
+func Int32sIntersectCounter(i ...[]int32) Int32Counter {
+	if c := Int32sIntersect(i...); c != nil {
+		return Int32Counter(c)
+	}
+	return make(Int32Counter)
+}
+
+// Add increases the count of each element by 1.
+func (c Int32Counter) Add(elements ...int32) {
+	for _, v := range elements {
+		c[v]++
+	}
+}
+
+// AddN increases the count of the element by n, and returns the new count.
+// NOTE:
+//
+//	If the count is no longer positive, the element is removed.
+func (c Int32Counter) AddN(element int32, n int) int {
+	n += c[element]
+	if n <= 0 {
+		delete(c, element)
+		return 0
+	}
+	c[element] = n
+	return n
+}
+
+// Subtract decreases the count of each element by 1.
+// NOTE:
+//
+//	If the count is no longer positive, the element is removed.
+func (c Int32Counter) Subtract(elements ...int32) {
+	for _, v := range elements {
+		c.AddN(v, -1)
+	}
+}
+
+// Get returns the count of the element.
+func (c Int32Counter) Get(element int32) int {
+	return c[element]
+}
+
+// Total returns the sum of all counts.
+func (c Int32Counter) Total() int {
+	var n int
+	for _, v := range c {
+		n += v
+	}
+	return n
+}
+
+// Clone creates a copy of the counter.
+func (c Int32Counter) Clone() Int32Counter {
+	r := make(Int32Counter, len(c))
+	for k, v := range c {
+		r[k] = v
+	}
+	return r
+}
+
+// Union returns a new counter with the maximum count of each element: c ∪ others...
+func (c Int32Counter) Union(others ...Int32Counter) Int32Counter {
+	r := c.Clone()
+	for _, o := range others {
+		for k, v := range o {
+			if v > r[k] {
+				r[k] = v
+			}
+		}
+	}
+	return r
+}
+
+// Intersect returns a new counter with the minimum count of each element: c ∩ others...
+func (c Int32Counter) Intersect(others ...Int32Counter) Int32Counter {
+	r := make(Int32Counter, len(c))
+L:
+	for k, v := range c {
+		for _, o := range others {
+			v2 := o[k]
+			if v2 <= 0 {
+				continue L
+			}
+			if v > v2 {
+				v = v2
+			}
+		}
+		r[k] = v
+	}
+	return r
+}
+
+// MostCommon returns the k most common elements from the most to the least common,
+// elements with equal counts are in ascending order.
+// NOTE:
+//
+//	If k < 0, returns all elements.
+func (c Int32Counter) MostCommon(k int) []Int32CounterItem {
+	items := make([]Int32CounterItem, 0, len(c))
+	for e, n := range c {
+		items = append(items, Int32CounterItem{Element: e, Count: n})
+	}
+	sort.Slice(items, func(i, j int) bool {
+		if items[i].Count != items[j].Count {
+			return items[i].Count > items[j].Count
+		}
+		return items[i].Element < items[j].Element
+	})
+	if k >= 0 && k < len(items) {
+		items = items[:k]
+	}
+	return items
+}
+
+// SortedElements returns the distinct elements in ascending order.
+func (c Int32Counter) SortedElements() []int32 {
+	r := make([]int32, 0, len(c))
+	for k := range c {
+		r = append(r, k)
+	}
+	sort.Slice(r, func(i, j int) bool { return r[i] < r[j] })
+	return r
+}
+
+// RangeSorted calls fn for each element in ascending order; if fn returns false, range stops the iteration.
+func (c Int32Counter) RangeSorted(fn func(element int32, count int) bool) {
+	for _, k := range c.SortedElements() {
+		if !fn(k, c[k]) {
+			return
+		}
+	}
+}
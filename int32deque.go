@@ -0,0 +1,194 @@
+package ameda
+
+// Int32Deque is a double-ended queue of int32 elements backed by a growable ring buffer,
+// so that pushing and popping at both ends are O(1).
+// NOTE:
+//
+//	The zero value is an empty deque ready to use.
+//	It is not safe for concurrent use.
+type Int32Deque struct {
+	buf   []int32
+	head  int
+	n     int
+	fixed bool
+}
+
+// NewInt32Deque creates a deque with the elements, see also Int32Deque.Slice.
+func NewInt32Deque(elements ...int32) *Int32Deque {
+	d := &Int32Deque{buf: make([]int32, len(elements))}
+	d.n = copy(d.buf, elements)
+	return d
+}
+
+// NewInt32RingBuffer creates a deque with a fixed capacity, e.g. to keep the last N elements.
+// When it is full, pushing at one end overwrites the element at the other end.
+// NOTE:
+//
+//	It panics if capacity < 1.
+func NewInt32RingBuffer(capacity int) *Int32Deque {
+	if capacity < 1 {
+		panic("ameda: ring buffer capacity must be positive")
+	}
+	return &Int32Deque{buf: make([]int32, capacity), fixed: true}
+}
+
+// Len returns the number of elements in the deque.
+func (d *Int32Deque) Len() int {
+	return d.n
+}
+
+// Cap returns the capacity of the underlying ring buffer.
+func (d *Int32Deque) Cap() int {
+	return len(d.buf)
+}
+
+// PushBack adds one or more elements to the end of the deque, and returns the new length of the deque.
+func (d *Int32Deque) PushBack(elements ...int32) int {
+	for _, v := range elements {
+		if d.n == len(d.buf) {
+			if d.fixed {
+				d.buf[d.head] = v
+				d.head = d.index(1)
+				continue
+			}
+			d.grow()
+		}
+		d.buf[d.index(d.n)] = v
+		d.n++
+	}
+	return d.n
+}
+
+// PushFront adds one or more elements to the beginning of the deque, and returns the new length of the deque.
+// NOTE:
+//
+//	Like Int32sUnshift, the elements keep their order at the beginning.
+func (d *Int32Deque) PushFront(elements ...int32) int {
+	for k := len(elements) - 1; k >= 0; k-- {
+		if d.n == len(d.buf) {
+			if d.fixed {
+				d.head = d.index(len(d.buf) - 1)
+				d.buf[d.head] = elements[k]
+				continue
+			}
+			d.grow()
+		}
+		d.head = d.index(len(d.buf) - 1)
+		d.buf[d.head] = elements[k]
+		d.n++
+	}
+	return d.n
+}
+
+// PopBack removes the last element from the deque and returns that element.
+func (d *Int32Deque) PopBack() (v int32, ok bool) {
+	if d.n == 0 {
+		return v, false
+	}
+	d.n--
+	k := d.index(d.n)
+	v = d.buf[k]
+	d.buf[k] = 0
+	return v, true
+}
+
+// PopFront removes the first element from the deque and returns that element.
+func (d *Int32Deque) PopFront() (v int32, ok bool) {
+	if d.n == 0 {
+		return v, false
+	}
+	v = d.buf[d.head]
+	d.buf[d.head] = 0
+	d.head = d.index(1)
+	d.n--
+	return v, true
+}
+
+// Front returns the first element without removing it.
+func (d *Int32Deque) Front() (v int32, ok bool) {
+	return d.At(0)
+}
+
+// Back returns the last element without removing it.
+func (d *Int32Deque) Back() (v int32, ok bool) {
+	return d.At(-1)
+}
+
+// At returns the element at the given index.
+// @index
+//
+//	Zero-based index of the element. If negative, index will be counted from the end.
+//
+// NOTE:
+//
+//	If the index is out of range, ok = false
+func (d *Int32Deque) At(index int) (v int32, ok bool) {
+	index, ok = atIndex(d.n, index)
+	if !ok {
+		return v, false
+	}
+	return d.buf[d.index(index)], true
+}
+
+// Set replaces the element at the given index, and reports whether the index is in range.
+// @index
+//
+//	Zero-based index of the element. If negative, index will be counted from the end.
+func (d *Int32Deque) Set(index int, v int32) bool {
+	index, ok := atIndex(d.n, index)
+	if ok {
+		d.buf[d.index(index)] = v
+	}
+	return ok
+}
+
+// Range calls fn for each element from the front to the back; if fn returns false, range stops the iteration.
+func (d *Int32Deque) Range(fn func(k int, v int32) bool) {
+	for k := 0; k < d.n; k++ {
+		if !fn(k, d.buf[d.index(k)]) {
+			return
+		}
+	}
+}
+
+// Slice returns a copy of the elements from the front to the back.
+func (d *Int32Deque) Slice() []int32 {
+	r := make([]int32, d.n)
+	if d.n == 0 {
+		return r
+	}
+	n := copy(r, d.buf[d.head:])
+	if n < d.n {
+		copy(r[n:], d.buf[:d.n-n])
+	}
+	return r
+}
+
+// Clear removes all elements from the deque, keeping the capacity.
+func (d *Int32Deque) Clear() {
+	for k := range d.buf {
+		d.buf[k] = 0
+	}
+	d.head = 0
+	d.n = 0
+}
+
+// index returns the position in the buffer of the element at index k.
+func (d *Int32Deque) index(k int) int {
+	k += d.head
+	if k >= len(d.buf) {
+		k -= len(d.buf)
+	}
+	return k
+}
+
+func (d *Int32Deque) grow() {
+	c := len(d.buf) * 2
+	if c < 8 {
+		c = 8
+	}
+	buf := make([]int32, c)
+	n := copy(buf, d.buf[d.head:])
+	copy(buf[n:], d.buf[:d.head])
+	d.buf, d.head = buf, 0
+}
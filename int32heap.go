@@ -0,0 +1,298 @@
+package ameda
+
+// Int32Heap is a binary heap (priority queue) of int32 elements.
+// NOTE:
+//
+//	The element that is less than others is popped first, e.g. the minimum for a min-heap.
+//	It is not safe for concurrent use.
+type Int32Heap struct {
+	data  []int32
+	less  func(a, b int32) bool
+	limit int
+}
+
+// NewInt32MinHeap creates a min-heap with the elements in O(n).
+// This method does not change the existing slice.
+func NewInt32MinHeap(elements ...int32) *Int32Heap {
+	return NewInt32Heap(nil, elements...)
+}
+
+// NewInt32MaxHeap creates a max-heap with the elements in O(n).
+// This method does not change the existing slice.
+func NewInt32MaxHeap(elements ...int32) *Int32Heap {
+	return NewInt32Heap(func(a, b int32) bool { return b < a }, elements...)
+}
+
+// NewInt32Heap creates a heap ordered by less with the elements in O(n).
+// This method does not change the existing slice.
+// @less
+//
+//	Reports whether the element a must be popped before the element b. If nil, it is a min-heap.
+func NewInt32Heap(less func(a, b int32) bool, elements ...int32) *Int32Heap {
+	if less == nil {
+		less = func(a, b int32) bool { return a < b }
+	}
+	h := &Int32Heap{data: Int32sCopy(elements), less: less}
+	for k := len(h.data)/2 - 1; k >= 0; k-- {
+		h.down(k)
+	}
+	return h
+}
+
+// NewInt32TopK creates a bounded heap that keeps at most k elements, the greatest ones ordered by less.
+// When it is full, pushing an element evicts the least one.
+// @less
+//
+//	Reports whether the element a is less than the element b. If nil, it is a min-heap.
+func NewInt32TopK(k int, less func(a, b int32) bool) *Int32Heap {
+	if k < 1 {
+		k = 1
+	}
+	if less == nil {
+		less = func(a, b int32) bool { return a < b }
+	}
+	return &Int32Heap{data: make([]int32, 0, k), less: less, limit: k}
+}
+
+// Len returns the number of elements in the heap.
+func (h *Int32Heap) Len() int {
+	return len(h.data)
+}
+
+// Push adds the elements to the heap in O(log n) each, and returns the new length of the heap.
+func (h *Int32Heap) Push(elements ...int32) int {
+	for _, v := range elements {
+		if h.limit > 0 && len(h.data) >= h.limit {
+			if h.less(h.data[0], v) {
+				h.data[0] = v
+				h.down(0)
+			}
+			continue
+		}
+		h.data = append(h.data, v)
+		h.up(len(h.data) - 1)
+	}
+	return len(h.data)
+}
+
+// Pop removes the first element of the heap in O(log n) and returns that element.
+func (h *Int32Heap) Pop() (v int32, ok bool) {
+	n := len(h.data) - 1
+	if n < 0 {
+		return v, false
+	}
+	v = h.data[0]
+	h.data[0] = h.data[n]
+	h.data[n] = 0
+	h.data = h.data[:n]
+	if n > 0 {
+		h.down(0)
+	}
+	return v, true
+}
+
+// Peek returns the first element of the heap without removing it.
+func (h *Int32Heap) Peek() (v int32, ok bool) {
+	if len(h.data) == 0 {
+		return v, false
+	}
+	return h.data[0], true
+}
+
+// Slice returns a copy of the elements in the heap order.
+func (h *Int32Heap) Slice() []int32 {
+	return Int32sCopy(h.data)
+}
+
+// Sorted returns a copy of the elements in the popping order.
+func (h *Int32Heap) Sorted() []int32 {
+	c := &Int32Heap{data: Int32sCopy(h.data), less: h.less}
+	r := make([]int32, 0, len(c.data))
+	for {
+		v, ok := c.Pop()
+		if !ok {
+			return r
+		}
+		r = append(r, v)
+	}
+}
+
+func (h *Int32Heap) up(k int) {
+	for k > 0 {
+		parent := (k - 1) / 2
+		if !h.less(h.data[k], h.data[parent]) {
+			return
+		}
+		h.data[k], h.data[parent] = h.data[parent], h.data[k]
+		k = parent
+	}
+}
+
+func (h *Int32Heap) down(k int) {
+	n := len(h.data)
+	for {
+		child := 2*k + 1
+		if child >= n {
+			return
+		}
+		if right := child + 1; right < n && h.less(h.data[right], h.data[child]) {
+			child = right
+		}
+		if !h.less(h.data[child], h.data[k]) {
+			return
+		}
+		h.data[k], h.data[child] = h.data[child], h.data[k]
+		k = child
+	}
+}
+
+// Int32IndexedHeap is a binary heap of integer keys with int32 priorities,
+// whose priorities can be updated (e.g. decrease-key) in O(log n).
+// NOTE:
+//
+//	The key with the priority that is less than others is popped first.
+//	It is not safe for concurrent use.
+type Int32IndexedHeap struct {
+	keys  []int
+	prios []int32
+	pos   map[int]int // key -> position in the heap
+	less  func(a, b int32) bool
+}
+
+// NewInt32IndexedHeap creates an empty indexed heap ordered by less.
+// @less
+//
+//	Reports whether the priority a must be popped before the priority b. If nil, it is a min-heap.
+func NewInt32IndexedHeap(less func(a, b int32) bool) *Int32IndexedHeap {
+	if less == nil {
+		less = func(a, b int32) bool { return a < b }
+	}
+	return &Int32IndexedHeap{pos: make(map[int]int), less: less}
+}
+
+// Len returns the number of keys in the heap.
+func (h *Int32IndexedHeap) Len() int {
+	return len(h.keys)
+}
+
+// Has reports whether the key is in the heap.
+func (h *Int32IndexedHeap) Has(key int) bool {
+	_, ok := h.pos[key]
+	return ok
+}
+
+// Priority returns the priority of the key.
+func (h *Int32IndexedHeap) Priority(key int) (priority int32, ok bool) {
+	k, ok := h.pos[key]
+	if !ok {
+		return priority, false
+	}
+	return h.prios[k], true
+}
+
+// Push adds the key with the priority, or updates the priority if the key is already in the heap.
+func (h *Int32IndexedHeap) Push(key int, priority int32) {
+	if !h.Update(key, priority) {
+		h.pos[key] = len(h.keys)
+		h.keys = append(h.keys, key)
+		h.prios = append(h.prios, priority)
+		h.up(len(h.keys) - 1)
+	}
+}
+
+// Update changes the priority of the key (e.g. decrease-key), and reports whether the key is in the heap.
+func (h *Int32IndexedHeap) Update(key int, priority int32) bool {
+	k, ok := h.pos[key]
+	if !ok {
+		return false
+	}
+	h.prios[k] = priority
+	h.fix(k)
+	return true
+}
+
+// Remove removes the key from the heap, and reports whether the key was in the heap.
+func (h *Int32IndexedHeap) Remove(key int) bool {
+	k, ok := h.pos[key]
+	if !ok {
+		return false
+	}
+	h.removeAt(k)
+	return true
+}
+
+// Peek returns the first key and its priority without removing it.
+func (h *Int32IndexedHeap) Peek() (key int, priority int32, ok bool) {
+	if len(h.keys) == 0 {
+		return -1, priority, false
+	}
+	return h.keys[0], h.prios[0], true
+}
+
+// Pop removes the first key from the heap and returns the key and its priority.
+func (h *Int32IndexedHeap) Pop() (key int, priority int32, ok bool) {
+	key, priority, ok = h.Peek()
+	if ok {
+		h.removeAt(0)
+	}
+	return
+}
+
+func (h *Int32IndexedHeap) removeAt(k int) {
+	n := len(h.keys) - 1
+	delete(h.pos, h.keys[k])
+	if k != n {
+		h.keys[k], h.prios[k] = h.keys[n], h.prios[n]
+		h.pos[h.keys[k]] = k
+	}
+	h.prios[n] = 0
+	h.keys, h.prios = h.keys[:n], h.prios[:n]
+	if k != n {
+		h.fix(k)
+	}
+}
+
+func (h *Int32IndexedHeap) fix(k int) {
+	if !h.down(k) {
+		h.up(k)
+	}
+}
+
+func (h *Int32IndexedHeap) swap(i, j int) {
+	h.keys[i], h.keys[j] = h.keys[j], h.keys[i]
+	h.prios[i], h.prios[j] = h.prios[j], h.prios[i]
+	h.pos[h.keys[i]] = i
+	h.pos[h.keys[j]] = j
+}
+
+func (h *Int32IndexedHeap) up(k int) {
+	for k > 0 {
+		parent := (k - 1) / 2
+		if !h.less(h.prios[k], h.prios[parent]) {
+			return
+		}
+		h.swap(k, parent)
+		k = parent
+	}
+}
+
+// down reports whether the element is moved.
+func (h *Int32IndexedHeap) down(k int) bool {
+	k0 := k
+	n := len(h.keys)
+	for {
+		child := 2*k + 1
+		if child >= n {
+			break
+		}
+		if right := child + 1; right < n && h.less(h.prios[right], h.prios[child]) {
+			child = right
+		}
+		if !h.less(h.prios[child], h.prios[k]) {
+			break
+		}
+		h.swap(k, child)
+		k = child
+	}
+	return k > k0
+}
@@ -1,5 +1,10 @@
 package ameda
 
+import (
+	"fmt"
+	"sort"
+)
+
 // OneInt32 try to return the first element, otherwise return zero value.
 func OneInt32(i []int32) int32 {
 	if len(i) > 0 {
@@ -455,26 +460,20 @@ func Int32sSplice(i *[]int32, start, deleteCount int, items ...int32) {
 	if deleteCount < 0 {
 		deleteCount = 0
 	}
-	start, end, _ := fixRange(len(a), start, start+1+deleteCount)
-	deleteCount = end - start - 1
-	for k := 0; k < len(items); k++ {
-		if deleteCount > 0 {
-			// replace
-			a[start] = items[k]
-			deleteCount--
-			start++
-		} else {
-			// insert
-			lastSlice := Int32sCopy(a[start:])
-			items = items[k:]
-			a = append(a[:start], items...)
-			a = append(a[:start+len(items)], lastSlice...)
-			*i = a[:len(a):len(a)]
-			return
-		}
+	start = fixIndex(len(a), start, true)
+	if rest := len(a) - start; deleteCount > rest {
+		deleteCount = rest
 	}
-	if deleteCount > 0 {
-		a = append(a[:start], a[start+1+deleteCount:]...)
+	// replace
+	n := copy(a[start:start+deleteCount], items)
+	if n == len(items) {
+		// delete the rest
+		a = append(a[:start+n], a[start+deleteCount:]...)
+	} else {
+		// insert the rest
+		lastSlice := Int32sCopy(a[start+deleteCount:])
+		a = append(a[:start+deleteCount], items[n:]...)
+		a = append(a, lastSlice...)
 	}
 	*i = a[:len(a):len(a)]
 }
@@ -703,3 +702,558 @@ func Int32SetDifference(set1, set2 []int32, others ...[]int32) []int32 {
 	}
 	return r
 }
+
+// Int32sIsSorted reports whether the slice is sorted in ascending order.
+func Int32sIsSorted(i []int32) bool {
+	for k := 1; k < len(i); k++ {
+		if i[k] < i[k-1] {
+			return false
+		}
+	}
+	return true
+}
+
+// Int32SortedSetUnion calculates between multiple sorted collections: set1 ∪ set2 ∪ others...
+// NOTE:
+//
+//	Every set must be sorted in ascending order (see Int32sIsSorted), and the result is sorted too.
+//	It merges the sets in O(n+m) without building any map.
+//	This method does not change the existing slices, but instead returns a new slice.
+func Int32SortedSetUnion(set1, set2 []int32, others ...[]int32) []int32 {
+	r := int32SortedSetUnion(set1, set2)
+	for _, set := range others {
+		r = int32SortedSetUnion(r, set)
+	}
+	return r
+}
+
+// Int32SortedSetIntersect calculates between multiple sorted collections: set1 ∩ set2 ∩ others...
+// NOTE:
+//
+//	Every set must be sorted in ascending order (see Int32sIsSorted), and the result is sorted too.
+//	It merges the sets in O(n+m) without building any map.
+//	This method does not change the existing slices, but instead returns a new slice.
+func Int32SortedSetIntersect(set1, set2 []int32, others ...[]int32) []int32 {
+	r := int32SortedSetIntersect(set1, set2)
+	for _, set := range others {
+		r = int32SortedSetIntersect(r, set)
+	}
+	return r
+}
+
+// Int32SortedSetDifference calculates between multiple sorted collections: set1 - set2 - others...
+// NOTE:
+//
+//	Every set must be sorted in ascending order (see Int32sIsSorted), and the result is sorted too.
+//	It merges the sets in O(n+m) without building any map.
+//	This method does not change the existing slices, but instead returns a new slice.
+func Int32SortedSetDifference(set1, set2 []int32, others ...[]int32) []int32 {
+	r := int32SortedSetDifference(set1, set2)
+	for _, set := range others {
+		r = int32SortedSetDifference(r, set)
+	}
+	return r
+}
+
+// Int32SortedSetSymmetricDifference calculates between multiple sorted collections: set1 ∆ set2 ∆ others...
+// NOTE:
+//
+//	Every set must be sorted in ascending order (see Int32sIsSorted), and the result is sorted too.
+//	It merges the sets in O(n+m) without building any map.
+//	This method does not change the existing slices, but instead returns a new slice.
+func Int32SortedSetSymmetricDifference(set1, set2 []int32, others ...[]int32) []int32 {
+	r := int32SortedSetSymmetricDifference(set1, set2)
+	for _, set := range others {
+		r = int32SortedSetSymmetricDifference(r, set)
+	}
+	return r
+}
+
+// Int32SortedSetIsSubset reports whether every element of sub is also in set: sub ⊆ set.
+// NOTE:
+//
+//	Both sets must be sorted in ascending order (see Int32sIsSorted).
+func Int32SortedSetIsSubset(sub, set []int32) bool {
+	j := 0
+	for k := 0; k < len(sub); {
+		if j >= len(set) || sub[k] < set[j] {
+			return false
+		}
+		if set[j] < sub[k] {
+			j++
+			continue
+		}
+		k++
+	}
+	return true
+}
+
+func int32SortedSetUnion(a, b []int32) []int32 {
+	r := make([]int32, 0, len(a)+len(b))
+	i, j := 0, 0
+	for i < len(a) || j < len(b) {
+		var v int32
+		switch {
+		case j >= len(b) || i < len(a) && a[i] < b[j]:
+			v = a[i]
+			i++
+		case i >= len(a) || b[j] < a[i]:
+			v = b[j]
+			j++
+		default:
+			v = a[i]
+			i++
+			j++
+		}
+		if n := len(r); n == 0 || r[n-1] != v {
+			r = append(r, v)
+		}
+	}
+	return r
+}
+
+func int32SortedSetIntersect(a, b []int32) []int32 {
+	n := len(a)
+	if len(b) < n {
+		n = len(b)
+	}
+	r := make([]int32, 0, n)
+	i, j := 0, 0
+	for i < len(a) && j < len(b) {
+		switch {
+		case a[i] < b[j]:
+			i++
+		case b[j] < a[i]:
+			j++
+		default:
+			v := a[i]
+			if n := len(r); n == 0 || r[n-1] != v {
+				r = append(r, v)
+			}
+			i++
+			j++
+		}
+	}
+	return r
+}
+
+func int32SortedSetDifference(a, b []int32) []int32 {
+	r := make([]int32, 0, len(a))
+	j := 0
+	for _, v := range a {
+		for j < len(b) && b[j] < v {
+			j++
+		}
+		if j < len(b) && b[j] == v {
+			continue
+		}
+		if n := len(r); n == 0 || r[n-1] != v {
+			r = append(r, v)
+		}
+	}
+	return r
+}
+
+func int32SortedSetSymmetricDifference(a, b []int32) []int32 {
+	r := make([]int32, 0, len(a)+len(b))
+	i, j := 0, 0
+	for i < len(a) || j < len(b) {
+		var v int32
+		switch {
+		case j >= len(b) || i < len(a) && a[i] < b[j]:
+			v = a[i]
+			i++
+		case i >= len(a) || b[j] < a[i]:
+			v = b[j]
+			j++
+		default:
+			v = a[i]
+			for i < len(a) && a[i] == v {
+				i++
+			}
+			for j < len(b) && b[j] == v {
+				j++
+			}
+			continue
+		}
+		if n := len(r); n == 0 || r[n-1] != v {
+			r = append(r, v)
+		}
+	}
+	return r
+}
+
+// Int32sEdit is an edit run of the edit script produced by Int32sDiff.
+type Int32sEdit struct {
+	Kind   DiffKind
+	Values []int32
+}
+
+// Int32sDiff computes the shortest edit script that turns slice a into slice b, see also DiffFunc.
+// This method does not change the existing slices, but instead returns the runs of edits.
+func Int32sDiff(a, b []int32) []Int32sEdit {
+	spans := DiffFunc(len(a), len(b), func(i, j int) bool { return a[i] == b[j] })
+	r := make([]Int32sEdit, len(spans))
+	for k, span := range spans {
+		r[k].Kind = span.Kind
+		if span.Kind == DiffInsert {
+			r[k].Values = Int32sCopy(b[span.B : span.B+span.Len])
+		} else {
+			r[k].Values = Int32sCopy(a[span.A : span.A+span.Len])
+		}
+	}
+	return r
+}
+
+// Int32sApplyPatch changes the contents of an slice in place by applying the edit script produced by Int32sDiff.
+// NOTE:
+//
+//	If the slice does not match the equal and delete runs of the patch, it returns an error
+//	and the slice will not be modified.
+func Int32sApplyPatch(p *[]int32, patch []Int32sEdit) error {
+	a := *p
+	r := make([]int32, 0, len(a))
+	pos := 0
+	for _, e := range patch {
+		switch e.Kind {
+		case DiffInsert:
+			r = append(r, e.Values...)
+		case DiffEqual, DiffDelete:
+			if pos+len(e.Values) > len(a) {
+				return fmt.Errorf("patch mismatch: %s run exceeds the slice length %d", e.Kind, len(a))
+			}
+			for k, v := range e.Values {
+				if a[pos+k] != v {
+					return fmt.Errorf("patch mismatch: %s run at index %d", e.Kind, pos+k)
+				}
+			}
+			if e.Kind == DiffEqual {
+				r = append(r, e.Values...)
+			}
+			pos += len(e.Values)
+		default:
+			return fmt.Errorf("patch mismatch: unknown edit kind %d", e.Kind)
+		}
+	}
+	if pos != len(a) {
+		return fmt.Errorf("patch mismatch: the slice has %d unpatched elements", len(a)-pos)
+	}
+	n := len(r)
+	*p = r[:n:n]
+	return nil
+}
+
+// Int32sShuffle randomizes the order of elements in place.
+// @r
+//
+//	The source of random numbers, nil means the global source of package math/rand.
+func Int32sShuffle(i []int32, r Randomizer) {
+	shuffleIndex(len(i), len(i), r, func(x, y int) { i[x], i[y] = i[y], i[x] })
+}
+
+// Int32sSample returns k elements randomly selected from the slice without replacement.
+// If k is greater than the length of the slice, all the elements are returned in random order.
+// This method does not change the existing slice, but instead returns a new slice.
+// @r
+//
+//	The source of random numbers, nil means the global source of package math/rand.
+func Int32sSample(i []int32, k int, r Randomizer) []int32 {
+	if k <= 0 {
+		return []int32{}
+	}
+	a := Int32sCopy(i)
+	shuffleIndex(len(a), k, r, func(x, y int) { a[x], a[y] = a[y], a[x] })
+	if k > len(a) {
+		k = len(a)
+	}
+	return a[:k:k]
+}
+
+// Int32sSampleWithReplacement returns k elements randomly selected from the slice with replacement.
+// This method does not change the existing slice, but instead returns a new slice.
+// @r
+//
+//	The source of random numbers, nil means the global source of package math/rand.
+func Int32sSampleWithReplacement(i []int32, k int, r Randomizer) []int32 {
+	if k <= 0 || len(i) == 0 {
+		return []int32{}
+	}
+	r = getRandomizer(r)
+	ret := make([]int32, k)
+	for n := range ret {
+		ret[n] = i[r.Intn(len(i))]
+	}
+	return ret
+}
+
+// Int32sWeightedChoice returns the key-value of an element randomly selected
+// with the probability proportional to its weight.
+// @weights
+//
+//	The weights of the elements with the same indexes, must be finite and non-negative.
+//
+// @r
+//
+//	The source of random numbers, nil means the global source of package math/rand.
+//
+// NOTE:
+//
+//	If the slice is empty, or the weights are invalid, returns an error and k = -1
+func Int32sWeightedChoice(i []int32, weights []float64, r Randomizer) (k int, v int32, err error) {
+	k, err = weightedIndex(len(i), weights, r)
+	if err != nil {
+		return k, v, err
+	}
+	return k, i[k], nil
+}
+
+// Int32sReservoirSample returns k elements randomly selected from a stream of unknown length,
+// in a single pass and with O(k) memory.
+// @next
+//
+//	Returns the next element of the stream, or ok = false at the end of the stream.
+//
+// @r
+//
+//	The source of random numbers, nil means the global source of package math/rand.
+func Int32sReservoirSample(next func() (v int32, ok bool), k int, r Randomizer) []int32 {
+	if k <= 0 {
+		return []int32{}
+	}
+	r = getRandomizer(r)
+	ret := make([]int32, 0, k)
+	for seen := 0; ; seen++ {
+		v, ok := next()
+		if !ok {
+			return ret
+		}
+		switch slot := reservoirIndex(seen, k, r); {
+		case slot == len(ret):
+			ret = append(ret, v)
+		case slot >= 0:
+			ret[slot] = v
+		}
+	}
+}
+
+// Int32sAt returns the element at the given index.
+// @index
+//
+//	Zero-based index of the element. If negative, index will be counted from the end.
+//
+// NOTE:
+//
+//	If the index is out of range, ok = false
+func Int32sAt(i []int32, index int) (v int32, ok bool) {
+	index, ok = atIndex(len(i), index)
+	if !ok {
+		return v, false
+	}
+	return i[index], true
+}
+
+// Int32sWith returns a copy of the slice with the element at the given index replaced with the value.
+// @index
+//
+//	Zero-based index of the element. If negative, index will be counted from the end.
+//
+// NOTE:
+//
+//	If the index is out of range, ok = false
+func Int32sWith(i []int32, index int, value int32) (ret []int32, ok bool) {
+	ret = Int32sCopy(i)
+	index, ok = atIndex(len(i), index)
+	if ok {
+		ret[index] = value
+	}
+	return ret, ok
+}
+
+// Int32sFindIndex returns the index of the first element in the provided slice that satisfies the provided testing function.
+// NOTE:
+//
+//	If not found, returns -1
+func Int32sFindIndex(i []int32, fn func(i []int32, k int, v int32) bool) int {
+	k, _ := Int32sFind(i, fn)
+	return k
+}
+
+// Int32sFindLast returns the key-value of the last element in the provided slice that satisfies the provided testing function.
+// NOTE:
+//
+//	If not found, k = -1
+func Int32sFindLast(i []int32, fn func(i []int32, k int, v int32) bool) (k int, v int32) {
+	for k := len(i) - 1; k >= 0; k-- {
+		if fn(i, k, i[k]) {
+			return k, i[k]
+		}
+	}
+	return -1, v
+}
+
+// Int32sFindLastIndex returns the index of the last element in the provided slice that satisfies the provided testing function.
+// NOTE:
+//
+//	If not found, returns -1
+func Int32sFindLastIndex(i []int32, fn func(i []int32, k int, v int32) bool) int {
+	k, _ := Int32sFindLast(i, fn)
+	return k
+}
+
+// Int32sFlat creates a new slice with all sub-slice elements concatenated into it.
+func Int32sFlat(i [][]int32) []int32 {
+	return Int32sConcat(i...)
+}
+
+// Int32sFlatMap creates a new slice populated with the results of calling a provided function
+// on every element in the calling slice, and then flattening the results.
+func Int32sFlatMap(i []int32, fn func(i []int32, k int, v int32) []int32) []int32 {
+	ret := make([]int32, 0, len(i))
+	for k, v := range i {
+		ret = append(ret, fn(i, k, v)...)
+	}
+	return ret
+}
+
+// Int32sToSorted returns a copy of the slice sorted stably.
+// @less
+//
+//	Reports whether the element a must sort before the element b. If nil, sorts in ascending order.
+func Int32sToSorted(i []int32, less func(a, b int32) bool) []int32 {
+	ret := Int32sCopy(i)
+	if less == nil {
+		less = func(a, b int32) bool { return a < b }
+	}
+	sort.SliceStable(ret, func(x, y int) bool { return less(ret[x], ret[y]) })
+	return ret
+}
+
+// Int32sToReversed returns a copy of the slice in reversed order.
+func Int32sToReversed(i []int32) []int32 {
+	ret := Int32sCopy(i)
+	Int32sReverse(ret)
+	return ret
+}
+
+// Int32sToSpliced returns a copy of the slice with some elements removed and/or replaced at a given index,
+// see also Int32sSplice.
+func Int32sToSpliced(i []int32, start, deleteCount int, items ...int32) []int32 {
+	ret := Int32sCopy(i)
+	Int32sSplice(&ret, start, deleteCount, items...)
+	return ret
+}
+
+// Int32sKeys returns an iterator of the indexes of the slice.
+// NOTE:
+//
+//	The iterator returns ok = false when it is exhausted.
+func Int32sKeys(i []int32) (next func() (k int, ok bool)) {
+	k := -1
+	return func() (int, bool) {
+		if k+1 >= len(i) {
+			return -1, false
+		}
+		k++
+		return k, true
+	}
+}
+
+// Int32sValues returns an iterator of the elements of the slice.
+// NOTE:
+//
+//	The iterator returns ok = false when it is exhausted.
+func Int32sValues(i []int32) (next func() (v int32, ok bool)) {
+	k := -1
+	return func() (v int32, ok bool) {
+		if k+1 >= len(i) {
+			return v, false
+		}
+		k++
+		return i[k], true
+	}
+}
+
+// Int32sEntries returns an iterator of the key-value pairs of the slice.
+// NOTE:
+//
+//	The iterator returns ok = false when it is exhausted.
+func Int32sEntries(i []int32) (next func() (k int, v int32, ok bool)) {
+	k := -1
+	return func() (int, int32, bool) {
+		if k+1 >= len(i) {
+			var v int32
+			return -1, v, false
+		}
+		k++
+		return k, i[k], true
+	}
+}
+
+// Int32sGroup groups the elements of the slice according to the string keys returned by the provided function.
+func Int32sGroup(i []int32, fn func(i []int32, k int, v int32) string) map[string][]int32 {
+	ret := make(map[string][]int32)
+	for k, v := range i {
+		key := fn(i, k, v)
+		ret[key] = append(ret[key], v)
+	}
+	return ret
+}
+
+// Int32sGroupToMap groups the elements of the slice according to the keys returned by the provided function.
+// NOTE:
+//
+//	The keys must be comparable.
+func Int32sGroupToMap(i []int32, fn func(i []int32, k int, v int32) interface{}) map[interface{}][]int32 {
+	ret := make(map[interface{}][]int32)
+	for k, v := range i {
+		key := fn(i, k, v)
+		ret[key] = append(ret[key], v)
+	}
+	return ret
+}
+
+// Int32sEqual reports whether a and b have the same length and the same elements in the same order.
+func Int32sEqual(a, b []int32) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for k, v := range a {
+		if v != b[k] {
+			return false
+		}
+	}
+	return true
+}
+
+// Int32sCompare compares a and b lexicographically, and returns -1 if a < b, 0 if a == b, or +1 if a > b.
+// NOTE:
+//
+//	A slice that is a prefix of another slice is less than it.
+func Int32sCompare(a, b []int32) int {
+	for k := 0; k < len(a) && k < len(b); k++ {
+		if c := compareInt32(a[k], b[k]); c != 0 {
+			return c
+		}
+	}
+	return compareInt(len(a), len(b))
+}
+
+// Int32sHasPrefix reports whether the slice i begins with prefix.
+func Int32sHasPrefix(i, prefix []int32) bool {
+	return len(i) >= len(prefix) && Int32sEqual(i[:len(prefix)], prefix)
+}
+
+// Int32sHasSuffix reports whether the slice i ends with suffix.
+func Int32sHasSuffix(i, suffix []int32) bool {
+	return len(i) >= len(suffix) && Int32sEqual(i[len(i)-len(suffix):], suffix)
+}
+
+func compareInt32(a, b int32) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+	return 0
+}
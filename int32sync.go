@@ -0,0 +1,373 @@
+package ameda
+
+import (
+	"sync"
+	"sync/atomic"
+)
+
+// SyncInt32s is an int32 slice that is safe for concurrent use.
+// NOTE:
+//
+//	The zero value is an empty slice ready to use.
+//	Point reads such as Len and At use the read lock without copying the elements.
+//	Callbacks are run on a snapshot, so they can call the methods of the same SyncInt32s.
+type SyncInt32s struct {
+	mu   sync.RWMutex
+	data []int32
+	snap atomic.Value // []int32: read-only copy of data, nil after modification
+}
+
+// NewSyncInt32s creates a SyncInt32s with a copy of the elements.
+func NewSyncInt32s(elements ...int32) *SyncInt32s {
+	return &SyncInt32s{data: Int32sCopy(elements)}
+}
+
+// Snapshot returns a read-only copy of the current elements.
+// NOTE:
+//
+//	The result is shared with other callers until the next modification, so it must not be changed.
+//	While there is no modification, it is an atomic read without locking.
+func (s *SyncInt32s) Snapshot() []int32 {
+	if snap, _ := s.snap.Load().([]int32); snap != nil {
+		return snap
+	}
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	snap := Int32sCopy(s.data)
+	s.snap.Store(snap)
+	return snap
+}
+
+// Slice returns a copy of the current elements that can be changed.
+func (s *SyncInt32s) Slice() []int32 {
+	return Int32sCopy(s.Snapshot())
+}
+
+// Len returns the number of elements.
+func (s *SyncInt32s) Len() int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return len(s.data)
+}
+
+// At returns the element at the index, which can be negative and counted from the end.
+func (s *SyncInt32s) At(index int) (v int32, ok bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return Int32sAt(s.data, index)
+}
+
+// Includes determines whether the slice includes a certain element.
+func (s *SyncInt32s) Includes(valueToFind int32, fromIndex ...int) bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return Int32sIncludes(s.data, valueToFind, fromIndex...)
+}
+
+// IndexOf returns the first index at which a given element can be found, or -1 if it is not present.
+func (s *SyncInt32s) IndexOf(searchElement int32, fromIndex ...int) int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return Int32sIndexOf(s.data, searchElement, fromIndex...)
+}
+
+// LastIndexOf returns the last index at which a given element can be found, or -1 if it is not present.
+func (s *SyncInt32s) LastIndexOf(searchElement int32, fromIndex ...int) int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return Int32sLastIndexOf(s.data, searchElement, fromIndex...)
+}
+
+// Range calls fn for each element of a snapshot in order, until fn returns false.
+func (s *SyncInt32s) Range(fn func(k int, v int32) bool) {
+	for k, v := range s.Snapshot() {
+		if !fn(k, v) {
+			return
+		}
+	}
+}
+
+// Every tests whether all elements of a snapshot pass the test implemented by fn.
+func (s *SyncInt32s) Every(fn func(i []int32, k int, v int32) bool) bool {
+	return Int32sEvery(s.Snapshot(), fn)
+}
+
+// Some tests whether at least one element of a snapshot passes the test implemented by fn.
+func (s *SyncInt32s) Some(fn func(i []int32, k int, v int32) bool) bool {
+	return Int32sSome(s.Snapshot(), fn)
+}
+
+// Find returns the key-value of the first element of a snapshot that satisfies fn.
+// If no element satisfies fn, -1 is returned.
+func (s *SyncInt32s) Find(fn func(i []int32, k int, v int32) bool) (k int, v int32) {
+	return Int32sFind(s.Snapshot(), fn)
+}
+
+// Filter creates a new slice with the elements of a snapshot that pass the test implemented by fn.
+func (s *SyncInt32s) Filter(fn func(i []int32, k int, v int32) bool) []int32 {
+	return Int32sFilter(s.Snapshot(), fn)
+}
+
+// Map creates a new slice with the results of calling fn on every element of a snapshot.
+func (s *SyncInt32s) Map(fn func(i []int32, k int, v int32) int32) []int32 {
+	return Int32sMap(s.Snapshot(), fn)
+}
+
+// Reduce executes fn on each element of a snapshot, resulting in a single output value.
+func (s *SyncInt32s) Reduce(fn func(i []int32, k int, v, accumulator int32) int32, initialValue ...int32) int32 {
+	return Int32sReduce(s.Snapshot(), fn, initialValue...)
+}
+
+// Set changes the element at the index, which can be negative and counted from the end,
+// and reports whether the index is in range.
+func (s *SyncInt32s) Set(index int, value int32) bool {
+	s.mu.Lock()
+	defer s.unlock()
+	k, ok := atIndex(len(s.data), index)
+	if ok {
+		s.data[k] = value
+	}
+	return ok
+}
+
+// Push adds one or more elements to the end, and returns the new length.
+func (s *SyncInt32s) Push(element ...int32) int {
+	s.mu.Lock()
+	defer s.unlock()
+	return Int32sPush(&s.data, element...)
+}
+
+// PushDistinct adds one or more new elements that do not exist at the end, and returns the new length.
+func (s *SyncInt32s) PushDistinct(element ...int32) int {
+	s.mu.Lock()
+	defer s.unlock()
+	s.data = Int32sPushDistinct(s.data, element...)
+	return len(s.data)
+}
+
+// Pop removes the last element and returns that element.
+func (s *SyncInt32s) Pop() (int32, bool) {
+	s.mu.Lock()
+	defer s.unlock()
+	return Int32sPop(&s.data)
+}
+
+// Shift removes the first element and returns that element.
+func (s *SyncInt32s) Shift() (int32, bool) {
+	s.mu.Lock()
+	defer s.unlock()
+	return Int32sShift(&s.data)
+}
+
+// Unshift adds one or more elements to the beginning, and returns the new length.
+func (s *SyncInt32s) Unshift(element ...int32) int {
+	s.mu.Lock()
+	defer s.unlock()
+	return Int32sUnshift(&s.data, element...)
+}
+
+// UnshiftDistinct adds one or more new elements that do not exist to the beginning, and returns the new length.
+func (s *SyncInt32s) UnshiftDistinct(element ...int32) int {
+	s.mu.Lock()
+	defer s.unlock()
+	return Int32sUnshiftDistinct(&s.data, element...)
+}
+
+// Splice removes deleteCount elements from start and adds the items in their place.
+func (s *SyncInt32s) Splice(start, deleteCount int, items ...int32) {
+	s.mu.Lock()
+	defer s.unlock()
+	Int32sSplice(&s.data, start, deleteCount, items...)
+}
+
+// RemoveFirst removes the first matched elements, and returns the new length.
+func (s *SyncInt32s) RemoveFirst(elements ...int32) int {
+	s.mu.Lock()
+	defer s.unlock()
+	return Int32sRemoveFirst(&s.data, elements...)
+}
+
+// RemoveEvery removes all the elements, and returns the new length.
+func (s *SyncInt32s) RemoveEvery(elements ...int32) int {
+	s.mu.Lock()
+	defer s.unlock()
+	return Int32sRemoveEvery(&s.data, elements...)
+}
+
+// Distinct removes the duplicate elements, and returns the count of each element.
+func (s *SyncInt32s) Distinct() (distinctCount map[int32]int) {
+	s.mu.Lock()
+	defer s.unlock()
+	return Int32sDistinct(&s.data, true)
+}
+
+// Fill changes all elements from start to end to a static value.
+func (s *SyncInt32s) Fill(value int32, start int, end ...int) {
+	s.mu.Lock()
+	defer s.unlock()
+	Int32sFill(s.data, value, start, end...)
+}
+
+// CopyWithin copies part of the slice to another location in the same slice.
+func (s *SyncInt32s) CopyWithin(target, start int, end ...int) {
+	s.mu.Lock()
+	defer s.unlock()
+	Int32sCopyWithin(s.data, target, start, end...)
+}
+
+// Reverse reverses the elements in place.
+func (s *SyncInt32s) Reverse() {
+	s.mu.Lock()
+	defer s.unlock()
+	Int32sReverse(s.data)
+}
+
+// Shuffle randomizes the order of the elements in place.
+func (s *SyncInt32s) Shuffle(r Randomizer) {
+	s.mu.Lock()
+	defer s.unlock()
+	Int32sShuffle(s.data, r)
+}
+
+// ApplyPatch applies the edit script to the elements.
+func (s *SyncInt32s) ApplyPatch(patch []Int32sEdit) error {
+	s.mu.Lock()
+	defer s.unlock()
+	return Int32sApplyPatch(&s.data, patch)
+}
+
+// Update calls fn with the pointer of the elements under the write lock.
+// NOTE:
+//
+//	fn must not call the methods of the same SyncInt32s, and must not retain the slice.
+func (s *SyncInt32s) Update(fn func(p *[]int32)) {
+	s.mu.Lock()
+	defer s.unlock()
+	fn(&s.data)
+}
+
+// Clear removes all elements.
+func (s *SyncInt32s) Clear() {
+	s.mu.Lock()
+	defer s.unlock()
+	s.data = nil
+}
+
+// unlock invalidates the snapshot and releases the write lock.
+func (s *SyncInt32s) unlock() {
+	s.snap.Store([]int32(nil))
+	s.mu.Unlock()
+}
+
+// SyncInt32Set is an int32 set that is safe for concurrent use.
+// NOTE:
+//
+//	The zero value is an empty set ready to use.
+//	Len and Has use the read lock without copying the elements.
+//	Callbacks are run on a snapshot, so they can call the methods of the same SyncInt32Set.
+type SyncInt32Set struct {
+	mu   sync.RWMutex
+	set  *Int32Set
+	snap atomic.Value // []int32: read-only elements in insertion order, nil after modification
+}
+
+// NewSyncInt32Set creates a SyncInt32Set with the elements.
+func NewSyncInt32Set(elements ...int32) *SyncInt32Set {
+	return &SyncInt32Set{set: NewInt32Set(elements...)}
+}
+
+// Snapshot returns the read-only elements in insertion order.
+// NOTE:
+//
+//	The result is shared with other callers until the next modification, so it must not be changed.
+//	While there is no modification, it is an atomic read without locking.
+func (s *SyncInt32Set) Snapshot() []int32 {
+	if snap, _ := s.snap.Load().([]int32); snap != nil {
+		return snap
+	}
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	var snap []int32
+	if s.set != nil {
+		snap = s.set.Slice()
+	}
+	if snap == nil {
+		snap = []int32{}
+	}
+	s.snap.Store(snap)
+	return snap
+}
+
+// Add adds the elements that are not yet in the set, and returns the new length of the set.
+func (s *SyncInt32Set) Add(elements ...int32) int {
+	s.mu.Lock()
+	defer s.unlock()
+	if s.set == nil {
+		s.set = NewInt32Set()
+	}
+	return s.set.Add(elements...)
+}
+
+// Remove removes the elements from the set, and returns the new length of the set.
+func (s *SyncInt32Set) Remove(elements ...int32) int {
+	s.mu.Lock()
+	defer s.unlock()
+	if s.set == nil {
+		return 0
+	}
+	return s.set.Remove(elements...)
+}
+
+// Has reports whether the element is in the set.
+func (s *SyncInt32Set) Has(element int32) bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.set != nil && s.set.Has(element)
+}
+
+// Len returns the number of elements in the set.
+func (s *SyncInt32Set) Len() int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	if s.set == nil {
+		return 0
+	}
+	return s.set.Len()
+}
+
+// Clear removes all elements.
+func (s *SyncInt32Set) Clear() {
+	s.mu.Lock()
+	defer s.unlock()
+	s.set = nil
+}
+
+// Range calls fn for each element of a snapshot in insertion order, until fn returns false.
+func (s *SyncInt32Set) Range(fn func(v int32) bool) {
+	for _, v := range s.Snapshot() {
+		if !fn(v) {
+			return
+		}
+	}
+}
+
+// Slice returns a copy of the elements in insertion order.
+func (s *SyncInt32Set) Slice() []int32 {
+	return Int32sCopy(s.Snapshot())
+}
+
+// SortedSlice returns a copy of the elements in ascending order.
+func (s *SyncInt32Set) SortedSlice() []int32 {
+	return NewInt32Set(s.Snapshot()...).SortedSlice()
+}
+
+// Clone returns an Int32Set with the current elements.
+func (s *SyncInt32Set) Clone() *Int32Set {
+	return NewInt32Set(s.Snapshot()...)
+}
+
+// unlock invalidates the snapshot and releases the write lock.
+func (s *SyncInt32Set) unlock() {
+	s.snap.Store([]int32(nil))
+	s.mu.Unlock()
+}
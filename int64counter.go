@@ -0,0 +1,164 @@
+package ameda
+
+import "sort"
+
+// Int64Counter is a multiset that maps each int64 element to its count.
+// NOTE:
+//
+//	Only positive counts are kept.
+//	It has the same underlying type as the result of Int64sDistinct and Int64sIntersect,
+//	so they can be converted to each other directly.
+type Int64Counter map[int64]int
+
+// Int64CounterItem is an element with its count.
+type Int64CounterItem struct {
+	Element int64
+	Count   int
+}
+
+// NewInt64Counter creates a counter that counts the elements.
+func NewInt64Counter(elements ...int64) Int64Counter {
+	c := make(Int64Counter, len(elements))
+	c.Add(elements...)
+	return c
+}
+
+// Int64sDistinctCounter is like Int64sDistinct, but returns a counter.
+func Int64sDistinctCounter(i *[]int64, changeSlice bool) Int64Counter {
+	return Int64Counter(Int64sDistinct(i, changeSlice))
+}
+
+// Int64sIntersectCounter is like Int64sIntersect, but returns a counter, which is empty but not nil if there is no common element.
+func Int64sIntersectCounter(i ...[]int64) Int64Counter {
+	if c := Int64sIntersect(i...); c != nil {
+		return Int64Counter(c)
+	}
+	return make(Int64Counter)
+}
+
+// Add increases the count of each element by 1.
+func (c Int64Counter) Add(elements ...int64) {
+	for _, v := range elements {
+		c[v]++
+	}
+}
+
+// AddN increases the count of the element by n, and returns the new count.
+// NOTE:
+//
+//	If the count is no longer positive, the element is removed.
+func (c Int64Counter) AddN(element int64, n int) int {
+	n += c[element]
+	if n <= 0 {
+		delete(c, element)
+		return 0
+	}
+	c[element] = n
+	return n
+}
+
+// Subtract decreases the count of each element by 1.
+// NOTE:
+//
+//	If the count is no longer positive, the element is removed.
+func (c Int64Counter) Subtract(elements ...int64) {
+	for _, v := range elements {
+		c.AddN(v, -1)
+	}
+}
+
+// Get returns the count of the element.
+func (c Int64Counter) Get(element int64) int {
+	return c[element]
+}
+
+// Total returns the sum of all counts.
+func (c Int64Counter) Total() int {
+	var n int
+	for _, v := range c {
+		n += v
+	}
+	return n
+}
+
+// Clone creates a copy of the counter.
+func (c Int64Counter) Clone() Int64Counter {
+	r := make(Int64Counter, len(c))
+	for k, v := range c {
+		r[k] = v
+	}
+	return r
+}
+
+// Union returns a new counter with the maximum count of each element: c ∪ others...
+func (c Int64Counter) Union(others ...Int64Counter) Int64Counter {
+	r := c.Clone()
+	for _, o := range others {
+		for k, v := range o {
+			if v > r[k] {
+				r[k] = v
+			}
+		}
+	}
+	return r
+}
+
+// Intersect returns a new counter with the minimum count of each element: c ∩ others...
+func (c Int64Counter) Intersect(others ...Int64Counter) Int64Counter {
+	r := make(Int64Counter, len(c))
+L:
+	for k, v := range c {
+		for _, o := range others {
+			v2 := o[k]
+			if v2 <= 0 {
+				continue L
+			}
+			if v > v2 {
+				v = v2
+			}
+		}
+		r[k] = v
+	}
+	return r
+}
+
+// MostCommon returns the k most common elements from the most to the least common,
+// elements with equal counts are in ascending order.
+// NOTE:
+//
+//	If k < 0, returns all elements.
+func (c Int64Counter) MostCommon(k int) []Int64CounterItem {
+	items := make([]Int64CounterItem, 0, len(c))
+	for e, n := range c {
+		items = append(items, Int64CounterItem{Element: e, Count: n})
+	}
+	sort.Slice(items, func(i, j int) bool {
+		if items[i].Count != items[j].Count {
+			return items[i].Count > items[j].Count
+		}
+		return items[i].Element < items[j].Element
+	})
+	if k >= 0 && k < len(items) {
+		items = items[:k]
+	}
+	return items
+}
+
+// SortedElements returns the distinct elements in ascending order.
+func (c Int64Counter) SortedElements() []int64 {
+	r := make([]int64, 0, len(c))
+	for k := range c {
+		r = append(r, k)
+	}
+	sort.Slice(r, func(i, j int) bool { return r[i] < r[j] })
+	return r
+}
+
+// RangeSorted calls fn for each element in ascending order; if fn returns false, range stops the iteration.
+func (c Int64Counter) RangeSorted(fn func(element int64, count int) bool) {
+	for _, k := range c.SortedElements() {
+		if !fn(k, c[k]) {
+			return
+		}
+	}
+}
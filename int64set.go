@@ -0,0 +1,222 @@
+package ameda
+
+import "sort"
+
+// Int64Set is a set of int64 elements that remembers the insertion order.
+// NOTE:
+//
+//	The zero value is an empty set ready to use.
+//	It is not safe for concurrent use.
+type Int64Set struct {
+	index   map[int64]int // element -> position in elems
+	elems   []int64
+	removed []bool
+	holes   int
+}
+
+// NewInt64Set creates a set from the elements, see also Int64Set.Slice.
+func NewInt64Set(elements ...int64) *Int64Set {
+	s := &Int64Set{
+		index: make(map[int64]int, len(elements)),
+		elems: make([]int64, 0, len(elements)),
+	}
+	s.Add(elements...)
+	return s
+}
+
+// Add adds the elements that are not yet in the set, and returns the new length of the set.
+func (s *Int64Set) Add(elements ...int64) int {
+	if s.index == nil {
+		s.index = make(map[int64]int, len(elements))
+	}
+	for _, v := range elements {
+		if _, ok := s.index[v]; ok {
+			continue
+		}
+		s.index[v] = len(s.elems)
+		s.elems = append(s.elems, v)
+		if s.removed != nil {
+			s.removed = append(s.removed, false)
+		}
+	}
+	return s.Len()
+}
+
+// Remove removes the elements from the set, and returns the new length of the set.
+func (s *Int64Set) Remove(elements ...int64) int {
+	for _, v := range elements {
+		k, ok := s.index[v]
+		if !ok {
+			continue
+		}
+		delete(s.index, v)
+		if s.removed == nil {
+			s.removed = make([]bool, len(s.elems))
+		}
+		s.removed[k] = true
+		s.holes++
+	}
+	if s.holes > 0 && s.holes*2 >= len(s.elems) {
+		s.compact()
+	}
+	return s.Len()
+}
+
+// Has reports whether the element is in the set.
+func (s *Int64Set) Has(element int64) bool {
+	_, ok := s.index[element]
+	return ok
+}
+
+// Len returns the number of elements in the set.
+func (s *Int64Set) Len() int {
+	return len(s.index)
+}
+
+// Clear removes all elements from the set.
+func (s *Int64Set) Clear() {
+	*s = Int64Set{}
+}
+
+// Clone creates a copy of the set.
+func (s *Int64Set) Clone() *Int64Set {
+	r := &Int64Set{
+		index: make(map[int64]int, s.Len()),
+		elems: make([]int64, 0, s.Len()),
+	}
+	s.Range(func(v int64) bool {
+		r.index[v] = len(r.elems)
+		r.elems = append(r.elems, v)
+		return true
+	})
+	return r
+}
+
+// Range calls fn for each element in insertion order; if fn returns false, range stops the iteration.
+func (s *Int64Set) Range(fn func(v int64) bool) {
+	for k, v := range s.elems {
+		if s.removed != nil && s.removed[k] {
+			continue
+		}
+		if !fn(v) {
+			return
+		}
+	}
+}
+
+// RangeSorted calls fn for each element in ascending order; if fn returns false, range stops the iteration.
+func (s *Int64Set) RangeSorted(fn func(v int64) bool) {
+	for _, v := range s.SortedSlice() {
+		if !fn(v) {
+			return
+		}
+	}
+}
+
+// Slice returns the elements in insertion order.
+func (s *Int64Set) Slice() []int64 {
+	r := make([]int64, 0, s.Len())
+	s.Range(func(v int64) bool {
+		r = append(r, v)
+		return true
+	})
+	return r
+}
+
+// SortedSlice returns the elements in ascending order.
+func (s *Int64Set) SortedSlice() []int64 {
+	r := s.Slice()
+	sort.Slice(r, func(i, j int) bool { return r[i] < r[j] })
+	return r
+}
+
+// Union returns a new set: s ∪ others...
+func (s *Int64Set) Union(others ...*Int64Set) *Int64Set {
+	r := s.Clone()
+	for _, o := range others {
+		o.Range(func(v int64) bool {
+			r.Add(v)
+			return true
+		})
+	}
+	return r
+}
+
+// Intersect returns a new set: s ∩ others...
+func (s *Int64Set) Intersect(others ...*Int64Set) *Int64Set {
+	r := NewInt64Set()
+	s.Range(func(v int64) bool {
+		for _, o := range others {
+			if !o.Has(v) {
+				return true
+			}
+		}
+		r.Add(v)
+		return true
+	})
+	return r
+}
+
+// Difference returns a new set: s - others...
+func (s *Int64Set) Difference(others ...*Int64Set) *Int64Set {
+	r := NewInt64Set()
+	s.Range(func(v int64) bool {
+		for _, o := range others {
+			if o.Has(v) {
+				return true
+			}
+		}
+		r.Add(v)
+		return true
+	})
+	return r
+}
+
+// SymmetricDifference returns a new set of the elements that are in exactly one of the two sets: s ∆ other.
+func (s *Int64Set) SymmetricDifference(other *Int64Set) *Int64Set {
+	r := s.Difference(other)
+	other.Range(func(v int64) bool {
+		if !s.Has(v) {
+			r.Add(v)
+		}
+		return true
+	})
+	return r
+}
+
+// IsSubset reports whether every element of s is also in other: s ⊆ other.
+func (s *Int64Set) IsSubset(other *Int64Set) bool {
+	if s.Len() > other.Len() {
+		return false
+	}
+	for v := range s.index {
+		if !other.Has(v) {
+			return false
+		}
+	}
+	return true
+}
+
+// IsSuperset reports whether every element of other is also in s: s ⊇ other.
+func (s *Int64Set) IsSuperset(other *Int64Set) bool {
+	return other.IsSubset(s)
+}
+
+// Equal reports whether the two sets contain the same elements, regardless of the order.
+func (s *Int64Set) Equal(other *Int64Set) bool {
+	return s.Len() == other.Len() && s.IsSubset(other)
+}
+
+func (s *Int64Set) compact() {
+	elems := s.elems[:0]
+	for k, v := range s.elems {
+		if s.removed[k] {
+			continue
+		}
+		s.index[v] = len(elems)
+		elems = append(elems, v)
+	}
+	s.elems = elems
+	s.removed = nil
+	s.holes = 0
+}
@@ -0,0 +1,373 @@
+package ameda
+
+import (
+	"sync"
+	"sync/atomic"
+)
+
+// SyncInt64s is an int64 slice that is safe for concurrent use.
+// NOTE:
+//
+//	The zero value is an empty slice ready to use.
+//	Point reads such as Len and At use the read lock without copying the elements.
+//	Callbacks are run on a snapshot, so they can call the methods of the same SyncInt64s.
+type SyncInt64s struct {
+	mu   sync.RWMutex
+	data []int64
+	snap atomic.Value // []int64: read-only copy of data, nil after modification
+}
+
+// NewSyncInt64s creates a SyncInt64s with a copy of the elements.
+func NewSyncInt64s(elements ...int64) *SyncInt64s {
+	return &SyncInt64s{data: Int64sCopy(elements)}
+}
+
+// Snapshot returns a read-only copy of the current elements.
+// NOTE:
+//
+//	The result is shared with other callers until the next modification, so it must not be changed.
+//	While there is no modification, it is an atomic read without locking.
+func (s *SyncInt64s) Snapshot() []int64 {
+	if snap, _ := s.snap.Load().([]int64); snap != nil {
+		return snap
+	}
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	snap := Int64sCopy(s.data)
+	s.snap.Store(snap)
+	return snap
+}
+
+// Slice returns a copy of the current elements that can be changed.
+func (s *SyncInt64s) Slice() []int64 {
+	return Int64sCopy(s.Snapshot())
+}
+
+// Len returns the number of elements.
+func (s *SyncInt64s) Len() int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return len(s.data)
+}
+
+// At returns the element at the index, which can be negative and counted from the end.
+func (s *SyncInt64s) At(index int) (v int64, ok bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return Int64sAt(s.data, index)
+}
+
+// Includes determines whether the slice includes a certain element.
+func (s *SyncInt64s) Includes(valueToFind int64, fromIndex ...int) bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return Int64sIncludes(s.data, valueToFind, fromIndex...)
+}
+
+// IndexOf returns the first index at which a given element can be found, or -1 if it is not present.
+func (s *SyncInt64s) IndexOf(searchElement int64, fromIndex ...int) int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return Int64sIndexOf(s.data, searchElement, fromIndex...)
+}
+
+// LastIndexOf returns the last index at which a given element can be found, or -1 if it is not present.
+func (s *SyncInt64s) LastIndexOf(searchElement int64, fromIndex ...int) int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return Int64sLastIndexOf(s.data, searchElement, fromIndex...)
+}
+
+// Range calls fn for each element of a snapshot in order, until fn returns false.
+func (s *SyncInt64s) Range(fn func(k int, v int64) bool) {
+	for k, v := range s.Snapshot() {
+		if !fn(k, v) {
+			return
+		}
+	}
+}
+
+// Every tests whether all elements of a snapshot pass the test implemented by fn.
+func (s *SyncInt64s) Every(fn func(i []int64, k int, v int64) bool) bool {
+	return Int64sEvery(s.Snapshot(), fn)
+}
+
+// Some tests whether at least one element of a snapshot passes the test implemented by fn.
+func (s *SyncInt64s) Some(fn func(i []int64, k int, v int64) bool) bool {
+	return Int64sSome(s.Snapshot(), fn)
+}
+
+// Find returns the key-value of the first element of a snapshot that satisfies fn.
+// If no element satisfies fn, -1 is returned.
+func (s *SyncInt64s) Find(fn func(i []int64, k int, v int64) bool) (k int, v int64) {
+	return Int64sFind(s.Snapshot(), fn)
+}
+
+// Filter creates a new slice with the elements of a snapshot that pass the test implemented by fn.
+func (s *SyncInt64s) Filter(fn func(i []int64, k int, v int64) bool) []int64 {
+	return Int64sFilter(s.Snapshot(), fn)
+}
+
+// Map creates a new slice with the results of calling fn on every element of a snapshot.
+func (s *SyncInt64s) Map(fn func(i []int64, k int, v int64) int64) []int64 {
+	return Int64sMap(s.Snapshot(), fn)
+}
+
+// Reduce executes fn on each element of a snapshot, resulting in a single output value.
+func (s *SyncInt64s) Reduce(fn func(i []int64, k int, v, accumulator int64) int64, initialValue ...int64) int64 {
+	return Int64sReduce(s.Snapshot(), fn, initialValue...)
+}
+
+// Set changes the element at the index, which can be negative and counted from the end,
+// and reports whether the index is in range.
+func (s *SyncInt64s) Set(index int, value int64) bool {
+	s.mu.Lock()
+	defer s.unlock()
+	k, ok := atIndex(len(s.data), index)
+	if ok {
+		s.data[k] = value
+	}
+	return ok
+}
+
+// Push adds one or more elements to the end, and returns the new length.
+func (s *SyncInt64s) Push(element ...int64) int {
+	s.mu.Lock()
+	defer s.unlock()
+	return Int64sPush(&s.data, element...)
+}
+
+// PushDistinct adds one or more new elements that do not exist at the end, and returns the new length.
+func (s *SyncInt64s) PushDistinct(element ...int64) int {
+	s.mu.Lock()
+	defer s.unlock()
+	s.data = Int64sPushDistinct(s.data, element...)
+	return len(s.data)
+}
+
+// Pop removes the last element and returns that element.
+func (s *SyncInt64s) Pop() (int64, bool) {
+	s.mu.Lock()
+	defer s.unlock()
+	return Int64sPop(&s.data)
+}
+
+// Shift removes the first element and returns that element.
+func (s *SyncInt64s) Shift() (int64, bool) {
+	s.mu.Lock()
+	defer s.unlock()
+	return Int64sShift(&s.data)
+}
+
+// Unshift adds one or more elements to the beginning, and returns the new length.
+func (s *SyncInt64s) Unshift(element ...int64) int {
+	s.mu.Lock()
+	defer s.unlock()
+	return Int64sUnshift(&s.data, element...)
+}
+
+// UnshiftDistinct adds one or more new elements that do not exist to the beginning, and returns the new length.
+func (s *SyncInt64s) UnshiftDistinct(element ...int64) int {
+	s.mu.Lock()
+	defer s.unlock()
+	return Int64sUnshiftDistinct(&s.data, element...)
+}
+
+// Splice removes deleteCount elements from start and adds the items in their place.
+func (s *SyncInt64s) Splice(start, deleteCount int, items ...int64) {
+	s.mu.Lock()
+	defer s.unlock()
+	Int64sSplice(&s.data, start, deleteCount, items...)
+}
+
+// RemoveFirst removes the first matched elements, and returns the new length.
+func (s *SyncInt64s) RemoveFirst(elements ...int64) int {
+	s.mu.Lock()
+	defer s.unlock()
+	return Int64sRemoveFirst(&s.data, elements...)
+}
+
+// RemoveEvery removes all the elements, and returns the new length.
+func (s *SyncInt64s) RemoveEvery(elements ...int64) int {
+	s.mu.Lock()
+	defer s.unlock()
+	return Int64sRemoveEvery(&s.data, elements...)
+}
+
+// Distinct removes the duplicate elements, and returns the count of each element.
+func (s *SyncInt64s) Distinct() (distinctCount map[int64]int) {
+	s.mu.Lock()
+	defer s.unlock()
+	return Int64sDistinct(&s.data, true)
+}
+
+// Fill changes all elements from start to end to a static value.
+func (s *SyncInt64s) Fill(value int64, start int, end ...int) {
+	s.mu.Lock()
+	defer s.unlock()
+	Int64sFill(s.data, value, start, end...)
+}
+
+// CopyWithin copies part of the slice to another location in the same slice.
+func (s *SyncInt64s) CopyWithin(target, start int, end ...int) {
+	s.mu.Lock()
+	defer s.unlock()
+	Int64sCopyWithin(s.data, target, start, end...)
+}
+
+// Reverse reverses the elements in place.
+func (s *SyncInt64s) Reverse() {
+	s.mu.Lock()
+	defer s.unlock()
+	Int64sReverse(s.data)
+}
+
+// Shuffle randomizes the order of the elements in place.
+func (s *SyncInt64s) Shuffle(r Randomizer) {
+	s.mu.Lock()
+	defer s.unlock()
+	Int64sShuffle(s.data, r)
+}
+
+// ApplyPatch applies the edit script to the elements.
+func (s *SyncInt64s) ApplyPatch(patch []Int64sEdit) error {
+	s.mu.Lock()
+	defer s.unlock()
+	return Int64sApplyPatch(&s.data, patch)
+}
+
+// Update calls fn with the pointer of the elements under the write lock.
+// NOTE:
+//
+//	fn must not call the methods of the same SyncInt64s, and must not retain the slice.
+func (s *SyncInt64s) Update(fn func(p *[]int64)) {
+	s.mu.Lock()
+	defer s.unlock()
+	fn(&s.data)
+}
+
+// Clear removes all elements.
+func (s *SyncInt64s) Clear() {
+	s.mu.Lock()
+	defer s.unlock()
+	s.data = nil
+}
+
+// unlock invalidates the snapshot and releases the write lock.
+func (s *SyncInt64s) unlock() {
+	s.snap.Store([]int64(nil))
+	s.mu.Unlock()
+}
+
+// SyncInt64Set is an int64 set that is safe for concurrent use.
+// NOTE:
+//
+//	The zero value is an empty set ready to use.
+//	Len and Has use the read lock without copying the elements.
+//	Callbacks are run on a snapshot, so they can call the methods of the same SyncInt64Set.
+type SyncInt64Set struct {
+	mu   sync.RWMutex
+	set  *Int64Set
+	snap atomic.Value // []int64: read-only elements in insertion order, nil after modification
+}
+
+// NewSyncInt64Set creates a SyncInt64Set with the elements.
+func NewSyncInt64Set(elements ...int64) *SyncInt64Set {
+	return &SyncInt64Set{set: NewInt64Set(elements...)}
+}
+
+// Snapshot returns the read-only elements in insertion order.
+// NOTE:
+//
+//	The result is shared with other callers until the next modification, so it must not be changed.
+//	While there is no modification, it is an atomic read without locking.
+func (s *SyncInt64Set) Snapshot() []int64 {
+	if snap, _ := s.snap.Load().([]int64); snap != nil {
+		return snap
+	}
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	var snap []int64
+	if s.set != nil {
+		snap = s.set.Slice()
+	}
+	if snap == nil {
+		snap = []int64{}
+	}
+	s.snap.Store(snap)
+	return snap
+}
+
+// Add adds the elements that are not yet in the set, and returns the new length of the set.
+func (s *SyncInt64Set) Add(elements ...int64) int {
+	s.mu.Lock()
+	defer s.unlock()
+	if s.set == nil {
+		s.set = NewInt64Set()
+	}
+	return s.set.Add(elements...)
+}
+
+// Remove removes the elements from the set, and returns the new length of the set.
+func (s *SyncInt64Set) Remove(elements ...int64) int {
+	s.mu.Lock()
+	defer s.unlock()
+	if s.set == nil {
+		return 0
+	}
+	return s.set.Remove(elements...)
+}
+
+// Has reports whether the element is in the set.
+func (s *SyncInt64Set) Has(element int64) bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.set != nil && s.set.Has(element)
+}
+
+// Len returns the number of elements in the set.
+func (s *SyncInt64Set) Len() int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	if s.set == nil {
+		return 0
+	}
+	return s.set.Len()
+}
+
+// Clear removes all elements.
+func (s *SyncInt64Set) Clear() {
+	s.mu.Lock()
+	defer s.unlock()
+	s.set = nil
+}
+
+// Range calls fn for each element of a snapshot in insertion order, until fn returns false.
+func (s *SyncInt64Set) Range(fn func(v int64) bool) {
+	for _, v := range s.Snapshot() {
+		if !fn(v) {
+			return
+		}
+	}
+}
+
+// Slice returns a copy of the elements in insertion order.
+func (s *SyncInt64Set) Slice() []int64 {
+	return Int64sCopy(s.Snapshot())
+}
+
+// SortedSlice returns a copy of the elements in ascending order.
+func (s *SyncInt64Set) SortedSlice() []int64 {
+	return NewInt64Set(s.Snapshot()...).SortedSlice()
+}
+
+// Clone returns an Int64Set with the current elements.
+func (s *SyncInt64Set) Clone() *Int64Set {
+	return NewInt64Set(s.Snapshot()...)
+}
+
+// unlock invalidates the snapshot and releases the write lock.
+func (s *SyncInt64Set) unlock() {
+	s.snap.Store([]int64(nil))
+	s.mu.Unlock()
+}
@@ -0,0 +1,164 @@
+package ameda
+
+import "sort"
+
+// Int8Counter is a multiset that maps each int8 element to its count.
+// NOTE:
+//
+//	Only positive counts are kept.
+//	It has the same underlying type as the result of Int8sDistinct and Int8sIntersect,
+//	so they can be converted to each other directly.
+type Int8Counter map[int8]int
+
+// Int8CounterItem is an element with its count.
+type Int8CounterItem struct {
+	Element int8
+	Count   int
+}
+
+// NewInt8Counter creates a counter that counts the elements.
+func NewInt8Counter(elements ...int8) Int8Counter {
+	c := make(Int8Counter, len(elements))
+	c.Add(elements...)
+	return c
+}
+
+// Int8sDistinctCounter is like Int8sDistinct, but returns a counter.
+func Int8sDistinctCounter(i *[]int8, changeSlice bool) Int8Counter {
+	return Int8Counter(Int8sDistinct(i, changeSlice))
+}
+
+// Int8sIntersectCounter is like Int8sIntersect, but returns a counter, which is empty but not nil if there is no common element.
+func Int8sIntersectCounter(i ...[]int8) Int8Counter {
+	if c := Int8sIntersect(i...); c != nil {
+		return Int8Counter(c)
+	}
+	return make(Int8Counter)
+}
+
+// Add increases the count of each element by 1.
+func (c Int8Counter) Add(elements ...int8) {
+	for _, v := range elements {
+		c[v]++
+	}
+}
+
+// AddN increases the count of the element by n, and returns the new count.
+// NOTE:
+//
+//	If the count is no longer positive, the element is removed.
+func (c Int8Counter) AddN(element int8, n int) int {
+	n += c[element]
+	if n <= 0 {
+		delete(c, element)
+		return 0
+	}
+	c[element] = n
+	return n
+}
+
+// Subtract decreases the count of each element by 1.
+// NOTE:
+//
+//	If the count is no longer positive, the element is removed.
+func (c Int8Counter) Subtract(elements ...int8) {
+	for _, v := range elements {
+		c.AddN(v, -1)
+	}
+}
+
+// Get returns the count of the element.
+func (c Int8Counter) Get(element int8) int {
+	return c[element]
+}
+
+// Total returns the sum of all counts.
+func (c Int8Counter) Total() int {
+	var n int
+	for _, v := range c {
+		n += v
+	}
+	return n
+}
+
+// Clone creates a copy of the counter.
+func (c Int8Counter) Clone() Int8Counter {
+	r := make(Int8Counter, len(c))
+	for k, v := range c {
+		r[k] = v
+	}
+	return r
+}
+
+// Union returns a new counter with the maximum count of each element: c ∪ others...
+func (c Int8Counter) Union(others ...Int8Counter) Int8Counter {
+	r := c.Clone()
+	for _, o := range others {
+		for k, v := range o {
+			if v > r[k] {
+				r[k] = v
+			}
+		}
+	}
+	return r
+}
+
+// Intersect returns a new counter with the minimum count of each element: c ∩ others...
+func (c Int8Counter) Intersect(others ...Int8Counter) Int8Counter {
+	r := make(Int8Counter, len(c))
+L:
+	for k, v := range c {
+		for _, o := range others {
+			v2 := o[k]
+			if v2 <= 0 {
+				continue L
+			}
+			if v > v2 {
+				v = v2
+			}
+		}
+		r[k] = v
+	}
+	return r
+}
+
+// MostCommon returns the k most common elements from the most to the least common,
+// elements with equal counts are in ascending order.
+// NOTE:
+//
+//	If k < 0, returns all elements.
+func (c Int8Counter) MostCommon(k int) []Int8CounterItem {
+	items := make([]Int8CounterItem, 0, len(c))
+	for e, n := range c {
+		items = append(items, Int8CounterItem{Element: e, Count: n})
+	}
+	sort.Slice(items, func(i, j int) bool {
+		if items[i].Count != items[j].Count {
+			return items[i].Count > items[j].Count
+		}
+		return items[i].Element < items[j].Element
+	})
+	if k >= 0 && k < len(items) {
+		items = items[:k]
+	}
+	return items
+}
+
+// SortedElements returns the distinct elements in ascending order.
+func (c Int8Counter) SortedElements() []int8 {
+	r := make([]int8, 0, len(c))
+	for k := range c {
+		r = append(r, k)
+	}
+	sort.Slice(r, func(i, j int) bool { return r[i] < r[j] })
+	return r
+}
+
+// RangeSorted calls fn for each element in ascending order; if fn returns false, range stops the iteration.
+func (c Int8Counter) RangeSorted(fn func(element int8, count int) bool) {
+	for _, k := range c.SortedElements() {
+		if !fn(k, c[k]) {
+			return
+		}
+	}
+}
@@ -0,0 +1,373 @@
+package ameda
+
+import (
+	"sync"
+	"sync/atomic"
+)
+
+// SyncInt8s is an int8 slice that is safe for concurrent use.
+// NOTE:
+//
+//	The zero value is an empty slice ready to use.
+//	Point reads such as Len and At use the read lock without copying the elements.
+//	Callbacks are run on a snapshot, so they can call the methods of the same SyncInt8s.
+type SyncInt8s struct {
+	mu   sync.RWMutex
+	data []int8
+	snap atomic.Value // []int8: read-only copy of data, nil after modification
+}
+
+// NewSyncInt8s creates a SyncInt8s with a copy of the elements.
+func NewSyncInt8s(elements ...int8) *SyncInt8s {
+	return &SyncInt8s{data: Int8sCopy(elements)}
+}
+
+// Snapshot returns a read-only copy of the current elements.
+// NOTE:
+//
+//	The result is shared with other callers until the next modification, so it must not be changed.
+//	While there is no modification, it is an atomic read without locking.
+func (s *SyncInt8s) Snapshot() []int8 {
+	if snap, _ := s.snap.Load().([]int8); snap != nil {
+		return snap
+	}
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	snap := Int8sCopy(s.data)
+	s.snap.Store(snap)
+	return snap
+}
+
+// Slice returns a copy of the current elements that can be changed.
+func (s *SyncInt8s) Slice() []int8 {
+	return Int8sCopy(s.Snapshot())
+}
+
+// Len returns the number of elements.
+func (s *SyncInt8s) Len() int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return len(s.data)
+}
+
+// At returns the element at the index, which can be negative and counted from the end.
+func (s *SyncInt8s) At(index int) (v int8, ok bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return Int8sAt(s.data, index)
+}
+
+// Includes determines whether the slice includes a certain element.
+func (s *SyncInt8s) Includes(valueToFind int8, fromIndex ...int) bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return Int8sIncludes(s.data, valueToFind, fromIndex...)
+}
+
+// IndexOf returns the first index at which a given element can be found, or -1 if it is not present.
+func (s *SyncInt8s) IndexOf(searchElement int8, fromIndex ...int) int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return Int8sIndexOf(s.data, searchElement, fromIndex...)
+}
+
+// LastIndexOf returns the last index at which a given element can be found, or -1 if it is not present.
+func (s *SyncInt8s) LastIndexOf(searchElement int8, fromIndex ...int) int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return Int8sLastIndexOf(s.data, searchElement, fromIndex...)
+}
+
+// Range calls fn for each element of a snapshot in order, until fn returns false.
+func (s *SyncInt8s) Range(fn func(k int, v int8) bool) {
+	for k, v := range s.Snapshot() {
+		if !fn(k, v) {
+			return
+		}
+	}
+}
+
+// Every tests whether all elements of a snapshot pass the test implemented by fn.
+func (s *SyncInt8s) Every(fn func(i []int8, k int, v int8) bool) bool {
+	return Int8sEvery(s.Snapshot(), fn)
+}
+
+// Some tests whether at least one element of a snapshot passes the test implemented by fn.
+func (s *SyncInt8s) Some(fn func(i []int8, k int, v int8) bool) bool {
+	return Int8sSome(s.Snapshot(), fn)
+}
+
+// Find returns the key-value of the first element of a snapshot that satisfies fn.
+// If no element satisfies fn, -1 is returned.
+func (s *SyncInt8s) Find(fn func(i []int8, k int, v int8) bool) (k int, v int8) {
+	return Int8sFind(s.Snapshot(), fn)
+}
+
+// Filter creates a new slice with the elements of a snapshot that pass the test implemented by fn.
+func (s *SyncInt8s) Filter(fn func(i []int8, k int, v int8) bool) []int8 {
+	return Int8sFilter(s.Snapshot(), fn)
+}
+
+// Map creates a new slice with the results of calling fn on every element of a snapshot.
+func (s *SyncInt8s) Map(fn func(i []int8, k int, v int8) int8) []int8 {
+	return Int8sMap(s.Snapshot(), fn)
+}
+
+// Reduce executes fn on each element of a snapshot, resulting in a single output value.
+func (s *SyncInt8s) Reduce(fn func(i []int8, k int, v, accumulator int8) int8, initialValue ...int8) int8 {
+	return Int8sReduce(s.Snapshot(), fn, initialValue...)
+}
+
+// Set changes the element at the index, which can be negative and counted from the end,
+// and reports whether the index is in range.
+func (s *SyncInt8s) Set(index int, value int8) bool {
+	s.mu.Lock()
+	defer s.unlock()
+	k, ok := atIndex(len(s.data), index)
+	if ok {
+		s.data[k] = value
+	}
+	return ok
+}
+
+// Push adds one or more elements to the end, and returns the new length.
+func (s *SyncInt8s) Push(element ...int8) int {
+	s.mu.Lock()
+	defer s.unlock()
+	return Int8sPush(&s.data, element...)
+}
+
+// PushDistinct adds one or more new elements that do not exist at the end, and returns the new length.
+func (s *SyncInt8s) PushDistinct(element ...int8) int {
+	s.mu.Lock()
+	defer s.unlock()
+	s.data = Int8sPushDistinct(s.data, element...)
+	return len(s.data)
+}
+
+// Pop removes the last element and returns that element.
+func (s *SyncInt8s) Pop() (int8, bool) {
+	s.mu.Lock()
+	defer s.unlock()
+	return Int8sPop(&s.data)
+}
+
+// Shift removes the first element and returns that element.
+func (s *SyncInt8s) Shift() (int8, bool) {
+	s.mu.Lock()
+	defer s.unlock()
+	return Int8sShift(&s.data)
+}
+
+// Unshift adds one or more elements to the beginning, and returns the new length.
+func (s *SyncInt8s) Unshift(element ...int8) int {
+	s.mu.Lock()
+	defer s.unlock()
+	return Int8sUnshift(&s.data, element...)
+}
+
+// UnshiftDistinct adds one or more new elements that do not exist to the beginning, and returns the new length.
+func (s *SyncInt8s) UnshiftDistinct(element ...int8) int {
+	s.mu.Lock()
+	defer s.unlock()
+	return Int8sUnshiftDistinct(&s.data, element...)
+}
+
+// Splice removes deleteCount elements from start and adds the items in their place.
+func (s *SyncInt8s) Splice(start, deleteCount int, items ...int8) {
+	s.mu.Lock()
+	defer s.unlock()
+	Int8sSplice(&s.data, start, deleteCount, items...)
+}
+
+// RemoveFirst removes the first matched elements, and returns the new length.
+func (s *SyncInt8s) RemoveFirst(elements ...int8) int {
+	s.mu.Lock()
+	defer s.unlock()
+	return Int8sRemoveFirst(&s.data, elements...)
+}
+
+// RemoveEvery removes all the elements, and returns the new length.
+func (s *SyncInt8s) RemoveEvery(elements ...int8) int {
+	s.mu.Lock()
+	defer s.unlock()
+	return Int8sRemoveEvery(&s.data, elements...)
+}
+
+// Distinct removes the duplicate elements, and returns the count of each element.
+func (s *SyncInt8s) Distinct() (distinctCount map[int8]int) {
+	s.mu.Lock()
+	defer s.unlock()
+	return Int8sDistinct(&s.data, true)
+}
+
+// Fill changes all elements from start to end to a static value.
+func (s *SyncInt8s) Fill(value int8, start int, end ...int) {
+	s.mu.Lock()
+	defer s.unlock()
+	Int8sFill(s.data, value, start, end...)
+}
+
+// CopyWithin copies part of the slice to another location in the same slice.
+func (s *SyncInt8s) CopyWithin(target, start int, end ...int) {
+	s.mu.Lock()
+	defer s.unlock()
+	Int8sCopyWithin(s.data, target, start, end...)
+}
+
+// Reverse reverses the elements in place.
+func (s *SyncInt8s) Reverse() {
+	s.mu.Lock()
+	defer s.unlock()
+	Int8sReverse(s.data)
+}
+
+// Shuffle randomizes the order of the elements in place.
+func (s *SyncInt8s) Shuffle(r Randomizer) {
+	s.mu.Lock()
+	defer s.unlock()
+	Int8sShuffle(s.data, r)
+}
+
+// ApplyPatch applies the edit script to the elements.
+func (s *SyncInt8s) ApplyPatch(patch []Int8sEdit) error {
+	s.mu.Lock()
+	defer s.unlock()
+	return Int8sApplyPatch(&s.data, patch)
+}
+
+// Update calls fn with the pointer of the elements under the write lock.
+// NOTE:
+//
+//	fn must not call the methods of the same SyncInt8s, and must not retain the slice.
+func (s *SyncInt8s) Update(fn func(p *[]int8)) {
+	s.mu.Lock()
+	defer s.unlock()
+	fn(&s.data)
+}
+
+// Clear removes all elements.
+func (s *SyncInt8s) Clear() {
+	s.mu.Lock()
+	defer s.unlock()
+	s.data = nil
+}
+
+// unlock invalidates the snapshot and releases the write lock.
+func (s *SyncInt8s) unlock() {
+	s.snap.Store([]int8(nil))
+	s.mu.Unlock()
+}
+
+// SyncInt8Set is an int8 set that is safe for concurrent use.
+// NOTE:
+//
+//	The zero value is an empty set ready to use.
+//	Len and Has use the read lock without copying the elements.
+//	Callbacks are run on a snapshot, so they can call the methods of the same SyncInt8Set.
+type SyncInt8Set struct {
+	mu   sync.RWMutex
+	set  *Int8Set
+	snap atomic.Value // []int8: read-only elements in insertion order, nil after modification
+}
+
+// NewSyncInt8Set creates a SyncInt8Set with the elements.
+func NewSyncInt8Set(elements ...int8) *SyncInt8Set {
+	return &SyncInt8Set{set: NewInt8Set(elements...)}
+}
+
+// Snapshot returns the read-only elements in insertion order.
+// NOTE:
+//
+//	The result is shared with other callers until the next modification, so it must not be changed.
+//	While there is no modification, it is an atomic read without locking.
+func (s *SyncInt8Set) Snapshot() []int8 {
+	if snap, _ := s.snap.Load().([]int8); snap != nil {
+		return snap
+	}
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	var snap []int8
+	if s.set != nil {
+		snap = s.set.Slice()
+	}
+	if snap == nil {
+		snap = []int8{}
+	}
+	s.snap.Store(snap)
+	return snap
+}
+
+// Add adds the elements that are not yet in the set, and returns the new length of the set.
+func (s *SyncInt8Set) Add(elements ...int8) int {
+	s.mu.Lock()
+	defer s.unlock()
+	if s.set == nil {
+		s.set = NewInt8Set()
+	}
+	return s.set.Add(elements...)
+}
+
+// Remove removes the elements from the set, and returns the new length of the set.
+func (s *SyncInt8Set) Remove(elements ...int8) int {
+	s.mu.Lock()
+	defer s.unlock()
+	if s.set == nil {
+		return 0
+	}
+	return s.set.Remove(elements...)
+}
+
+// Has reports whether the element is in the set.
+func (s *SyncInt8Set) Has(element int8) bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.set != nil && s.set.Has(element)
+}
+
+// Len returns the number of elements in the set.
+func (s *SyncInt8Set) Len() int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	if s.set == nil {
+		return 0
+	}
+	return s.set.Len()
+}
+
+// Clear removes all elements.
+func (s *SyncInt8Set) Clear() {
+	s.mu.Lock()
+	defer s.unlock()
+	s.set = nil
+}
+
+// Range calls fn for each element of a snapshot in insertion order, until fn returns false.
+func (s *SyncInt8Set) Range(fn func(v int8) bool) {
+	for _, v := range s.Snapshot() {
+		if !fn(v) {
+			return
+		}
+	}
+}
+
+// Slice returns a copy of the elements in insertion order.
+func (s *SyncInt8Set) Slice() []int8 {
+	return Int8sCopy(s.Snapshot())
+}
+
+// SortedSlice returns a copy of the elements in ascending order.
+func (s *SyncInt8Set) SortedSlice() []int8 {
+	return NewInt8Set(s.Snapshot()...).SortedSlice()
+}
+
+// Clone returns an Int8Set with the current elements.
+func (s *SyncInt8Set) Clone() *Int8Set {
+	return NewInt8Set(s.Snapshot()...)
+}
+
+// unlock invalidates the snapshot and releases the write lock.
+func (s *SyncInt8Set) unlock() {
+	s.snap.Store([]int8(nil))
+	s.mu.Unlock()
+}
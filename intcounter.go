@@ -0,0 +1,164 @@
+package ameda
+
+import "sort"
+
+// IntCounter is a multiset that maps each int element to its count.
+// NOTE:
+//
+//	Only positive counts are kept.
+//	It has the same underlying type as the result of IntsDistinct and IntsIntersect,
+//	so they can be converted to each other directly.
+type IntCounter map[int]int
+
+// IntCounterItem is an element with its count.
+type IntCounterItem struct {
+	Element int
+	Count   int
+}
+
+// NewIntCounter creates a counter that counts the elements.
+func NewIntCounter(elements ...int) IntCounter {
+	c := make(IntCounter, len(elements))
+	c.Add(elements...)
+	return c
+}
+
+// IntsDistinctCounter is like IntsDistinct, but returns a counter.
+func IntsDistinctCounter(i *[]int, changeSlice bool) IntCounter {
+	return IntCounter(IntsDistinct(i, changeSlice))
+}
+
+// IntsIntersectCounter is like IntsIntersect, but returns a counter, which is empty but not nil if there is no common element.
+func IntsIntersectCounter(i ...[]int) IntCounter {
+	if c := IntsIntersect(i...); c != nil {
+		return IntCounter(c)
+	}
+	return make(IntCounter)
+}
+
+// Add increases the count of each element by 1.
+func (c IntCounter) Add(elements ...int) {
+	for _, v := range elements {
+		c[v]++
+	}
+}
+
+// AddN increases the count of the element by n, and returns the new count.
+// NOTE:
+//
+//	If the count is no longer positive, the element is removed.
+func (c IntCounter) AddN(element int, n int) int {
+	n += c[element]
+	if n <= 0 {
+		delete(c, element)
+		return 0
+	}
+	c[element] = n
+	return n
+}
+
+// Subtract decreases the count of each element by 1.
+// NOTE:
+//
+//	If the count is no longer positive, the element is removed.
+func (c IntCounter) Subtract(elements ...int) {
+	for _, v := range elements {
+		c.AddN(v, -1)
+	}
+}
+
+// Get returns the count of the element.
+func (c IntCounter) Get(element int) int {
+	return c[element]
+}
+
+// Total returns the sum of all counts.
+func (c IntCounter) Total() int {
+	var n int
+	for _, v := range c {
+		n += v
+	}
+	return n
+}
+
+// Clone creates a copy of the counter.
+func (c IntCounter) Clone() IntCounter {
+	r := make(IntCounter, len(c))
+	for k, v := range c {
+		r[k] = v
+	}
+	return r
+}
+
+// Union returns a new counter with the maximum count of each element: c ∪ others...
+func (c IntCounter) Union(others ...IntCounter) IntCounter {
+	r := c.Clone()
+	for _, o := range others {
+		for k, v := range o {
+			if v > r[k] {
+				r[k] = v
+			}
+		}
+	}
+	return r
+}
+
+// Intersect returns a new counter with the minimum count of each element: c ∩ others...
+func (c IntCounter) Intersect(others ...IntCounter) IntCounter {
+	r := make(IntCounter, len(c))
+L:
+	for k, v := range c {
+		for _, o := range others {
+			v2 := o[k]
+			if v2 <= 0 {
+				continue L
+			}
+			if v > v2 {
+				v = v2
+			}
+		}
+		r[k] = v
+	}
+	return r
+}
+
+// MostCommon returns the k most common elements from the most to the least common,
+// elements with equal counts are in ascending order.
+// NOTE:
+//
+//	If k < 0, returns all elements.
+func (c IntCounter) MostCommon(k int) []IntCounterItem {
+	items := make([]IntCounterItem, 0, len(c))
+	for e, n := range c {
+		items = append(items, IntCounterItem{Element: e, Count: n})
+	}
+	sort.Slice(items, func(i, j int) bool {
+		if items[i].Count != items[j].Count {
+			return items[i].Count > items[j].Count
+		}
+		return items[i].Element < items[j].Element
+	})
+	if k >= 0 && k < len(items) {
+		items = items[:k]
+	}
+	return items
+}
+
+// SortedElements returns the distinct elements in ascending order.
+func (c IntCounter) SortedElements() []int {
+	r := make([]int, 0, len(c))
+	for k := range c {
+		r = append(r, k)
+	}
+	sort.Slice(r, func(i, j int) bool { return r[i] < r[j] })
+	return r
+}
+
+// RangeSorted calls fn for each element in ascending order; if fn returns false, range stops the iteration.
+func (c IntCounter) RangeSorted(fn func(element int, count int) bool) {
+	for _, k := range c.SortedElements() {
+		if !fn(k, c[k]) {
+			return
+		}
+	}
+}
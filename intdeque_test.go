@@ -0,0 +1,69 @@
+package ameda
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIntDeque(t *testing.T) {
+	var d IntDeque
+	_, ok := d.PopFront()
+	assert.False(t, ok)
+	assert.Equal(t, 3, d.PushBack(1, 2, 3))
+	assert.Equal(t, 5, d.PushFront(-1, 0))
+	assert.Equal(t, []int{-1, 0, 1, 2, 3}, d.Slice())
+	for i := 4; i < 20; i++ {
+		d.PushBack(i)
+	}
+	assert.Equal(t, 21, d.Len())
+	v, ok := d.PopFront()
+	assert.True(t, ok)
+	assert.Equal(t, -1, v)
+	v, ok = d.PopBack()
+	assert.True(t, ok)
+	assert.Equal(t, 19, v)
+	v, _ = d.At(-1)
+	assert.Equal(t, 18, v)
+	v, _ = d.Front()
+	assert.Equal(t, 0, v)
+	assert.True(t, d.Set(1, 100))
+	assert.False(t, d.Set(100, 100))
+	v, _ = d.At(1)
+	assert.Equal(t, 100, v)
+
+	var keys []int
+	d.Range(func(k int, v int) bool {
+		keys = append(keys, k)
+		return k < 2
+	})
+	assert.Equal(t, []int{0, 1, 2}, keys)
+	d.Clear()
+	assert.Equal(t, 0, d.Len())
+	assert.Equal(t, []int{}, d.Slice())
+}
+
+func TestIntDequeWrap(t *testing.T) {
+	d := NewIntDeque(1, 2, 3)
+	assert.Equal(t, 3, d.Cap())
+	d.PopFront()
+	d.PushBack(4)
+	d.PushBack(5) // grows while wrapped
+	assert.Equal(t, []int{2, 3, 4, 5}, d.Slice())
+	d.PushFront(1)
+	assert.Equal(t, []int{1, 2, 3, 4, 5}, d.Slice())
+}
+
+func TestIntRingBuffer(t *testing.T) {
+	r := NewIntRingBuffer(3)
+	assert.Equal(t, 3, r.PushBack(1, 2, 3, 4, 5))
+	assert.Equal(t, []int{3, 4, 5}, r.Slice())
+	assert.Equal(t, 3, r.PushFront(0))
+	assert.Equal(t, []int{0, 3, 4}, r.Slice())
+	v, _ := r.PopBack()
+	assert.Equal(t, 4, v)
+	r.PushBack(6, 7)
+	assert.Equal(t, []int{3, 6, 7}, r.Slice())
+	assert.Equal(t, 3, r.Cap())
+	assert.Panics(t, func() { NewIntRingBuffer(0) })
+}
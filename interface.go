@@ -2,6 +2,7 @@ package ameda
 
 import (
 	"fmt"
+	"math"
 	"reflect"
 )
 
@@ -812,3 +813,110 @@ func InterfaceToUint64Ptr(i interface{}, emptyAsFalse ...bool) (*uint64, error)
 	r, err := InterfaceToUint64(i, emptyAsFalse...)
 	return &r, err
 }
+
+// InterfaceEqualFunc reports whether the two values are regarded as equal.
+type InterfaceEqualFunc func(a, b interface{}) bool
+
+var (
+	_ InterfaceEqualFunc = InterfaceStrictEqual
+	_ InterfaceEqualFunc = InterfaceDeepEqual
+	_ InterfaceEqualFunc = InterfaceLooseEqual
+)
+
+// InterfaceStrictEqual reports whether a == b.
+// NOTE:
+//
+//	Unlike the == operator, it returns false instead of panicking if the dynamic type is not comparable.
+func InterfaceStrictEqual(a, b interface{}) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	ta := reflect.TypeOf(a)
+	if ta != reflect.TypeOf(b) || !ta.Comparable() {
+		return false
+	}
+	defer func() { recover() }() // comparable struct or array types may still contain incomparable interfaces
+	return a == b
+}
+
+// InterfaceDeepEqual reports whether a and b are deeply equal, see reflect.DeepEqual.
+func InterfaceDeepEqual(a, b interface{}) bool {
+	return reflect.DeepEqual(a, b)
+}
+
+// InterfaceLooseEqual reports whether a and b are equal, ignoring the difference of numeric types.
+// NOTE:
+//
+//	The numbers are converted by InterfaceToInt64, InterfaceToUint64 or InterfaceToFloat64,
+//	e.g. int(1), uint8(1) and float64(1.0) are equal to each other;
+//	an integer and a float are compared exactly, e.g. int64(1<<53+1) does not equal float64(1<<53);
+//	other values are compared by InterfaceStrictEqual.
+func InterfaceLooseEqual(a, b interface{}) bool {
+	if InterfaceStrictEqual(a, b) {
+		return true
+	}
+	ka, kb := numericKind(a), numericKind(b)
+	if ka == reflect.Invalid || kb == reflect.Invalid {
+		return false
+	}
+	switch {
+	case ka == reflect.Int64 && kb == reflect.Int64:
+		x, _ := InterfaceToInt64(a)
+		y, _ := InterfaceToInt64(b)
+		return x == y
+	case ka == reflect.Uint64 && kb == reflect.Uint64:
+		x, _ := InterfaceToUint64(a)
+		y, _ := InterfaceToUint64(b)
+		return x == y
+	case ka == reflect.Int64 && kb == reflect.Uint64:
+		x, _ := InterfaceToInt64(a)
+		y, _ := InterfaceToUint64(b)
+		return x >= 0 && uint64(x) == y
+	case ka == reflect.Uint64 && kb == reflect.Int64:
+		x, _ := InterfaceToUint64(a)
+		y, _ := InterfaceToInt64(b)
+		return y >= 0 && x == uint64(y)
+	case ka == reflect.Float64 && kb == reflect.Float64:
+		x, _ := InterfaceToFloat64(a)
+		y, _ := InterfaceToFloat64(b)
+		return x == y
+	case ka == reflect.Float64:
+		return looseEqualFloat(a, b, kb)
+	default:
+		return looseEqualFloat(b, a, ka)
+	}
+}
+
+// looseEqualFloat reports whether the float f equals the integer i of the kind exactly,
+// without the rounding of converting i to float64.
+func looseEqualFloat(f, i interface{}, kind reflect.Kind) bool {
+	x, _ := InterfaceToFloat64(f)
+	if x != math.Trunc(x) {
+		// not integral, or NaN
+		return false
+	}
+	if kind == reflect.Int64 {
+		y, _ := InterfaceToInt64(i)
+		return x >= math.MinInt64 && x < -math.MinInt64 && int64(x) == y
+	}
+	y, _ := InterfaceToUint64(i)
+	return x >= 0 && x < 1<<64 && uint64(x) == y
+}
+
+// numericKind returns reflect.Int64, reflect.Uint64 or reflect.Float64 for the numeric value,
+// otherwise returns reflect.Invalid.
+func numericKind(i interface{}) reflect.Kind {
+	if i == nil {
+		return reflect.Invalid
+	}
+	switch IndirectValue(reflect.ValueOf(i)).Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return reflect.Int64
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return reflect.Uint64
+	case reflect.Float32, reflect.Float64:
+		return reflect.Float64
+	default:
+		return reflect.Invalid
+	}
+}
@@ -1,5 +1,10 @@
 package ameda
 
+import (
+	"fmt"
+	"sort"
+)
+
 // OneInterface try to return the first element, otherwise return zero value.
 func OneInterface(i []interface{}) interface{} {
 	if len(i) > 0 {
@@ -283,21 +288,41 @@ func InterfacesFind(i []interface{}, fn func(i []interface{}, k int, v interface
 }
 
 // InterfacesIncludes determines whether an slice includes a certain value among its entries.
+// The elements are compared by InterfaceStrictEqual.
 // @fromIndex
 //
 //	The index to start the search at. Defaults to 0.
-func InterfacesIncludes(i []interface{}, valueToFind int64, fromIndex ...int) bool {
+func InterfacesIncludes(i []interface{}, valueToFind interface{}, fromIndex ...int) bool {
 	return InterfacesIndexOf(i, valueToFind, fromIndex...) > -1
 }
 
+// InterfacesIncludesFunc determines whether an slice includes a certain value among its entries,
+// comparing the elements with the provided equal function.
+// @fromIndex
+//
+//	The index to start the search at. Defaults to 0.
+func InterfacesIncludesFunc(i []interface{}, valueToFind interface{}, equal InterfaceEqualFunc, fromIndex ...int) bool {
+	return InterfacesIndexOfFunc(i, valueToFind, equal, fromIndex...) > -1
+}
+
 // InterfacesIndexOf returns the first index at which a given element can be found in the slice, or -1 if it is not present.
+// The elements are compared by InterfaceStrictEqual.
 // @fromIndex
 //
 //	The index to start the search at. Defaults to 0.
-func InterfacesIndexOf(i []interface{}, searchElement int64, fromIndex ...int) int {
+func InterfacesIndexOf(i []interface{}, searchElement interface{}, fromIndex ...int) int {
+	return InterfacesIndexOfFunc(i, searchElement, InterfaceStrictEqual, fromIndex...)
+}
+
+// InterfacesIndexOfFunc returns the first index at which a given element can be found in the slice,
+// or -1 if it is not present, comparing the elements with the provided equal function.
+// @fromIndex
+//
+//	The index to start the search at. Defaults to 0.
+func InterfacesIndexOfFunc(i []interface{}, searchElement interface{}, equal InterfaceEqualFunc, fromIndex ...int) int {
 	idx := getFromIndex(len(i), fromIndex...)
 	for k, v := range i[idx:] {
-		if searchElement == v {
+		if equal(searchElement, v) {
 			return k + idx
 		}
 	}
@@ -305,13 +330,23 @@ func InterfacesIndexOf(i []interface{}, searchElement int64, fromIndex ...int) i
 }
 
 // InterfacesLastIndexOf returns the last index at which a given element can be found in the slice, or -1 if it is not present.
+// The elements are compared by InterfaceStrictEqual.
+// @fromIndex
+//
+//	The index to start the search at. Defaults to 0.
+func InterfacesLastIndexOf(i []interface{}, searchElement interface{}, fromIndex ...int) int {
+	return InterfacesLastIndexOfFunc(i, searchElement, InterfaceStrictEqual, fromIndex...)
+}
+
+// InterfacesLastIndexOfFunc returns the last index at which a given element can be found in the slice,
+// or -1 if it is not present, comparing the elements with the provided equal function.
 // @fromIndex
 //
 //	The index to start the search at. Defaults to 0.
-func InterfacesLastIndexOf(i []interface{}, searchElement int64, fromIndex ...int) int {
+func InterfacesLastIndexOfFunc(i []interface{}, searchElement interface{}, equal InterfaceEqualFunc, fromIndex ...int) int {
 	idx := getFromIndex(len(i), fromIndex...)
 	for k := len(i) - 1; k >= idx; k-- {
-		if searchElement == i[k] {
+		if equal(searchElement, i[k]) {
 			return k
 		}
 	}
@@ -320,8 +355,8 @@ func InterfacesLastIndexOf(i []interface{}, searchElement int64, fromIndex ...in
 
 // InterfacesMap creates a new slice populated with the results of calling a provided function
 // on every element in the calling slice.
-func InterfacesMap(i []interface{}, fn func(i []interface{}, k int, v interface{}) int64) []int64 {
-	ret := make([]int64, len(i))
+func InterfacesMap(i []interface{}, fn func(i []interface{}, k int, v interface{}) interface{}) []interface{} {
+	ret := make([]interface{}, len(i))
 	for k, v := range i {
 		ret[k] = fn(i, k, v)
 	}
@@ -481,26 +516,20 @@ func InterfacesSplice(i *[]interface{}, start, deleteCount int, items ...interfa
 	if deleteCount < 0 {
 		deleteCount = 0
 	}
-	start, end, _ := fixRange(len(a), start, start+1+deleteCount)
-	deleteCount = end - start - 1
-	for k := 0; k < len(items); k++ {
-		if deleteCount > 0 {
-			// replace
-			a[start] = items[k]
-			deleteCount--
-			start++
-		} else {
-			// insert
-			lastSlice := InterfacesCopy(a[start:])
-			items = items[k:]
-			a = append(a[:start], items...)
-			a = append(a[:start+len(items)], lastSlice...)
-			*i = a[:len(a):len(a)]
-			return
-		}
+	start = fixIndex(len(a), start, true)
+	if rest := len(a) - start; deleteCount > rest {
+		deleteCount = rest
 	}
-	if deleteCount > 0 {
-		a = append(a[:start], a[start+1+deleteCount:]...)
+	// replace
+	n := copy(a[start:start+deleteCount], items)
+	if n == len(items) {
+		// delete the rest
+		a = append(a[:start+n], a[start+deleteCount:]...)
+	} else {
+		// insert the rest
+		lastSlice := InterfacesCopy(a[start+deleteCount:])
+		a = append(a[:start+deleteCount], items[n:]...)
+		a = append(a, lastSlice...)
 	}
 	*i = a[:len(a):len(a)]
 }
@@ -664,3 +693,410 @@ func interfacesDistinct(src []interface{}, dst *[]interface{}) map[interface{}]i
 	}
 	return m
 }
+
+// InterfacesEdit is an edit run of the edit script produced by InterfacesDiff.
+type InterfacesEdit struct {
+	Kind   DiffKind
+	Values []interface{}
+}
+
+// InterfacesDiff computes the shortest edit script that turns slice a into slice b, see also DiffFunc.
+// This method does not change the existing slices, but instead returns the runs of edits.
+// NOTE:
+//
+//	The elements are compared by InterfaceDeepEqual, so the incomparable ones (e.g. slices) also work.
+func InterfacesDiff(a, b []interface{}) []InterfacesEdit {
+	return InterfacesDiffFunc(a, b, InterfaceDeepEqual)
+}
+
+// InterfacesDiffFunc is like InterfacesDiff, but compares the elements by the equal function,
+// called as equal(a[i], b[j]).
+// NOTE:
+//
+//	The equal runs carry the values of b, so InterfacesApplyPatchFunc turns a into b exactly,
+//	even if the equal function treats different values as equal, e.g. InterfaceLooseEqual.
+func InterfacesDiffFunc(a, b []interface{}, equal InterfaceEqualFunc) []InterfacesEdit {
+	spans := DiffFunc(len(a), len(b), func(i, j int) bool { return equal(a[i], b[j]) })
+	r := make([]InterfacesEdit, len(spans))
+	for k, span := range spans {
+		r[k].Kind = span.Kind
+		if span.Kind != DiffDelete {
+			r[k].Values = InterfacesCopy(b[span.B : span.B+span.Len])
+		} else {
+			r[k].Values = InterfacesCopy(a[span.A : span.A+span.Len])
+		}
+	}
+	return r
+}
+
+// InterfacesApplyPatch changes the contents of an slice in place by applying the edit script produced by InterfacesDiff.
+// NOTE:
+//
+//	If the slice does not match the equal and delete runs of the patch, it returns an error
+//	and the slice will not be modified.
+//	The elements are compared by InterfaceDeepEqual, the same as InterfacesDiff.
+func InterfacesApplyPatch(p *[]interface{}, patch []InterfacesEdit) error {
+	return InterfacesApplyPatchFunc(p, patch, InterfaceDeepEqual)
+}
+
+// InterfacesApplyPatchFunc is like InterfacesApplyPatch, but compares the elements by the equal function,
+// which should be the same as the one passed to InterfacesDiffFunc.
+// The equal runs are checked as equal(element, run value), and the run values are kept in the result.
+func InterfacesApplyPatchFunc(p *[]interface{}, patch []InterfacesEdit, equal InterfaceEqualFunc) error {
+	a := *p
+	r := make([]interface{}, 0, len(a))
+	pos := 0
+	for _, e := range patch {
+		switch e.Kind {
+		case DiffInsert:
+			r = append(r, e.Values...)
+		case DiffEqual, DiffDelete:
+			if pos+len(e.Values) > len(a) {
+				return fmt.Errorf("patch mismatch: %s run exceeds the slice length %d", e.Kind, len(a))
+			}
+			for k, v := range e.Values {
+				if !equal(a[pos+k], v) {
+					return fmt.Errorf("patch mismatch: %s run at index %d", e.Kind, pos+k)
+				}
+			}
+			if e.Kind == DiffEqual {
+				r = append(r, e.Values...)
+			}
+			pos += len(e.Values)
+		default:
+			return fmt.Errorf("patch mismatch: unknown edit kind %d", e.Kind)
+		}
+	}
+	if pos != len(a) {
+		return fmt.Errorf("patch mismatch: the slice has %d unpatched elements", len(a)-pos)
+	}
+	n := len(r)
+	*p = r[:n:n]
+	return nil
+}
+
+// InterfacesShuffle randomizes the order of elements in place.
+// @r
+//
+//	The source of random numbers, nil means the global source of package math/rand.
+func InterfacesShuffle(i []interface{}, r Randomizer) {
+	shuffleIndex(len(i), len(i), r, func(x, y int) { i[x], i[y] = i[y], i[x] })
+}
+
+// InterfacesSample returns k elements randomly selected from the slice without replacement.
+// If k is greater than the length of the slice, all the elements are returned in random order.
+// This method does not change the existing slice, but instead returns a new slice.
+// @r
+//
+//	The source of random numbers, nil means the global source of package math/rand.
+func InterfacesSample(i []interface{}, k int, r Randomizer) []interface{} {
+	if k <= 0 {
+		return []interface{}{}
+	}
+	a := InterfacesCopy(i)
+	shuffleIndex(len(a), k, r, func(x, y int) { a[x], a[y] = a[y], a[x] })
+	if k > len(a) {
+		k = len(a)
+	}
+	return a[:k:k]
+}
+
+// InterfacesSampleWithReplacement returns k elements randomly selected from the slice with replacement.
+// This method does not change the existing slice, but instead returns a new slice.
+// @r
+//
+//	The source of random numbers, nil means the global source of package math/rand.
+func InterfacesSampleWithReplacement(i []interface{}, k int, r Randomizer) []interface{} {
+	if k <= 0 || len(i) == 0 {
+		return []interface{}{}
+	}
+	r = getRandomizer(r)
+	ret := make([]interface{}, k)
+	for n := range ret {
+		ret[n] = i[r.Intn(len(i))]
+	}
+	return ret
+}
+
+// InterfacesWeightedChoice returns the key-value of an element randomly selected
+// with the probability proportional to its weight.
+// @weights
+//
+//	The weights of the elements with the same indexes, must be finite and non-negative.
+//
+// @r
+//
+//	The source of random numbers, nil means the global source of package math/rand.
+//
+// NOTE:
+//
+//	If the slice is empty, or the weights are invalid, returns an error and k = -1
+func InterfacesWeightedChoice(i []interface{}, weights []float64, r Randomizer) (k int, v interface{}, err error) {
+	k, err = weightedIndex(len(i), weights, r)
+	if err != nil {
+		return k, v, err
+	}
+	return k, i[k], nil
+}
+
+// InterfacesReservoirSample returns k elements randomly selected from a stream of unknown length,
+// in a single pass and with O(k) memory.
+// @next
+//
+//	Returns the next element of the stream, or ok = false at the end of the stream.
+//
+// @r
+//
+//	The source of random numbers, nil means the global source of package math/rand.
+func InterfacesReservoirSample(next func() (v interface{}, ok bool), k int, r Randomizer) []interface{} {
+	if k <= 0 {
+		return []interface{}{}
+	}
+	r = getRandomizer(r)
+	ret := make([]interface{}, 0, k)
+	for seen := 0; ; seen++ {
+		v, ok := next()
+		if !ok {
+			return ret
+		}
+		switch slot := reservoirIndex(seen, k, r); {
+		case slot == len(ret):
+			ret = append(ret, v)
+		case slot >= 0:
+			ret[slot] = v
+		}
+	}
+}
+
+// InterfacesAt returns the element at the given index.
+// @index
+//
+//	Zero-based index of the element. If negative, index will be counted from the end.
+//
+// NOTE:
+//
+//	If the index is out of range, ok = false
+func InterfacesAt(i []interface{}, index int) (v interface{}, ok bool) {
+	index, ok = atIndex(len(i), index)
+	if !ok {
+		return v, false
+	}
+	return i[index], true
+}
+
+// InterfacesWith returns a copy of the slice with the element at the given index replaced with the value.
+// @index
+//
+//	Zero-based index of the element. If negative, index will be counted from the end.
+//
+// NOTE:
+//
+//	If the index is out of range, ok = false
+func InterfacesWith(i []interface{}, index int, value interface{}) (ret []interface{}, ok bool) {
+	ret = InterfacesCopy(i)
+	index, ok = atIndex(len(i), index)
+	if ok {
+		ret[index] = value
+	}
+	return ret, ok
+}
+
+// InterfacesFindIndex returns the index of the first element in the provided slice that satisfies the provided testing function.
+// NOTE:
+//
+//	If not found, returns -1
+func InterfacesFindIndex(i []interface{}, fn func(i []interface{}, k int, v interface{}) bool) int {
+	k, _ := InterfacesFind(i, fn)
+	return k
+}
+
+// InterfacesFindLast returns the key-value of the last element in the provided slice that satisfies the provided testing function.
+// NOTE:
+//
+//	If not found, k = -1
+func InterfacesFindLast(i []interface{}, fn func(i []interface{}, k int, v interface{}) bool) (k int, v interface{}) {
+	for k := len(i) - 1; k >= 0; k-- {
+		if fn(i, k, i[k]) {
+			return k, i[k]
+		}
+	}
+	return -1, v
+}
+
+// InterfacesFindLastIndex returns the index of the last element in the provided slice that satisfies the provided testing function.
+// NOTE:
+//
+//	If not found, returns -1
+func InterfacesFindLastIndex(i []interface{}, fn func(i []interface{}, k int, v interface{}) bool) int {
+	k, _ := InterfacesFindLast(i, fn)
+	return k
+}
+
+// InterfacesFlat creates a new slice with all sub-slice elements concatenated into it.
+func InterfacesFlat(i [][]interface{}) []interface{} {
+	return InterfacesConcat(i...)
+}
+
+// InterfacesFlatMap creates a new slice populated with the results of calling a provided function
+// on every element in the calling slice, and then flattening the results.
+func InterfacesFlatMap(i []interface{}, fn func(i []interface{}, k int, v interface{}) []interface{}) []interface{} {
+	ret := make([]interface{}, 0, len(i))
+	for k, v := range i {
+		ret = append(ret, fn(i, k, v)...)
+	}
+	return ret
+}
+
+// InterfacesToSorted returns a copy of the slice sorted stably.
+// @less
+//
+//	Reports whether the element a must sort before the element b. It must not be nil.
+func InterfacesToSorted(i []interface{}, less func(a, b interface{}) bool) []interface{} {
+	ret := InterfacesCopy(i)
+	sort.SliceStable(ret, func(x, y int) bool { return less(ret[x], ret[y]) })
+	return ret
+}
+
+// InterfacesToReversed returns a copy of the slice in reversed order.
+func InterfacesToReversed(i []interface{}) []interface{} {
+	ret := InterfacesCopy(i)
+	InterfacesReverse(ret)
+	return ret
+}
+
+// InterfacesToSpliced returns a copy of the slice with some elements removed and/or replaced at a given index,
+// see also InterfacesSplice.
+func InterfacesToSpliced(i []interface{}, start, deleteCount int, items ...interface{}) []interface{} {
+	ret := InterfacesCopy(i)
+	InterfacesSplice(&ret, start, deleteCount, items...)
+	return ret
+}
+
+// InterfacesKeys returns an iterator of the indexes of the slice.
+// NOTE:
+//
+//	The iterator returns ok = false when it is exhausted.
+func InterfacesKeys(i []interface{}) (next func() (k int, ok bool)) {
+	k := -1
+	return func() (int, bool) {
+		if k+1 >= len(i) {
+			return -1, false
+		}
+		k++
+		return k, true
+	}
+}
+
+// InterfacesValues returns an iterator of the elements of the slice.
+// NOTE:
+//
+//	The iterator returns ok = false when it is exhausted.
+func InterfacesValues(i []interface{}) (next func() (v interface{}, ok bool)) {
+	k := -1
+	return func() (v interface{}, ok bool) {
+		if k+1 >= len(i) {
+			return v, false
+		}
+		k++
+		return i[k], true
+	}
+}
+
+// InterfacesEntries returns an iterator of the key-value pairs of the slice.
+// NOTE:
+//
+//	The iterator returns ok = false when it is exhausted.
+func InterfacesEntries(i []interface{}) (next func() (k int, v interface{}, ok bool)) {
+	k := -1
+	return func() (int, interface{}, bool) {
+		if k+1 >= len(i) {
+			var v interface{}
+			return -1, v, false
+		}
+		k++
+		return k, i[k], true
+	}
+}
+
+// InterfacesGroup groups the elements of the slice according to the string keys returned by the provided function.
+func InterfacesGroup(i []interface{}, fn func(i []interface{}, k int, v interface{}) string) map[string][]interface{} {
+	ret := make(map[string][]interface{})
+	for k, v := range i {
+		key := fn(i, k, v)
+		ret[key] = append(ret[key], v)
+	}
+	return ret
+}
+
+// InterfacesGroupToMap groups the elements of the slice according to the keys returned by the provided function.
+// NOTE:
+//
+//	The keys must be comparable.
+func InterfacesGroupToMap(i []interface{}, fn func(i []interface{}, k int, v interface{}) interface{}) map[interface{}][]interface{} {
+	ret := make(map[interface{}][]interface{})
+	for k, v := range i {
+		key := fn(i, k, v)
+		ret[key] = append(ret[key], v)
+	}
+	return ret
+}
+
+// InterfacesEqual reports whether a and b have the same length and the same elements in the same order.
+// NOTE:
+//
+//	The elements are compared by InterfaceStrictEqual.
+func InterfacesEqual(a, b []interface{}) bool {
+	return InterfacesEqualFunc(a, b, InterfaceStrictEqual)
+}
+
+// InterfacesEqualFunc reports whether a and b have the same length,
+// and each pair of elements in the same position is equal according to the equal function.
+func InterfacesEqualFunc(a, b []interface{}, equal InterfaceEqualFunc) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for k, v := range a {
+		if !equal(v, b[k]) {
+			return false
+		}
+	}
+	return true
+}
+
+// InterfacesCompare compares a and b lexicographically by the cmp function,
+// and returns -1 if a < b, 0 if a == b, or +1 if a > b.
+// NOTE:
+//
+//	A slice that is a prefix of another slice is less than it.
+//
+// @cmp
+//
+//	Returns a negative number if a < b, 0 if a == b, or a positive number if a > b.
+func InterfacesCompare(a, b []interface{}, cmp func(a, b interface{}) int) int {
+	for k := 0; k < len(a) && k < len(b); k++ {
+		if c := cmp(a[k], b[k]); c != 0 {
+			return compareInt(c, 0)
+		}
+	}
+	return compareInt(len(a), len(b))
+}
+
+// InterfacesHasPrefix reports whether the slice i begins with prefix, comparing the elements by InterfaceStrictEqual.
+func InterfacesHasPrefix(i, prefix []interface{}) bool {
+	return InterfacesHasPrefixFunc(i, prefix, InterfaceStrictEqual)
+}
+
+// InterfacesHasPrefixFunc reports whether the slice i begins with prefix, comparing the elements by the equal function.
+func InterfacesHasPrefixFunc(i, prefix []interface{}, equal InterfaceEqualFunc) bool {
+	return len(i) >= len(prefix) && InterfacesEqualFunc(i[:len(prefix)], prefix, equal)
+}
+
+// InterfacesHasSuffix reports whether the slice i ends with suffix, comparing the elements by InterfaceStrictEqual.
+func InterfacesHasSuffix(i, suffix []interface{}) bool {
+	return InterfacesHasSuffixFunc(i, suffix, InterfaceStrictEqual)
+}
+
+// InterfacesHasSuffixFunc reports whether the slice i ends with suffix, comparing the elements by the equal function.
+func InterfacesHasSuffixFunc(i, suffix []interface{}, equal InterfaceEqualFunc) bool {
+	return len(i) >= len(suffix) && InterfacesEqualFunc(i[len(i)-len(suffix):], suffix, equal)
+}
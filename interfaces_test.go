@@ -0,0 +1,70 @@
+package ameda
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestInterfacesIndexOf(t *testing.T) {
+	slice := []interface{}{1, "a", int64(2), 1.0, []int{3}}
+	assert.Equal(t, 0, InterfacesIndexOf(slice, 1))
+	assert.Equal(t, 1, InterfacesIndexOf(slice, "a"))
+	assert.Equal(t, 2, InterfacesIndexOf(slice, int64(2)))
+	assert.Equal(t, -1, InterfacesIndexOf(slice, 2))
+	assert.Equal(t, -1, InterfacesIndexOf(slice, []int{3}))
+	assert.Equal(t, -1, InterfacesIndexOf(slice, 1, 1))
+	assert.Equal(t, 3, InterfacesLastIndexOf(slice, 1.0))
+	assert.True(t, InterfacesIncludes(slice, "a"))
+	assert.False(t, InterfacesIncludes(slice, "b"))
+
+	assert.Equal(t, 4, InterfacesIndexOfFunc(slice, []int{3}, InterfaceDeepEqual))
+	assert.Equal(t, 2, InterfacesIndexOfFunc(slice, 2, InterfaceLooseEqual))
+	assert.Equal(t, 3, InterfacesLastIndexOfFunc(slice, uint8(1), InterfaceLooseEqual))
+	assert.Equal(t, -1, InterfacesLastIndexOfFunc(slice, uint8(1), InterfaceLooseEqual, 4))
+	assert.True(t, InterfacesIncludesFunc(slice, "A", func(a, b interface{}) bool {
+		s, ok := b.(string)
+		return ok && s == "a"
+	}))
+}
+
+func TestInterfaceLooseEqual(t *testing.T) {
+	cases := []struct {
+		a, b     interface{}
+		expected bool
+	}{
+		{1, 1.0, true},
+		{int8(-1), int64(-1), true},
+		{int8(-1), uint64(1<<64 - 1), false},
+		{uint64(1<<63 + 1), int64(-1 << 63), false},
+		{uint64(1<<64 - 1), uint64(1<<64 - 1), true},
+		{float32(0.5), 0.5, true},
+		{"1", 1, false},
+		{nil, 0, false},
+		{nil, nil, true},
+		{[]int{1}, []int{1}, false},
+		// integers and floats are compared exactly above 2^53
+		{int64(1<<53 + 1), float64(1 << 53), false},
+		{float64(1 << 53), int64(1<<53 + 1), false},
+		{int64(1 << 53), float64(1 << 53), true},
+		{uint64(1<<64 - 1), float64(1 << 64), false},
+		{uint64(1 << 63), float64(1 << 63), true},
+		{int64(-1 << 63), float64(-1 << 63), true},
+		{int64(1<<63 - 1), float64(1 << 63), false},
+		{1, 1.5, false},
+		{0, math.NaN(), false},
+		{int64(1<<63 - 1), math.Inf(1), false},
+	}
+	for _, c := range cases {
+		assert.Equal(t, c.expected, InterfaceLooseEqual(c.a, c.b), c)
+	}
+}
+
+func TestInterfacesMap(t *testing.T) {
+	slice := []interface{}{1, "a"}
+	ret := InterfacesMap(slice, func(i []interface{}, k int, v interface{}) interface{} {
+		return InterfaceToString(v) + "!"
+	})
+	assert.Equal(t, []interface{}{"1!", "a!"}, ret)
+}
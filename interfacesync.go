@@ -0,0 +1,266 @@
+package ameda
+
+import (
+	"sync"
+	"sync/atomic"
+)
+
+// SyncInterfaces is an interface{} slice that is safe for concurrent use.
+// NOTE:
+//
+//	The zero value is an empty slice ready to use.
+//	Point reads such as Len and At use the read lock without copying the elements.
+//	Callbacks are run on a snapshot, so they can call the methods of the same SyncInterfaces.
+type SyncInterfaces struct {
+	mu   sync.RWMutex
+	data []interface{}
+	snap atomic.Value // []interface{}: read-only copy of data, nil after modification
+}
+
+// NewSyncInterfaces creates a SyncInterfaces with a copy of the elements.
+func NewSyncInterfaces(elements ...interface{}) *SyncInterfaces {
+	return &SyncInterfaces{data: InterfacesCopy(elements)}
+}
+
+// Snapshot returns a read-only copy of the current elements.
+// NOTE:
+//
+//	The result is shared with other callers until the next modification, so it must not be changed.
+//	While there is no modification, it is an atomic read without locking.
+func (s *SyncInterfaces) Snapshot() []interface{} {
+	if snap, _ := s.snap.Load().([]interface{}); snap != nil {
+		return snap
+	}
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	snap := InterfacesCopy(s.data)
+	s.snap.Store(snap)
+	return snap
+}
+
+// Slice returns a copy of the current elements that can be changed.
+func (s *SyncInterfaces) Slice() []interface{} {
+	return InterfacesCopy(s.Snapshot())
+}
+
+// Len returns the number of elements.
+func (s *SyncInterfaces) Len() int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return len(s.data)
+}
+
+// At returns the element at the index, which can be negative and counted from the end.
+func (s *SyncInterfaces) At(index int) (v interface{}, ok bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return InterfacesAt(s.data, index)
+}
+
+// Includes determines whether the slice includes a certain element.
+func (s *SyncInterfaces) Includes(valueToFind interface{}, fromIndex ...int) bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return InterfacesIncludes(s.data, valueToFind, fromIndex...)
+}
+
+// IndexOf returns the first index at which a given element can be found, or -1 if it is not present.
+func (s *SyncInterfaces) IndexOf(searchElement interface{}, fromIndex ...int) int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return InterfacesIndexOf(s.data, searchElement, fromIndex...)
+}
+
+// LastIndexOf returns the last index at which a given element can be found, or -1 if it is not present.
+func (s *SyncInterfaces) LastIndexOf(searchElement interface{}, fromIndex ...int) int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return InterfacesLastIndexOf(s.data, searchElement, fromIndex...)
+}
+
+// Range calls fn for each element of a snapshot in order, until fn returns false.
+func (s *SyncInterfaces) Range(fn func(k int, v interface{}) bool) {
+	for k, v := range s.Snapshot() {
+		if !fn(k, v) {
+			return
+		}
+	}
+}
+
+// Every tests whether all elements of a snapshot pass the test implemented by fn.
+func (s *SyncInterfaces) Every(fn func(i []interface{}, k int, v interface{}) bool) bool {
+	return InterfacesEvery(s.Snapshot(), fn)
+}
+
+// Some tests whether at least one element of a snapshot passes the test implemented by fn.
+func (s *SyncInterfaces) Some(fn func(i []interface{}, k int, v interface{}) bool) bool {
+	return InterfacesSome(s.Snapshot(), fn)
+}
+
+// Find returns the key-value of the first element of a snapshot that satisfies fn.
+// If no element satisfies fn, -1 is returned.
+func (s *SyncInterfaces) Find(fn func(i []interface{}, k int, v interface{}) bool) (k int, v interface{}) {
+	return InterfacesFind(s.Snapshot(), fn)
+}
+
+// Filter creates a new slice with the elements of a snapshot that pass the test implemented by fn.
+func (s *SyncInterfaces) Filter(fn func(i []interface{}, k int, v interface{}) bool) []interface{} {
+	return InterfacesFilter(s.Snapshot(), fn)
+}
+
+// Map creates a new slice with the results of calling fn on every element of a snapshot.
+func (s *SyncInterfaces) Map(fn func(i []interface{}, k int, v interface{}) interface{}) []interface{} {
+	return InterfacesMap(s.Snapshot(), fn)
+}
+
+// Reduce executes fn on each element of a snapshot, resulting in a single output value.
+func (s *SyncInterfaces) Reduce(fn func(i []interface{}, k int, v, accumulator interface{}) interface{}, initialValue ...interface{}) interface{} {
+	return InterfacesReduce(s.Snapshot(), fn, initialValue...)
+}
+
+// Set changes the element at the index, which can be negative and counted from the end,
+// and reports whether the index is in range.
+func (s *SyncInterfaces) Set(index int, value interface{}) bool {
+	s.mu.Lock()
+	defer s.unlock()
+	k, ok := atIndex(len(s.data), index)
+	if ok {
+		s.data[k] = value
+	}
+	return ok
+}
+
+// Push adds one or more elements to the end, and returns the new length.
+func (s *SyncInterfaces) Push(element ...interface{}) int {
+	s.mu.Lock()
+	defer s.unlock()
+	return InterfacesPush(&s.data, element...)
+}
+
+// PushDistinct adds one or more new elements that do not exist at the end, and returns the new length.
+func (s *SyncInterfaces) PushDistinct(element ...interface{}) int {
+	s.mu.Lock()
+	defer s.unlock()
+	s.data = InterfacesPushDistinct(s.data, element...)
+	return len(s.data)
+}
+
+// Pop removes the last element and returns that element.
+func (s *SyncInterfaces) Pop() (interface{}, bool) {
+	s.mu.Lock()
+	defer s.unlock()
+	return InterfacesPop(&s.data)
+}
+
+// Shift removes the first element and returns that element.
+func (s *SyncInterfaces) Shift() (interface{}, bool) {
+	s.mu.Lock()
+	defer s.unlock()
+	return InterfacesShift(&s.data)
+}
+
+// Unshift adds one or more elements to the beginning, and returns the new length.
+func (s *SyncInterfaces) Unshift(element ...interface{}) int {
+	s.mu.Lock()
+	defer s.unlock()
+	return InterfacesUnshift(&s.data, element...)
+}
+
+// UnshiftDistinct adds one or more new elements that do not exist to the beginning, and returns the new length.
+func (s *SyncInterfaces) UnshiftDistinct(element ...interface{}) int {
+	s.mu.Lock()
+	defer s.unlock()
+	return InterfacesUnshiftDistinct(&s.data, element...)
+}
+
+// Splice removes deleteCount elements from start and adds the items in their place.
+func (s *SyncInterfaces) Splice(start, deleteCount int, items ...interface{}) {
+	s.mu.Lock()
+	defer s.unlock()
+	InterfacesSplice(&s.data, start, deleteCount, items...)
+}
+
+// RemoveFirst removes the first matched elements, and returns the new length.
+func (s *SyncInterfaces) RemoveFirst(elements ...interface{}) int {
+	s.mu.Lock()
+	defer s.unlock()
+	return InterfacesRemoveFirst(&s.data, elements...)
+}
+
+// RemoveEvery removes all the elements, and returns the new length.
+func (s *SyncInterfaces) RemoveEvery(elements ...interface{}) int {
+	s.mu.Lock()
+	defer s.unlock()
+	return InterfacesRemoveEvery(&s.data, elements...)
+}
+
+// Distinct removes the duplicate elements, and returns the count of each element.
+func (s *SyncInterfaces) Distinct() (distinctCount map[interface{}]int) {
+	s.mu.Lock()
+	defer s.unlock()
+	return InterfacesDistinct(&s.data, true)
+}
+
+// Fill changes all elements from start to end to a static value.
+func (s *SyncInterfaces) Fill(value interface{}, start int, end ...int) {
+	s.mu.Lock()
+	defer s.unlock()
+	fixedStart, fixedEnd, ok := fixRange(len(s.data), start, end...)
+	if !ok {
+		return
+	}
+	for k := fixedStart; k < fixedEnd; k++ {
+		s.data[k] = value
+	}
+}
+
+// CopyWithin copies part of the slice to another location in the same slice.
+func (s *SyncInterfaces) CopyWithin(target, start int, end ...int) {
+	s.mu.Lock()
+	defer s.unlock()
+	InterfacesCopyWithin(s.data, target, start, end...)
+}
+
+// Reverse reverses the elements in place.
+func (s *SyncInterfaces) Reverse() {
+	s.mu.Lock()
+	defer s.unlock()
+	InterfacesReverse(s.data)
+}
+
+// Shuffle randomizes the order of the elements in place.
+func (s *SyncInterfaces) Shuffle(r Randomizer) {
+	s.mu.Lock()
+	defer s.unlock()
+	InterfacesShuffle(s.data, r)
+}
+
+// ApplyPatch applies the edit script to the elements.
+func (s *SyncInterfaces) ApplyPatch(patch []InterfacesEdit) error {
+	s.mu.Lock()
+	defer s.unlock()
+	return InterfacesApplyPatch(&s.data, patch)
+}
+
+// Update calls fn with the pointer of the elements under the write lock.
+// NOTE:
+//
+//	fn must not call the methods of the same SyncInterfaces, and must not retain the slice.
+func (s *SyncInterfaces) Update(fn func(p *[]interface{})) {
+	s.mu.Lock()
+	defer s.unlock()
+	fn(&s.data)
+}
+
+// Clear removes all elements.
+func (s *SyncInterfaces) Clear() {
+	s.mu.Lock()
+	defer s.unlock()
+	s.data = nil
+}
+
+// unlock invalidates the snapshot and releases the write lock.
+func (s *SyncInterfaces) unlock() {
+	s.snap.Store([]interface{}(nil))
+	s.mu.Unlock()
+}
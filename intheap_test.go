@@ -0,0 +1,74 @@
+package ameda
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIntHeap(t *testing.T) {
+	src := []int{5, 3, 8, 1, 9, 2}
+	h := NewIntMinHeap(src...)
+	assert.Equal(t, []int{5, 3, 8, 1, 9, 2}, src)
+	assert.Equal(t, 6, h.Len())
+	v, ok := h.Peek()
+	assert.True(t, ok)
+	assert.Equal(t, 1, v)
+	assert.Equal(t, 8, h.Push(0, 7))
+	assert.Equal(t, []int{0, 1, 2, 3, 5, 7, 8, 9}, h.Sorted())
+	for _, want := range []int{0, 1, 2, 3, 5, 7, 8, 9} {
+		v, ok = h.Pop()
+		assert.True(t, ok)
+		assert.Equal(t, want, v)
+	}
+	_, ok = h.Pop()
+	assert.False(t, ok)
+
+	h = NewIntMaxHeap(src...)
+	assert.Equal(t, []int{9, 8, 5, 3, 2, 1}, h.Sorted())
+
+	h = NewIntHeap(func(a, b int) bool { return a%3 < b%3 || a%3 == b%3 && a < b }, src...)
+	assert.Equal(t, []int{3, 9, 1, 2, 5, 8}, h.Sorted())
+
+	h = NewIntTopK(3, nil)
+	h.Push(src...)
+	h.Push(4, 6)
+	assert.Equal(t, 3, h.Len())
+	assert.Equal(t, []int{6, 8, 9}, h.Sorted())
+	h = NewIntTopK(2, func(a, b int) bool { return b < a })
+	h.Push(src...)
+	assert.Equal(t, []int{2, 1}, h.Sorted())
+}
+
+func TestIntIndexedHeap(t *testing.T) {
+	h := NewIntIndexedHeap(nil)
+	_, _, ok := h.Peek()
+	assert.False(t, ok)
+	for key, prio := range []int{50, 40, 30, 20, 10} {
+		h.Push(key, prio)
+	}
+	assert.Equal(t, 5, h.Len())
+	key, prio, ok := h.Peek()
+	assert.True(t, ok)
+	assert.Equal(t, 4, key)
+	assert.Equal(t, 10, prio)
+
+	assert.True(t, h.Update(0, 5))
+	assert.False(t, h.Update(9, 1))
+	h.Push(1, 60)
+	p, ok := h.Priority(1)
+	assert.True(t, ok)
+	assert.Equal(t, 60, p)
+	assert.True(t, h.Remove(2))
+	assert.False(t, h.Remove(2))
+	assert.False(t, h.Has(2))
+
+	var keys, prios []int
+	for h.Len() > 0 {
+		key, prio, _ = h.Pop()
+		keys = append(keys, key)
+		prios = append(prios, prio)
+	}
+	assert.Equal(t, []int{0, 4, 3, 1}, keys)
+	assert.Equal(t, []int{5, 10, 20, 60}, prios)
+}
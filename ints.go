@@ -1,5 +1,10 @@
 package ameda
 
+import (
+	"fmt"
+	"sort"
+)
+
 // OneInt try to return the first element, otherwise return zero value.
 func OneInt(i []int) int {
 	if len(i) > 0 {
@@ -455,26 +460,20 @@ func IntsSplice(i *[]int, start, deleteCount int, items ...int) {
 	if deleteCount < 0 {
 		deleteCount = 0
 	}
-	start, end, _ := fixRange(len(a), start, start+1+deleteCount)
-	deleteCount = end - start - 1
-	for k := 0; k < len(items); k++ {
-		if deleteCount > 0 {
-			// replace
-			a[start] = items[k]
-			deleteCount--
-			start++
-		} else {
-			// insert
-			lastSlice := IntsCopy(a[start:])
-			items = items[k:]
-			a = append(a[:start], items...)
-			a = append(a[:start+len(items)], lastSlice...)
-			*i = a[:len(a):len(a)]
-			return
-		}
+	start = fixIndex(len(a), start, true)
+	if rest := len(a) - start; deleteCount > rest {
+		deleteCount = rest
 	}
-	if deleteCount > 0 {
-		a = append(a[:start], a[start+1+deleteCount:]...)
+	// replace
+	n := copy(a[start:start+deleteCount], items)
+	if n == len(items) {
+		// delete the rest
+		a = append(a[:start+n], a[start+deleteCount:]...)
+	} else {
+		// insert the rest
+		lastSlice := IntsCopy(a[start+deleteCount:])
+		a = append(a[:start+deleteCount], items[n:]...)
+		a = append(a, lastSlice...)
 	}
 	*i = a[:len(a):len(a)]
 }
@@ -703,3 +702,548 @@ func IntSetDifference(set1, set2 []int, others ...[]int) []int {
 	}
 	return r
 }
+
+// IntsIsSorted reports whether the slice is sorted in ascending order.
+func IntsIsSorted(i []int) bool {
+	for k := 1; k < len(i); k++ {
+		if i[k] < i[k-1] {
+			return false
+		}
+	}
+	return true
+}
+
+// IntSortedSetUnion calculates between multiple sorted collections: set1 ∪ set2 ∪ others...
+// NOTE:
+//
+//	Every set must be sorted in ascending order (see IntsIsSorted), and the result is sorted too.
+//	It merges the sets in O(n+m) without building any map.
+//	This method does not change the existing slices, but instead returns a new slice.
+func IntSortedSetUnion(set1, set2 []int, others ...[]int) []int {
+	r := intSortedSetUnion(set1, set2)
+	for _, set := range others {
+		r = intSortedSetUnion(r, set)
+	}
+	return r
+}
+
+// IntSortedSetIntersect calculates between multiple sorted collections: set1 ∩ set2 ∩ others...
+// NOTE:
+//
+//	Every set must be sorted in ascending order (see IntsIsSorted), and the result is sorted too.
+//	It merges the sets in O(n+m) without building any map.
+//	This method does not change the existing slices, but instead returns a new slice.
+func IntSortedSetIntersect(set1, set2 []int, others ...[]int) []int {
+	r := intSortedSetIntersect(set1, set2)
+	for _, set := range others {
+		r = intSortedSetIntersect(r, set)
+	}
+	return r
+}
+
+// IntSortedSetDifference calculates between multiple sorted collections: set1 - set2 - others...
+// NOTE:
+//
+//	Every set must be sorted in ascending order (see IntsIsSorted), and the result is sorted too.
+//	It merges the sets in O(n+m) without building any map.
+//	This method does not change the existing slices, but instead returns a new slice.
+func IntSortedSetDifference(set1, set2 []int, others ...[]int) []int {
+	r := intSortedSetDifference(set1, set2)
+	for _, set := range others {
+		r = intSortedSetDifference(r, set)
+	}
+	return r
+}
+
+// IntSortedSetSymmetricDifference calculates between multiple sorted collections: set1 ∆ set2 ∆ others...
+// NOTE:
+//
+//	Every set must be sorted in ascending order (see IntsIsSorted), and the result is sorted too.
+//	It merges the sets in O(n+m) without building any map.
+//	This method does not change the existing slices, but instead returns a new slice.
+func IntSortedSetSymmetricDifference(set1, set2 []int, others ...[]int) []int {
+	r := intSortedSetSymmetricDifference(set1, set2)
+	for _, set := range others {
+		r = intSortedSetSymmetricDifference(r, set)
+	}
+	return r
+}
+
+// IntSortedSetIsSubset reports whether every element of sub is also in set: sub ⊆ set.
+// NOTE:
+//
+//	Both sets must be sorted in ascending order (see IntsIsSorted).
+func IntSortedSetIsSubset(sub, set []int) bool {
+	j := 0
+	for k := 0; k < len(sub); {
+		if j >= len(set) || sub[k] < set[j] {
+			return false
+		}
+		if set[j] < sub[k] {
+			j++
+			continue
+		}
+		k++
+	}
+	return true
+}
+
+func intSortedSetUnion(a, b []int) []int {
+	r := make([]int, 0, len(a)+len(b))
+	i, j := 0, 0
+	for i < len(a) || j < len(b) {
+		var v int
+		switch {
+		case j >= len(b) || i < len(a) && a[i] < b[j]:
+			v = a[i]
+			i++
+		case i >= len(a) || b[j] < a[i]:
+			v = b[j]
+			j++
+		default:
+			v = a[i]
+			i++
+			j++
+		}
+		if n := len(r); n == 0 || r[n-1] != v {
+			r = append(r, v)
+		}
+	}
+	return r
+}
+
+func intSortedSetIntersect(a, b []int) []int {
+	n := len(a)
+	if len(b) < n {
+		n = len(b)
+	}
+	r := make([]int, 0, n)
+	i, j := 0, 0
+	for i < len(a) && j < len(b) {
+		switch {
+		case a[i] < b[j]:
+			i++
+		case b[j] < a[i]:
+			j++
+		default:
+			v := a[i]
+			if n := len(r); n == 0 || r[n-1] != v {
+				r = append(r, v)
+			}
+			i++
+			j++
+		}
+	}
+	return r
+}
+
+func intSortedSetDifference(a, b []int) []int {
+	r := make([]int, 0, len(a))
+	j := 0
+	for _, v := range a {
+		for j < len(b) && b[j] < v {
+			j++
+		}
+		if j < len(b) && b[j] == v {
+			continue
+		}
+		if n := len(r); n == 0 || r[n-1] != v {
+			r = append(r, v)
+		}
+	}
+	return r
+}
+
+func intSortedSetSymmetricDifference(a, b []int) []int {
+	r := make([]int, 0, len(a)+len(b))
+	i, j := 0, 0
+	for i < len(a) || j < len(b) {
+		var v int
+		switch {
+		case j >= len(b) || i < len(a) && a[i] < b[j]:
+			v = a[i]
+			i++
+		case i >= len(a) || b[j] < a[i]:
+			v = b[j]
+			j++
+		default:
+			v = a[i]
+			for i < len(a) && a[i] == v {
+				i++
+			}
+			for j < len(b) && b[j] == v {
+				j++
+			}
+			continue
+		}
+		if n := len(r); n == 0 || r[n-1] != v {
+			r = append(r, v)
+		}
+	}
+	return r
+}
+
+// IntsEdit is an edit run of the edit script produced by IntsDiff.
+type IntsEdit struct {
+	Kind   DiffKind
+	Values []int
+}
+
+// IntsDiff computes the shortest edit script that turns slice a into slice b, see also DiffFunc.
+// This method does not change the existing slices, but instead returns the runs of edits.
+func IntsDiff(a, b []int) []IntsEdit {
+	spans := DiffFunc(len(a), len(b), func(i, j int) bool { return a[i] == b[j] })
+	r := make([]IntsEdit, len(spans))
+	for k, span := range spans {
+		r[k].Kind = span.Kind
+		if span.Kind == DiffInsert {
+			r[k].Values = IntsCopy(b[span.B : span.B+span.Len])
+		} else {
+			r[k].Values = IntsCopy(a[span.A : span.A+span.Len])
+		}
+	}
+	return r
+}
+
+// IntsApplyPatch changes the contents of an slice in place by applying the edit script produced by IntsDiff.
+// NOTE:
+//
+//	If the slice does not match the equal and delete runs of the patch, it returns an error
+//	and the slice will not be modified.
+func IntsApplyPatch(p *[]int, patch []IntsEdit) error {
+	a := *p
+	r := make([]int, 0, len(a))
+	pos := 0
+	for _, e := range patch {
+		switch e.Kind {
+		case DiffInsert:
+			r = append(r, e.Values...)
+		case DiffEqual, DiffDelete:
+			if pos+len(e.Values) > len(a) {
+				return fmt.Errorf("patch mismatch: %s run exceeds the slice length %d", e.Kind, len(a))
+			}
+			for k, v := range e.Values {
+				if a[pos+k] != v {
+					return fmt.Errorf("patch mismatch: %s run at index %d", e.Kind, pos+k)
+				}
+			}
+			if e.Kind == DiffEqual {
+				r = append(r, e.Values...)
+			}
+			pos += len(e.Values)
+		default:
+			return fmt.Errorf("patch mismatch: unknown edit kind %d", e.Kind)
+		}
+	}
+	if pos != len(a) {
+		return fmt.Errorf("patch mismatch: the slice has %d unpatched elements", len(a)-pos)
+	}
+	n := len(r)
+	*p = r[:n:n]
+	return nil
+}
+
+// IntsShuffle randomizes the order of elements in place.
+// @r
+//
+//	The source of random numbers, nil means the global source of package math/rand.
+func IntsShuffle(i []int, r Randomizer) {
+	shuffleIndex(len(i), len(i), r, func(x, y int) { i[x], i[y] = i[y], i[x] })
+}
+
+// IntsSample returns k elements randomly selected from the slice without replacement.
+// If k is greater than the length of the slice, all the elements are returned in random order.
+// This method does not change the existing slice, but instead returns a new slice.
+// @r
+//
+//	The source of random numbers, nil means the global source of package math/rand.
+func IntsSample(i []int, k int, r Randomizer) []int {
+	if k <= 0 {
+		return []int{}
+	}
+	a := IntsCopy(i)
+	shuffleIndex(len(a), k, r, func(x, y int) { a[x], a[y] = a[y], a[x] })
+	if k > len(a) {
+		k = len(a)
+	}
+	return a[:k:k]
+}
+
+// IntsSampleWithReplacement returns k elements randomly selected from the slice with replacement.
+// This method does not change the existing slice, but instead returns a new slice.
+// @r
+//
+//	The source of random numbers, nil means the global source of package math/rand.
+func IntsSampleWithReplacement(i []int, k int, r Randomizer) []int {
+	if k <= 0 || len(i) == 0 {
+		return []int{}
+	}
+	r = getRandomizer(r)
+	ret := make([]int, k)
+	for n := range ret {
+		ret[n] = i[r.Intn(len(i))]
+	}
+	return ret
+}
+
+// IntsWeightedChoice returns the key-value of an element randomly selected
+// with the probability proportional to its weight.
+// @weights
+//
+//	The weights of the elements with the same indexes, must be finite and non-negative.
+//
+// @r
+//
+//	The source of random numbers, nil means the global source of package math/rand.
+//
+// NOTE:
+//
+//	If the slice is empty, or the weights are invalid, returns an error and k = -1
+func IntsWeightedChoice(i []int, weights []float64, r Randomizer) (k int, v int, err error) {
+	k, err = weightedIndex(len(i), weights, r)
+	if err != nil {
+		return k, v, err
+	}
+	return k, i[k], nil
+}
+
+// IntsReservoirSample returns k elements randomly selected from a stream of unknown length,
+// in a single pass and with O(k) memory.
+// @next
+//
+//	Returns the next element of the stream, or ok = false at the end of the stream.
+//
+// @r
+//
+//	The source of random numbers, nil means the global source of package math/rand.
+func IntsReservoirSample(next func() (v int, ok bool), k int, r Randomizer) []int {
+	if k <= 0 {
+		return []int{}
+	}
+	r = getRandomizer(r)
+	ret := make([]int, 0, k)
+	for seen := 0; ; seen++ {
+		v, ok := next()
+		if !ok {
+			return ret
+		}
+		switch slot := reservoirIndex(seen, k, r); {
+		case slot == len(ret):
+			ret = append(ret, v)
+		case slot >= 0:
+			ret[slot] = v
+		}
+	}
+}
+
+// IntsAt returns the element at the given index.
+// @index
+//
+//	Zero-based index of the element. If negative, index will be counted from the end.
+//
+// NOTE:
+//
+//	If the index is out of range, ok = false
+func IntsAt(i []int, index int) (v int, ok bool) {
+	index, ok = atIndex(len(i), index)
+	if !ok {
+		return v, false
+	}
+	return i[index], true
+}
+
+// IntsWith returns a copy of the slice with the element at the given index replaced with the value.
+// @index
+//
+//	Zero-based index of the element. If negative, index will be counted from the end.
+//
+// NOTE:
+//
+//	If the index is out of range, ok = false
+func IntsWith(i []int, index int, value int) (ret []int, ok bool) {
+	ret = IntsCopy(i)
+	index, ok = atIndex(len(i), index)
+	if ok {
+		ret[index] = value
+	}
+	return ret, ok
+}
+
+// IntsFindIndex returns the index of the first element in the provided slice that satisfies the provided testing function.
+// NOTE:
+//
+//	If not found, returns -1
+func IntsFindIndex(i []int, fn func(i []int, k int, v int) bool) int {
+	k, _ := IntsFind(i, fn)
+	return k
+}
+
+// IntsFindLast returns the key-value of the last element in the provided slice that satisfies the provided testing function.
+// NOTE:
+//
+//	If not found, k = -1
+func IntsFindLast(i []int, fn func(i []int, k int, v int) bool) (k int, v int) {
+	for k := len(i) - 1; k >= 0; k-- {
+		if fn(i, k, i[k]) {
+			return k, i[k]
+		}
+	}
+	return -1, v
+}
+
+// IntsFindLastIndex returns the index of the last element in the provided slice that satisfies the provided testing function.
+// NOTE:
+//
+//	If not found, returns -1
+func IntsFindLastIndex(i []int, fn func(i []int, k int, v int) bool) int {
+	k, _ := IntsFindLast(i, fn)
+	return k
+}
+
+// IntsFlat creates a new slice with all sub-slice elements concatenated into it.
+func IntsFlat(i [][]int) []int {
+	return IntsConcat(i...)
+}
+
+// IntsFlatMap creates a new slice populated with the results of calling a provided function
+// on every element in the calling slice, and then flattening the results.
+func IntsFlatMap(i []int, fn func(i []int, k int, v int) []int) []int {
+	ret := make([]int, 0, len(i))
+	for k, v := range i {
+		ret = append(ret, fn(i, k, v)...)
+	}
+	return ret
+}
+
+// IntsToSorted returns a copy of the slice sorted stably.
+// @less
+//
+//	Reports whether the element a must sort before the element b. If nil, sorts in ascending order.
+func IntsToSorted(i []int, less func(a, b int) bool) []int {
+	ret := IntsCopy(i)
+	if less == nil {
+		less = func(a, b int) bool { return a < b }
+	}
+	sort.SliceStable(ret, func(x, y int) bool { return less(ret[x], ret[y]) })
+	return ret
+}
+
+// IntsToReversed returns a copy of the slice in reversed order.
+func IntsToReversed(i []int) []int {
+	ret := IntsCopy(i)
+	IntsReverse(ret)
+	return ret
+}
+
+// IntsToSpliced returns a copy of the slice with some elements removed and/or replaced at a given index,
+// see also IntsSplice.
+func IntsToSpliced(i []int, start, deleteCount int, items ...int) []int {
+	ret := IntsCopy(i)
+	IntsSplice(&ret, start, deleteCount, items...)
+	return ret
+}
+
+// IntsKeys returns an iterator of the indexes of the slice.
+// NOTE:
+//
+//	The iterator returns ok = false when it is exhausted.
+func IntsKeys(i []int) (next func() (k int, ok bool)) {
+	k := -1
+	return func() (int, bool) {
+		if k+1 >= len(i) {
+			return -1, false
+		}
+		k++
+		return k, true
+	}
+}
+
+// IntsValues returns an iterator of the elements of the slice.
+// NOTE:
+//
+//	The iterator returns ok = false when it is exhausted.
+func IntsValues(i []int) (next func() (v int, ok bool)) {
+	k := -1
+	return func() (v int, ok bool) {
+		if k+1 >= len(i) {
+			return v, false
+		}
+		k++
+		return i[k], true
+	}
+}
+
+// IntsEntries returns an iterator of the key-value pairs of the slice.
+// NOTE:
+//
+//	The iterator returns ok = false when it is exhausted.
+func IntsEntries(i []int) (next func() (k int, v int, ok bool)) {
+	k := -1
+	return func() (int, int, bool) {
+		if k+1 >= len(i) {
+			var v int
+			return -1, v, false
+		}
+		k++
+		return k, i[k], true
+	}
+}
+
+// IntsGroup groups the elements of the slice according to the string keys returned by the provided function.
+func IntsGroup(i []int, fn func(i []int, k int, v int) string) map[string][]int {
+	ret := make(map[string][]int)
+	for k, v := range i {
+		key := fn(i, k, v)
+		ret[key] = append(ret[key], v)
+	}
+	return ret
+}
+
+// IntsGroupToMap groups the elements of the slice according to the keys returned by the provided function.
+// NOTE:
+//
+//	The keys must be comparable.
+func IntsGroupToMap(i []int, fn func(i []int, k int, v int) interface{}) map[interface{}][]int {
+	ret := make(map[interface{}][]int)
+	for k, v := range i {
+		key := fn(i, k, v)
+		ret[key] = append(ret[key], v)
+	}
+	return ret
+}
+
+// IntsEqual reports whether a and b have the same length and the same elements in the same order.
+func IntsEqual(a, b []int) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for k, v := range a {
+		if v != b[k] {
+			return false
+		}
+	}
+	return true
+}
+
+// IntsCompare compares a and b lexicographically, and returns -1 if a < b, 0 if a == b, or +1 if a > b.
+// NOTE:
+//
+//	A slice that is a prefix of another slice is less than it.
+func IntsCompare(a, b []int) int {
+	for k := 0; k < len(a) && k < len(b); k++ {
+		if c := compareInt(a[k], b[k]); c != 0 {
+			return c
+		}
+	}
+	return compareInt(len(a), len(b))
+}
+
+// IntsHasPrefix reports whether the slice i begins with prefix.
+func IntsHasPrefix(i, prefix []int) bool {
+	return len(i) >= len(prefix) && IntsEqual(i[:len(prefix)], prefix)
+}
+
+// IntsHasSuffix reports whether the slice i ends with suffix.
+func IntsHasSuffix(i, suffix []int) bool {
+	return len(i) >= len(suffix) && IntsEqual(i[len(i)-len(suffix):], suffix)
+}
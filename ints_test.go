@@ -198,6 +198,23 @@ func TestIntsSplice(t *testing.T) {
 	slice = []int{0, 1, 2, 3, 4}
 	IntsSplice(&slice, 1, 10, 1, 2)
 	assert.Equal(t, []int{0, 1, 2}, slice)
+	// deleteCount > 0 without items only deletes
+	slice = []int{0, 1, 2, 3, 4}
+	IntsSplice(&slice, 1, 2)
+	assert.Equal(t, []int{0, 3, 4}, slice)
+
+	// negative start is counted from the end
+	slice = []int{0, 1, 2, 3, 4}
+	IntsSplice(&slice, -2, 1)
+	assert.Equal(t, []int{0, 1, 2, 4}, slice)
+
+	slice = []int{0, 1, 2, 3, 4}
+	IntsSplice(&slice, -2, 0, 9)
+	assert.Equal(t, []int{0, 1, 2, 9, 3, 4}, slice)
+
+	slice = []int{0, 1, 2, 3, 4}
+	IntsSplice(&slice, -10, 1)
+	assert.Equal(t, []int{1, 2, 3, 4}, slice)
 }
 
 func TestIntsUnshift(t *testing.T) {
@@ -235,3 +252,120 @@ func TestIntsRemoveEvery(t *testing.T) {
 	assert.Equal(t, len(slice), n)
 	assert.Equal(t, []int{-1, -1, 1, 1}, slice)
 }
+
+func TestIntSortedSet(t *testing.T) {
+	set1 := []int{1, 2, 2, 3, 6, 8}
+	set2 := []int{0, 2, 3, 5}
+	set3 := []int{2, 6, 7}
+	assert.True(t, IntsIsSorted(set1))
+	assert.False(t, IntsIsSorted([]int{2, 1}))
+	assert.Equal(t, []int{0, 1, 2, 3, 5, 6, 7, 8}, IntSortedSetUnion(set1, set2, set3))
+	assert.Equal(t, []int{2}, IntSortedSetIntersect(set1, set2, set3))
+	assert.Equal(t, []int{1, 8}, IntSortedSetDifference(set1, set2, set3))
+	assert.Equal(t, []int{0, 1, 5, 6, 8}, IntSortedSetSymmetricDifference(set1, set2))
+	assert.Equal(t, []int{0, 1, 2, 5, 7, 8}, IntSortedSetSymmetricDifference(set1, set2, set3))
+	assert.True(t, IntSortedSetIsSubset([]int{2, 2, 6}, set1))
+	assert.True(t, IntSortedSetIsSubset(nil, set1))
+	assert.False(t, IntSortedSetIsSubset([]int{2, 4}, set1))
+	assert.False(t, IntSortedSetIsSubset([]int{9}, set1))
+	assert.Equal(t, []int{}, IntSortedSetIntersect(set1, nil))
+}
+
+func TestIntsAt(t *testing.T) {
+	slice := []int{1, 2, 3}
+	v, ok := IntsAt(slice, -1)
+	assert.True(t, ok)
+	assert.Equal(t, 3, v)
+	_, ok = IntsAt(slice, 3)
+	assert.False(t, ok)
+	_, ok = IntsAt(slice, -4)
+	assert.False(t, ok)
+
+	ret, ok := IntsWith(slice, -2, 9)
+	assert.True(t, ok)
+	assert.Equal(t, []int{1, 9, 3}, ret)
+	assert.Equal(t, []int{1, 2, 3}, slice)
+}
+
+func TestIntsFindLast(t *testing.T) {
+	slice := []int{301, 302, 303, 304, 305, 306}
+	gt := func(i []int, k int, v int) bool { return v > 303 }
+	k, v := IntsFindLast(slice, gt)
+	assert.Equal(t, 5, k)
+	assert.Equal(t, 306, v)
+	assert.Equal(t, 3, IntsFindIndex(slice, gt))
+	assert.Equal(t, 5, IntsFindLastIndex(slice, gt))
+	assert.Equal(t, -1, IntsFindLastIndex(slice, func(i []int, k int, v int) bool { return v > 400 }))
+}
+
+func TestIntsFlatMap(t *testing.T) {
+	assert.Equal(t, []int{1, 2, 3}, IntsFlat([][]int{{1}, {}, {2, 3}}))
+	ret := IntsFlatMap([]int{1, 2, 3}, func(i []int, k int, v int) []int {
+		return i[:v-1]
+	})
+	assert.Equal(t, []int{1, 1, 2}, ret)
+}
+
+func TestIntsToSorted(t *testing.T) {
+	slice := []int{3, 1, 2}
+	assert.Equal(t, []int{1, 2, 3}, IntsToSorted(slice, nil))
+	assert.Equal(t, []int{3, 2, 1}, IntsToSorted(slice, func(a, b int) bool { return a > b }))
+	assert.Equal(t, []int{2, 1, 3}, IntsToReversed(slice))
+	assert.Equal(t, []int{3, 9, 2}, IntsToSpliced(slice, -2, 1, 9))
+	assert.Equal(t, []int{3, 1, 2}, slice)
+}
+
+func TestIntsEntries(t *testing.T) {
+	slice := []int{7, 8}
+	next := IntsEntries(slice)
+	k, v, ok := next()
+	assert.Equal(t, []interface{}{0, 7, true}, []interface{}{k, v, ok})
+	k, v, ok = next()
+	assert.Equal(t, []interface{}{1, 8, true}, []interface{}{k, v, ok})
+	k, v, ok = next()
+	assert.Equal(t, []interface{}{-1, 0, false}, []interface{}{k, v, ok})
+
+	var keys []int
+	nextKey := IntsKeys(slice)
+	for k, ok := nextKey(); ok; k, ok = nextKey() {
+		keys = append(keys, k)
+	}
+	assert.Equal(t, []int{0, 1}, keys)
+	assert.Equal(t, []int{7, 8}, IntsReservoirSample(IntsValues(slice), 2, nil))
+}
+
+func TestIntsGroup(t *testing.T) {
+	slice := []int{1, 2, 3, 4, 5}
+	parity := func(i []int, k int, v int) string {
+		if v%2 == 0 {
+			return "even"
+		}
+		return "odd"
+	}
+	assert.Equal(t, map[string][]int{"even": {2, 4}, "odd": {1, 3, 5}}, IntsGroup(slice, parity))
+	ret := IntsGroupToMap(slice, func(i []int, k int, v int) interface{} { return v > 2 })
+	assert.Equal(t, map[interface{}][]int{false: {1, 2}, true: {3, 4, 5}}, ret)
+}
+
+func TestIntsCompare(t *testing.T) {
+	assert.True(t, IntsEqual([]int{1, 2}, []int{1, 2}))
+	assert.True(t, IntsEqual(nil, []int{}))
+	assert.False(t, IntsEqual([]int{1, 2}, []int{1}))
+	assert.Equal(t, 0, IntsCompare([]int{1, 2}, []int{1, 2}))
+	assert.Equal(t, -1, IntsCompare([]int{1, 2}, []int{1, 3}))
+	assert.Equal(t, 1, IntsCompare([]int{2}, []int{1, 3}))
+	assert.Equal(t, -1, IntsCompare([]int{1}, []int{1, 0}))
+	assert.Equal(t, 1, IntsCompare([]int{1}, nil))
+	assert.True(t, IntsHasPrefix([]int{1, 2, 3}, []int{1, 2}))
+	assert.True(t, IntsHasPrefix([]int{1, 2, 3}, nil))
+	assert.False(t, IntsHasPrefix([]int{1}, []int{1, 2}))
+	assert.True(t, IntsHasSuffix([]int{1, 2, 3}, []int{2, 3}))
+	assert.False(t, IntsHasSuffix([]int{1, 2, 3}, []int{1, 3}))
+	assert.Equal(t, -1, BoolsCompare([]bool{false, true}, []bool{true}))
+	assert.Equal(t, 1, InterfacesCompare([]interface{}{1, "b"}, []interface{}{1, "a"}, func(a, b interface{}) int {
+		return StringsCompare([]string{InterfaceToString(a)}, []string{InterfaceToString(b)})
+	}))
+	assert.True(t, InterfacesEqualFunc([]interface{}{1, 2.0}, []interface{}{int8(1), uint(2)}, InterfaceLooseEqual))
+	assert.False(t, InterfacesEqual([]interface{}{1, []int{2}}, []interface{}{1, []int{2}}))
+	assert.True(t, InterfacesHasSuffixFunc([]interface{}{1, []int{2}}, []interface{}{[]int{2}}, InterfaceDeepEqual))
+}
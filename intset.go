@@ -0,0 +1,222 @@
+package ameda
+
+import "sort"
+
+// IntSet is a set of int elements that remembers the insertion order.
+// NOTE:
+//
+//	The zero value is an empty set ready to use.
+//	It is not safe for concurrent use.
+type IntSet struct {
+	index   map[int]int // element -> position in elems
+	elems   []int
+	removed []bool
+	holes   int
+}
+
+// NewIntSet creates a set from the elements, see also IntSet.Slice.
+func NewIntSet(elements ...int) *IntSet {
+	s := &IntSet{
+		index: make(map[int]int, len(elements)),
+		elems: make([]int, 0, len(elements)),
+	}
+	s.Add(elements...)
+	return s
+}
+
+// Add adds the elements that are not yet in the set, and returns the new length of the set.
+func (s *IntSet) Add(elements ...int) int {
+	if s.index == nil {
+		s.index = make(map[int]int, len(elements))
+	}
+	for _, v := range elements {
+		if _, ok := s.index[v]; ok {
+			continue
+		}
+		s.index[v] = len(s.elems)
+		s.elems = append(s.elems, v)
+		if s.removed != nil {
+			s.removed = append(s.removed, false)
+		}
+	}
+	return s.Len()
+}
+
+// Remove removes the elements from the set, and returns the new length of the set.
+func (s *IntSet) Remove(elements ...int) int {
+	for _, v := range elements {
+		k, ok := s.index[v]
+		if !ok {
+			continue
+		}
+		delete(s.index, v)
+		if s.removed == nil {
+			s.removed = make([]bool, len(s.elems))
+		}
+		s.removed[k] = true
+		s.holes++
+	}
+	if s.holes > 0 && s.holes*2 >= len(s.elems) {
+		s.compact()
+	}
+	return s.Len()
+}
+
+// Has reports whether the element is in the set.
+func (s *IntSet) Has(element int) bool {
+	_, ok := s.index[element]
+	return ok
+}
+
+// Len returns the number of elements in the set.
+func (s *IntSet) Len() int {
+	return len(s.index)
+}
+
+// Clear removes all elements from the set.
+func (s *IntSet) Clear() {
+	*s = IntSet{}
+}
+
+// Clone creates a copy of the set.
+func (s *IntSet) Clone() *IntSet {
+	r := &IntSet{
+		index: make(map[int]int, s.Len()),
+		elems: make([]int, 0, s.Len()),
+	}
+	s.Range(func(v int) bool {
+		r.index[v] = len(r.elems)
+		r.elems = append(r.elems, v)
+		return true
+	})
+	return r
+}
+
+// Range calls fn for each element in insertion order; if fn returns false, range stops the iteration.
+func (s *IntSet) Range(fn func(v int) bool) {
+	for k, v := range s.elems {
+		if s.removed != nil && s.removed[k] {
+			continue
+		}
+		if !fn(v) {
+			return
+		}
+	}
+}
+
+// RangeSorted calls fn for each element in ascending order; if fn returns false, range stops the iteration.
+func (s *IntSet) RangeSorted(fn func(v int) bool) {
+	for _, v := range s.SortedSlice() {
+		if !fn(v) {
+			return
+		}
+	}
+}
+
+// Slice returns the elements in insertion order.
+func (s *IntSet) Slice() []int {
+	r := make([]int, 0, s.Len())
+	s.Range(func(v int) bool {
+		r = append(r, v)
+		return true
+	})
+	return r
+}
+
+// SortedSlice returns the elements in ascending order.
+func (s *IntSet) SortedSlice() []int {
+	r := s.Slice()
+	sort.Slice(r, func(i, j int) bool { return r[i] < r[j] })
+	return r
+}
+
+// Union returns a new set: s ∪ others...
+func (s *IntSet) Union(others ...*IntSet) *IntSet {
+	r := s.Clone()
+	for _, o := range others {
+		o.Range(func(v int) bool {
+			r.Add(v)
+			return true
+		})
+	}
+	return r
+}
+
+// Intersect returns a new set: s ∩ others...
+func (s *IntSet) Intersect(others ...*IntSet) *IntSet {
+	r := NewIntSet()
+	s.Range(func(v int) bool {
+		for _, o := range others {
+			if !o.Has(v) {
+				return true
+			}
+		}
+		r.Add(v)
+		return true
+	})
+	return r
+}
+
+// Difference returns a new set: s - others...
+func (s *IntSet) Difference(others ...*IntSet) *IntSet {
+	r := NewIntSet()
+	s.Range(func(v int) bool {
+		for _, o := range others {
+			if o.Has(v) {
+				return true
+			}
+		}
+		r.Add(v)
+		return true
+	})
+	return r
+}
+
+// SymmetricDifference returns a new set of the elements that are in exactly one of the two sets: s ∆ other.
+func (s *IntSet) SymmetricDifference(other *IntSet) *IntSet {
+	r := s.Difference(other)
+	other.Range(func(v int) bool {
+		if !s.Has(v) {
+			r.Add(v)
+		}
+		return true
+	})
+	return r
+}
+
+// IsSubset reports whether every element of s is also in other: s ⊆ other.
+func (s *IntSet) IsSubset(other *IntSet) bool {
+	if s.Len() > other.Len() {
+		return false
+	}
+	for v := range s.index {
+		if !other.Has(v) {
+			return false
+		}
+	}
+	return true
+}
+
+// IsSuperset reports whether every element of other is also in s: s ⊇ other.
+func (s *IntSet) IsSuperset(other *IntSet) bool {
+	return other.IsSubset(s)
+}
+
+// Equal reports whether the two sets contain the same elements, regardless of the order.
+func (s *IntSet) Equal(other *IntSet) bool {
+	return s.Len() == other.Len() && s.IsSubset(other)
+}
+
+func (s *IntSet) compact() {
+	elems := s.elems[:0]
+	for k, v := range s.elems {
+		if s.removed[k] {
+			continue
+		}
+		s.index[v] = len(elems)
+		elems = append(elems, v)
+	}
+	s.elems = elems
+	s.removed = nil
+	s.holes = 0
+}
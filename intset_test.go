@@ -0,0 +1,55 @@
+package ameda
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIntSet(t *testing.T) {
+	var s IntSet
+	assert.Equal(t, 0, s.Len())
+	assert.Equal(t, 3, s.Add(3, 1, 2, 1))
+	assert.True(t, s.Has(1))
+	assert.False(t, s.Has(4))
+	assert.Equal(t, []int{3, 1, 2}, s.Slice())
+	assert.Equal(t, []int{1, 2, 3}, s.SortedSlice())
+
+	assert.Equal(t, 2, s.Remove(1, 4))
+	assert.Equal(t, []int{3, 2}, s.Slice())
+	assert.Equal(t, 3, s.Add(1))
+	assert.Equal(t, []int{3, 2, 1}, s.Slice())
+	assert.Equal(t, 0, s.Remove(1, 2, 3))
+	assert.Equal(t, []int{}, s.Slice())
+	s.Add(5, 6)
+	assert.Equal(t, []int{5, 6}, s.Slice())
+
+	c := s.Clone()
+	c.Add(7)
+	assert.Equal(t, 2, s.Len())
+	assert.Equal(t, 3, c.Len())
+	s.Clear()
+	assert.Equal(t, 0, s.Len())
+}
+
+func TestIntSetAlgebra(t *testing.T) {
+	set1 := NewIntSet(1, 2, 3, 6, 8)
+	set2 := NewIntSet(2, 3, 5, 0)
+	set3 := NewIntSet(2, 6, 7)
+	assert.Equal(t, []int{1, 2, 3, 6, 8, 5, 0, 7}, set1.Union(set2, set3).Slice())
+	assert.Equal(t, []int{2}, set1.Intersect(set2, set3).Slice())
+	assert.Equal(t, []int{1, 8}, set1.Difference(set2, set3).Slice())
+	assert.Equal(t, []int{1, 6, 8, 5, 0}, set1.SymmetricDifference(set2).Slice())
+	assert.True(t, NewIntSet(2, 6).IsSubset(set1))
+	assert.False(t, NewIntSet(2, 7).IsSubset(set1))
+	assert.True(t, set1.IsSuperset(NewIntSet(8, 1)))
+	assert.True(t, NewIntSet(3, 2, 1).Equal(NewIntSet(1, 2, 3)))
+	assert.False(t, NewIntSet(3, 2).Equal(NewIntSet(1, 2)))
+
+	var sorted []int
+	set1.Union(set2).RangeSorted(func(v int) bool {
+		sorted = append(sorted, v)
+		return v < 3
+	})
+	assert.Equal(t, []int{0, 1, 2, 3}, sorted)
+}
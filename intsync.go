@@ -0,0 +1,373 @@
+package ameda
+
+import (
+	"sync"
+	"sync/atomic"
+)
+
+// SyncInts is an int slice that is safe for concurrent use.
+// NOTE:
+//
+//	The zero value is an empty slice ready to use.
+//	Point reads such as Len and At use the read lock without copying the elements.
+//	Callbacks are run on a snapshot, so they can call the methods of the same SyncInts.
+type SyncInts struct {
+	mu   sync.RWMutex
+	data []int
+	snap atomic.Value // []int: read-only copy of data, nil after modification
+}
+
+// NewSyncInts creates a SyncInts with a copy of the elements.
+func NewSyncInts(elements ...int) *SyncInts {
+	return &SyncInts{data: IntsCopy(elements)}
+}
+
+// Snapshot returns a read-only copy of the current elements.
+// NOTE:
+//
+//	The result is shared with other callers until the next modification, so it must not be changed.
+//	While there is no modification, it is an atomic read without locking.
+func (s *SyncInts) Snapshot() []int {
+	if snap, _ := s.snap.Load().([]int); snap != nil {
+		return snap
+	}
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	snap := IntsCopy(s.data)
+	s.snap.Store(snap)
+	return snap
+}
+
+// Slice returns a copy of the current elements that can be changed.
+func (s *SyncInts) Slice() []int {
+	return IntsCopy(s.Snapshot())
+}
+
+// Len returns the number of elements.
+func (s *SyncInts) Len() int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return len(s.data)
+}
+
+// At returns the element at the index, which can be negative and counted from the end.
+func (s *SyncInts) At(index int) (v int, ok bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return IntsAt(s.data, index)
+}
+
+// Includes determines whether the slice includes a certain element.
+func (s *SyncInts) Includes(valueToFind int, fromIndex ...int) bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return IntsIncludes(s.data, valueToFind, fromIndex...)
+}
+
+// IndexOf returns the first index at which a given element can be found, or -1 if it is not present.
+func (s *SyncInts) IndexOf(searchElement int, fromIndex ...int) int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return IntsIndexOf(s.data, searchElement, fromIndex...)
+}
+
+// LastIndexOf returns the last index at which a given element can be found, or -1 if it is not present.
+func (s *SyncInts) LastIndexOf(searchElement int, fromIndex ...int) int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return IntsLastIndexOf(s.data, searchElement, fromIndex...)
+}
+
+// Range calls fn for each element of a snapshot in order, until fn returns false.
+func (s *SyncInts) Range(fn func(k int, v int) bool) {
+	for k, v := range s.Snapshot() {
+		if !fn(k, v) {
+			return
+		}
+	}
+}
+
+// Every tests whether all elements of a snapshot pass the test implemented by fn.
+func (s *SyncInts) Every(fn func(i []int, k int, v int) bool) bool {
+	return IntsEvery(s.Snapshot(), fn)
+}
+
+// Some tests whether at least one element of a snapshot passes the test implemented by fn.
+func (s *SyncInts) Some(fn func(i []int, k int, v int) bool) bool {
+	return IntsSome(s.Snapshot(), fn)
+}
+
+// Find returns the key-value of the first element of a snapshot that satisfies fn.
+// If no element satisfies fn, -1 is returned.
+func (s *SyncInts) Find(fn func(i []int, k int, v int) bool) (k int, v int) {
+	return IntsFind(s.Snapshot(), fn)
+}
+
+// Filter creates a new slice with the elements of a snapshot that pass the test implemented by fn.
+func (s *SyncInts) Filter(fn func(i []int, k int, v int) bool) []int {
+	return IntsFilter(s.Snapshot(), fn)
+}
+
+// Map creates a new slice with the results of calling fn on every element of a snapshot.
+func (s *SyncInts) Map(fn func(i []int, k int, v int) int) []int {
+	return IntsMap(s.Snapshot(), fn)
+}
+
+// Reduce executes fn on each element of a snapshot, resulting in a single output value.
+func (s *SyncInts) Reduce(fn func(i []int, k int, v, accumulator int) int, initialValue ...int) int {
+	return IntsReduce(s.Snapshot(), fn, initialValue...)
+}
+
+// Set changes the element at the index, which can be negative and counted from the end,
+// and reports whether the index is in range.
+func (s *SyncInts) Set(index int, value int) bool {
+	s.mu.Lock()
+	defer s.unlock()
+	k, ok := atIndex(len(s.data), index)
+	if ok {
+		s.data[k] = value
+	}
+	return ok
+}
+
+// Push adds one or more elements to the end, and returns the new length.
+func (s *SyncInts) Push(element ...int) int {
+	s.mu.Lock()
+	defer s.unlock()
+	return IntsPush(&s.data, element...)
+}
+
+// PushDistinct adds one or more new elements that do not exist at the end, and returns the new length.
+func (s *SyncInts) PushDistinct(element ...int) int {
+	s.mu.Lock()
+	defer s.unlock()
+	s.data = IntsPushDistinct(s.data, element...)
+	return len(s.data)
+}
+
+// Pop removes the last element and returns that element.
+func (s *SyncInts) Pop() (int, bool) {
+	s.mu.Lock()
+	defer s.unlock()
+	return IntsPop(&s.data)
+}
+
+// Shift removes the first element and returns that element.
+func (s *SyncInts) Shift() (int, bool) {
+	s.mu.Lock()
+	defer s.unlock()
+	return IntsShift(&s.data)
+}
+
+// Unshift adds one or more elements to the beginning, and returns the new length.
+func (s *SyncInts) Unshift(element ...int) int {
+	s.mu.Lock()
+	defer s.unlock()
+	return IntsUnshift(&s.data, element...)
+}
+
+// UnshiftDistinct adds one or more new elements that do not exist to the beginning, and returns the new length.
+func (s *SyncInts) UnshiftDistinct(element ...int) int {
+	s.mu.Lock()
+	defer s.unlock()
+	return IntsUnshiftDistinct(&s.data, element...)
+}
+
+// Splice removes deleteCount elements from start and adds the items in their place.
+func (s *SyncInts) Splice(start, deleteCount int, items ...int) {
+	s.mu.Lock()
+	defer s.unlock()
+	IntsSplice(&s.data, start, deleteCount, items...)
+}
+
+// RemoveFirst removes the first matched elements, and returns the new length.
+func (s *SyncInts) RemoveFirst(elements ...int) int {
+	s.mu.Lock()
+	defer s.unlock()
+	return IntsRemoveFirst(&s.data, elements...)
+}
+
+// RemoveEvery removes all the elements, and returns the new length.
+func (s *SyncInts) RemoveEvery(elements ...int) int {
+	s.mu.Lock()
+	defer s.unlock()
+	return IntsRemoveEvery(&s.data, elements...)
+}
+
+// Distinct removes the duplicate elements, and returns the count of each element.
+func (s *SyncInts) Distinct() (distinctCount map[int]int) {
+	s.mu.Lock()
+	defer s.unlock()
+	return IntsDistinct(&s.data, true)
+}
+
+// Fill changes all elements from start to end to a static value.
+func (s *SyncInts) Fill(value int, start int, end ...int) {
+	s.mu.Lock()
+	defer s.unlock()
+	IntsFill(s.data, value, start, end...)
+}
+
+// CopyWithin copies part of the slice to another location in the same slice.
+func (s *SyncInts) CopyWithin(target, start int, end ...int) {
+	s.mu.Lock()
+	defer s.unlock()
+	IntsCopyWithin(s.data, target, start, end...)
+}
+
+// Reverse reverses the elements in place.
+func (s *SyncInts) Reverse() {
+	s.mu.Lock()
+	defer s.unlock()
+	IntsReverse(s.data)
+}
+
+// Shuffle randomizes the order of the elements in place.
+func (s *SyncInts) Shuffle(r Randomizer) {
+	s.mu.Lock()
+	defer s.unlock()
+	IntsShuffle(s.data, r)
+}
+
+// ApplyPatch applies the edit script to the elements.
+func (s *SyncInts) ApplyPatch(patch []IntsEdit) error {
+	s.mu.Lock()
+	defer s.unlock()
+	return IntsApplyPatch(&s.data, patch)
+}
+
+// Update calls fn with the pointer of the elements under the write lock.
+// NOTE:
+//
+//	fn must not call the methods of the same SyncInts, and must not retain the slice.
+func (s *SyncInts) Update(fn func(p *[]int)) {
+	s.mu.Lock()
+	defer s.unlock()
+	fn(&s.data)
+}
+
+// Clear removes all elements.
+func (s *SyncInts) Clear() {
+	s.mu.Lock()
+	defer s.unlock()
+	s.data = nil
+}
+
+// unlock invalidates the snapshot and releases the write lock.
+func (s *SyncInts) unlock() {
+	s.snap.Store([]int(nil))
+	s.mu.Unlock()
+}
+
+// SyncIntSet is an int set that is safe for concurrent use.
+// NOTE:
+//
+//	The zero value is an empty set ready to use.
+//	Len and Has use the read lock without copying the elements.
+//	Callbacks are run on a snapshot, so they can call the methods of the same SyncIntSet.
+type SyncIntSet struct {
+	mu   sync.RWMutex
+	set  *IntSet
+	snap atomic.Value // []int: read-only elements in insertion order, nil after modification
+}
+
+// NewSyncIntSet creates a SyncIntSet with the elements.
+func NewSyncIntSet(elements ...int) *SyncIntSet {
+	return &SyncIntSet{set: NewIntSet(elements...)}
+}
+
+// Snapshot returns the read-only elements in insertion order.
+// NOTE:
+//
+//	The result is shared with other callers until the next modification, so it must not be changed.
+//	While there is no modification, it is an atomic read without locking.
+func (s *SyncIntSet) Snapshot() []int {
+	if snap, _ := s.snap.Load().([]int); snap != nil {
+		return snap
+	}
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	var snap []int
+	if s.set != nil {
+		snap = s.set.Slice()
+	}
+	if snap == nil {
+		snap = []int{}
+	}
+	s.snap.Store(snap)
+	return snap
+}
+
+// Add adds the elements that are not yet in the set, and returns the new length of the set.
+func (s *SyncIntSet) Add(elements ...int) int {
+	s.mu.Lock()
+	defer s.unlock()
+	if s.set == nil {
+		s.set = NewIntSet()
+	}
+	return s.set.Add(elements...)
+}
+
+// Remove removes the elements from the set, and returns the new length of the set.
+func (s *SyncIntSet) Remove(elements ...int) int {
+	s.mu.Lock()
+	defer s.unlock()
+	if s.set == nil {
+		return 0
+	}
+	return s.set.Remove(elements...)
+}
+
+// Has reports whether the element is in the set.
+func (s *SyncIntSet) Has(element int) bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.set != nil && s.set.Has(element)
+}
+
+// Len returns the number of elements in the set.
+func (s *SyncIntSet) Len() int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	if s.set == nil {
+		return 0
+	}
+	return s.set.Len()
+}
+
+// Clear removes all elements.
+func (s *SyncIntSet) Clear() {
+	s.mu.Lock()
+	defer s.unlock()
+	s.set = nil
+}
+
+// Range calls fn for each element of a snapshot in insertion order, until fn returns false.
+func (s *SyncIntSet) Range(fn func(v int) bool) {
+	for _, v := range s.Snapshot() {
+		if !fn(v) {
+			return
+		}
+	}
+}
+
+// Slice returns a copy of the elements in insertion order.
+func (s *SyncIntSet) Slice() []int {
+	return IntsCopy(s.Snapshot())
+}
+
+// SortedSlice returns a copy of the elements in ascending order.
+func (s *SyncIntSet) SortedSlice() []int {
+	return NewIntSet(s.Snapshot()...).SortedSlice()
+}
+
+// Clone returns an IntSet with the current elements.
+func (s *SyncIntSet) Clone() *IntSet {
+	return NewIntSet(s.Snapshot()...)
+}
+
+// unlock invalidates the snapshot and releases the write lock.
+func (s *SyncIntSet) unlock() {
+	s.snap.Store([]int(nil))
+	s.mu.Unlock()
+}
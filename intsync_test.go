@@ -0,0 +1,95 @@
+package ameda
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSyncInts(t *testing.T) {
+	var s SyncInts
+	assert.Equal(t, 0, s.Len())
+	assert.Equal(t, 3, s.Push(1, 2, 3))
+	assert.Equal(t, 4, s.Unshift(0))
+	assert.Equal(t, 4, s.PushDistinct(1, 3))
+	snap := s.Snapshot()
+	assert.Equal(t, []int{0, 1, 2, 3}, snap)
+	assert.True(t, s.Set(-1, 30))
+	assert.False(t, s.Set(4, 40))
+	assert.Equal(t, []int{0, 1, 2, 3}, snap)
+	assert.Equal(t, []int{0, 1, 2, 30}, s.Slice())
+	v, ok := s.Pop()
+	assert.True(t, ok)
+	assert.Equal(t, 30, v)
+	v, _ = s.Shift()
+	assert.Equal(t, 0, v)
+	assert.Equal(t, 1, s.IndexOf(2))
+	assert.True(t, s.Includes(1))
+	s.Range(func(k int, v int) bool {
+		s.Push(v) // callbacks run on a snapshot
+		return true
+	})
+	assert.Equal(t, []int{1, 2, 1, 2}, s.Slice())
+	assert.Equal(t, map[int]int{1: 2, 2: 2}, s.Distinct())
+	assert.Equal(t, []int{1, 2}, s.Snapshot())
+	s.Update(func(p *[]int) { *p = append(*p, 5) })
+	assert.Equal(t, 8, s.Reduce(func(_ []int, _ int, v, acc int) int { return acc + v }))
+	s.Clear()
+	assert.Equal(t, []int{}, s.Snapshot())
+}
+
+func TestSyncIntsRace(t *testing.T) {
+	s := NewSyncInts()
+	set := NewSyncIntSet()
+	var wg sync.WaitGroup
+	for g := 0; g < 8; g++ {
+		wg.Add(2)
+		go func(g int) {
+			defer wg.Done()
+			for k := 0; k < 100; k++ {
+				s.Push(g*100 + k)
+				set.Add(k)
+				if k%10 == 0 {
+					s.Shift()
+					set.Remove(k)
+				}
+			}
+		}(g)
+		go func() {
+			defer wg.Done()
+			for k := 0; k < 100; k++ {
+				snap := s.Snapshot()
+				want := IntsCopy(snap)
+				// each writer pushes increasing values, so a consistent view keeps them in order
+				last := make(map[int]int)
+				s.Range(func(_ int, v int) bool {
+					if prev, ok := last[v/100]; ok {
+						assert.Less(t, prev, v)
+					}
+					last[v/100] = v
+					return true
+				})
+				s.At(-1)
+				set.Has(k)
+				set.Range(func(int) bool { return true })
+				// a snapshot is not changed by the later writes
+				assert.Equal(t, want, snap)
+			}
+		}()
+	}
+	wg.Wait()
+	assert.Equal(t, 8*90, s.Len())
+	assert.Equal(t, 90, set.Len())
+	assert.Equal(t, 90, set.Clone().Len())
+}
+
+func TestSyncIntSet(t *testing.T) {
+	var set SyncIntSet
+	assert.Equal(t, 0, set.Remove(1))
+	assert.Equal(t, 2, set.Add(1, 2))
+	assert.Equal(t, 3, set.Add(2, 3))
+	assert.Equal(t, 3, set.Remove(9))
+	assert.Equal(t, 1, set.Remove(1, 3))
+	assert.Equal(t, []int{2}, set.Slice())
+}
@@ -3,28 +3,32 @@ package ameda
 import "math/bits"
 
 // FormatUint returns the string representation of i in the given base,
-// for 2 <= base <= 62.
+// for 2 <= base <= 95.
 // NOTE:
 //
 //	Compatible with standard package strconv.
+//	The digits of base > 62 are in StdDigitTable.
 func FormatUint(i uint64, base int) string {
 	if fastSmalls && i < nSmalls && base == 10 {
 		return small(int(i))
 	}
-	_, s := formatBits(nil, i, base, false, false)
+	_, s := formatBits(nil, i, base, false, false, digits95)
 	return s
 }
 
 // FormatInt returns the string representation of i in the given base,
-// for 2 <= base <= 62.
+// for 2 <= base <= 95.
 // NOTE:
 //
 //	Compatible with standard package strconv.
+//	The digits of base > 62 are in StdDigitTable, so it panics on negative i for base >= 63, where '-' is a digit;
+//	use a DigitTable without '-' for negative values of those bases.
 func FormatInt(i int64, base int) string {
 	if fastSmalls && 0 <= i && i < nSmalls && base == 10 {
 		return small(int(i))
 	}
-	_, s := formatBits(nil, uint64(i), base, i < 0, false)
+	StdDigitTable.checkSign(i, base)
+	_, s := formatBits(nil, uint64(i), base, i < 0, false, digits95)
 	return s
 }
 
@@ -45,7 +49,8 @@ func AppendInt(dst []byte, i int64, base int) []byte {
 	if fastSmalls && 0 <= i && i < nSmalls && base == 10 {
 		return append(dst, small(int(i))...)
 	}
-	dst, _ = formatBits(dst, uint64(i), base, i < 0, true)
+	StdDigitTable.checkSign(i, base)
+	dst, _ = formatBits(dst, uint64(i), base, i < 0, true, digits95)
 	return dst
 }
 
@@ -58,7 +63,7 @@ func AppendUint(dst []byte, i uint64, base int) []byte {
 	if fastSmalls && i < nSmalls && base == 10 {
 		return append(dst, small(int(i))...)
 	}
-	dst, _ = formatBits(dst, i, base, false, true)
+	dst, _ = formatBits(dst, i, base, false, true, digits95)
 	return dst
 }
 
@@ -89,16 +94,16 @@ func small(i int) string {
 	return smallsString[i*2 : i*2+2]
 }
 
-// formatBits computes the string representation of u in the given base.
+// formatBits computes the string representation of u in the given base with the digit table.
 // If neg is set, u is treated as negative int64 value. If append_ is
 // set, the string is appended to dst and the resulting byte slice is
 // returned as the first result value; otherwise the string is returned
 // as the second result value.
-func formatBits(dst []byte, u uint64, base int, neg, append_ bool) (d []byte, s string) {
-	if base < 2 || base > len(digits) {
+func formatBits(dst []byte, u uint64, base int, neg, append_ bool, table string) (d []byte, s string) {
+	if base < 2 || base > len(table) {
 		panic("ameda(strconv): illegal AppendInt/FormatInt base")
 	}
-	// 2 <= base && base <= len(digits)
+	// 2 <= base && base <= len(table)
 
 	var a [64 + 1]byte // +1 for sign of 64bit value in base 2
 	i := len(a)
@@ -161,9 +166,9 @@ func formatBits(dst []byte, u uint64, base int, neg, append_ bool) (d []byte, s
 
 	} else if isPowerOfTwo(base) {
 		// Use shifts and masks instead of / and %.
-		// Base is a power of 2 and 2 <= base <= len(digits) where len(digits) is 62.
-		// The largest power of 2 below or equal to 62 is 32, which is 1 << 5;
-		// i.e., the largest possible shift count is 5. By &-ind that value with
+		// Base is a power of 2 and 2 <= base <= len(table) where len(table) is at most 95.
+		// The largest power of 2 below or equal to 95 is 64, which is 1 << 6;
+		// i.e., the largest possible shift count is 6. By &-ind that value with
 		// the constant 7 we tell the compiler that the shift count is always
 		// less than 8 which is smaller than any register width. This allows
 		// the compiler to generate better code for the shift operation.
@@ -172,12 +177,12 @@ func formatBits(dst []byte, u uint64, base int, neg, append_ bool) (d []byte, s
 		m := uint(base) - 1 // == 1<<shift - 1
 		for u >= b {
 			i--
-			a[i] = digits[uint(u)&m]
+			a[i] = table[uint(u)&m]
 			u >>= shift
 		}
 		// u < base
 		i--
-		a[i] = digits[uint(u)]
+		a[i] = table[uint(u)]
 	} else {
 		// general case
 		b := uint64(base)
@@ -187,12 +192,12 @@ func formatBits(dst []byte, u uint64, base int, neg, append_ bool) (d []byte, s
 			// since 64bit division and modulo operations
 			// are calculated by runtime functions on 32bit machines.
 			q := u / b
-			a[i] = digits[uint(u-q*b)]
+			a[i] = table[uint(u-q*b)]
 			u = q
 		}
 		// u < base
 		i--
-		a[i] = digits[uint(u)]
+		a[i] = table[uint(u)]
 	}
 
 	// add sign, if any
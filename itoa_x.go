@@ -1,21 +1,75 @@
 package ameda
 
+import "unicode/utf8"
+
 // FormatUintByDict convert num into corresponding string according to dict.
+// NOTE:
+//
+//	It returns "" if the dict has less than 2 characters;
+//	see Codec for a validated dict that can be reused.
 func FormatUintByDict(dict []byte, num uint64) string {
 	var base = uint64(len(dict))
-	if base == 0 {
+	if base < 2 {
 		return ""
 	}
-	var str []byte
-	for {
-		tmp := make([]byte, len(str)+1)
-		tmp[0] = dict[num%base]
-		copy(tmp[1:], str)
-		str = tmp
-		num = num / base
-		if num == 0 {
-			break
-		}
+	return string(appendUintByDict(nil, dict, num))
+}
+
+// appendUintByDict appends the digits of num in the dict, that must contain at least 2 characters.
+func appendUintByDict(dst []byte, dict []byte, num uint64) []byte {
+	base := uint64(len(dict))
+	var a [64]byte
+	i := len(a)
+	for num >= base {
+		q := num / base
+		i--
+		a[i] = dict[num-q*base]
+		num = q
+	}
+	i--
+	a[i] = dict[num]
+	return append(dst, a[i:]...)
+}
+
+// FormatUintByRunes convert num into corresponding string according to the rune dict,
+// e.g. a dict of emoji or CJK characters.
+// NOTE:
+//
+//	It returns "" if the dict has less than 2 runes;
+//	see RuneCodec for a validated dict that can be reused.
+func FormatUintByRunes(dict []rune, num uint64) string {
+	var base = uint64(len(dict))
+	if base < 2 {
+		return ""
+	}
+	return string(appendUintByRunes(nil, dict, num))
+}
+
+// appendUintByRunes appends the UTF-8 encoded digits of num in the dict, that must contain at least 2 runes.
+func appendUintByRunes(dst []byte, dict []rune, num uint64) []byte {
+	base := uint64(len(dict))
+	var a [64]rune
+	i := len(a)
+	for num >= base {
+		q := num / base
+		i--
+		a[i] = dict[num-q*base]
+		num = q
+	}
+	i--
+	a[i] = dict[num]
+	for _, r := range a[i:] {
+		dst = appendRune(dst, r)
+	}
+	return dst
+}
+
+// appendRune appends the UTF-8 encoding of r to dst and returns the extended buffer.
+func appendRune(dst []byte, r rune) []byte {
+	if r >= 0 && r < utf8.RuneSelf {
+		return append(dst, byte(r))
 	}
-	return string(str)
+	var a [utf8.UTFMax]byte
+	n := utf8.EncodeRune(a[:], r)
+	return append(dst, a[:n]...)
 }
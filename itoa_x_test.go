@@ -1,6 +1,10 @@
 package ameda
 
 import (
+	"errors"
+	"fmt"
+	"math"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -16,3 +20,26 @@ func TestFormatUintByDict(t *testing.T) {
 		assert.Equal(t, i, i2)
 	}
 }
+
+func TestFormatUintByRunes(t *testing.T) {
+	dict := []rune("零一二三四五六七八九😀")
+	assert.Equal(t, "", FormatUintByRunes(dict[:1], 1))
+	assert.Equal(t, "一零", FormatUintByRunes(dict, 11))
+	assert.Equal(t, "😀", FormatUintByRunes(dict, 10))
+	for i := uint64(0); i < 1000; i++ {
+		numStr := FormatUintByRunes(dict, i)
+		i2, err := ParseUintByRunes(dict, numStr)
+		assert.NoError(t, err)
+		assert.Equal(t, i, i2)
+	}
+	_, err := ParseUintByRunes(nil, "零")
+	assert.Error(t, err)
+	_, err = ParseUintByRunes(dict, "一x")
+	assert.EqualError(t, err, `found a char not included in the dict: "x"`)
+	_, err = ParseUintByRunes(dict, "一\xe4\xb8")
+	assert.True(t, errors.Is(err, ErrInvalidUTF8))
+	assert.EqualError(t, err, `invalid UTF-8 at byte 3 of "一\xe4\xb8"`)
+	u, err := ParseUintByRunes(dict, strings.Repeat("😀", 20))
+	assert.EqualError(t, err, fmt.Sprintf("value out of range: %q", strings.Repeat("😀", 20)))
+	assert.Equal(t, uint64(math.MaxUint64), u)
+}
@@ -0,0 +1,38 @@
+package ameda
+
+// NaNPolicy defines how NaN values are regarded when comparing floats.
+type NaNPolicy int
+
+const (
+	// NaNNotEqual regards NaN as not equal to any value, including NaN (IEEE 754).
+	NaNNotEqual NaNPolicy = iota
+	// NaNEqual regards NaN as equal to NaN, and not equal to other values.
+	NaNEqual
+)
+
+// nanMatch reports whether a and b are both NaN and the policy regards them as equal.
+// It is only called when at least one of a and b is NaN.
+func (p NaNPolicy) nanMatch(aIsNaN, bIsNaN bool) bool {
+	return p == NaNEqual && aIsNaN && bIsNaN
+}
+
+// SignedZeroPolicy defines how +0 and -0 are regarded when comparing floats.
+type SignedZeroPolicy int
+
+const (
+	// SignedZeroEqual regards +0 and -0 as equal (IEEE 754).
+	SignedZeroEqual SignedZeroPolicy = iota
+	// SignedZeroDistinct regards +0 and -0 as different values, and orders -0 before +0.
+	SignedZeroDistinct
+)
+
+// FloatPolicy is the option of the float slice operations with the With suffix,
+// e.g. Float64sIndexOfWith, Float64sDistinctWith and Float64SetUnionWith.
+// NOTE:
+//
+//	The zero value is the IEEE 754 semantics of ==, that is the same as the operations without the With suffix:
+//	NaN is not equal to anything, and +0 is equal to -0.
+type FloatPolicy struct {
+	NaN        NaNPolicy
+	SignedZero SignedZeroPolicy
+}
@@ -0,0 +1,123 @@
+package ameda
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// NaturalOption is an option of the natural order comparison.
+type NaturalOption uint8
+
+const (
+	// NaturalIgnoreCase compares letters case-insensitively, e.g. "a" and "A" are equal.
+	NaturalIgnoreCase NaturalOption = 1 << iota
+	// NaturalCollate orders letters case-insensitively first and then breaks ties by code point,
+	// e.g. "a" < "B" < "b", independent of the locale. It is ignored if NaturalIgnoreCase is set.
+	NaturalCollate
+)
+
+func mergeNaturalOptions(options []NaturalOption) NaturalOption {
+	var o NaturalOption
+	for _, v := range options {
+		o |= v
+	}
+	return o
+}
+
+// NaturalCompare compares two strings in natural order, and returns an integer comparing them,
+// the result will be 0 if a == b, -1 if a < b, and +1 if a > b.
+// NOTE:
+//
+//	The runs of ASCII digits are compared by their numeric values, e.g. "item2" < "item10";
+//	if the values are equal, fewer leading zeros sort first, e.g. "a1" < "a01" < "a2".
+//	Other characters are compared by Unicode code points, or folded by the options.
+func NaturalCompare(a, b string, options ...NaturalOption) int {
+	opt := mergeNaturalOptions(options)
+	fold := opt&(NaturalIgnoreCase|NaturalCollate) != 0
+	tie := 0 // the first secondary difference
+	for len(a) > 0 && len(b) > 0 {
+		if isASCIIDigit(a[0]) && isASCIIDigit(b[0]) {
+			var na, nb string
+			var za, zb int
+			na, za, a = cutDigits(a)
+			nb, zb, b = cutDigits(b)
+			if len(na) != len(nb) {
+				return compareInt(len(na), len(nb))
+			}
+			if c := strings.Compare(na, nb); c != 0 {
+				return c
+			}
+			if tie == 0 {
+				tie = compareInt(za, zb)
+			}
+			continue
+		}
+		ra, sa := utf8.DecodeRuneInString(a)
+		rb, sb := utf8.DecodeRuneInString(b)
+		a, b = a[sa:], b[sb:]
+		if ra == rb {
+			continue
+		}
+		if fold {
+			if c := compareInt(int(foldRune(ra)), int(foldRune(rb))); c != 0 {
+				return c
+			}
+			if tie == 0 && opt&NaturalIgnoreCase == 0 {
+				tie = compareInt(int(ra), int(rb))
+			}
+			continue
+		}
+		return compareInt(int(ra), int(rb))
+	}
+	if len(a) != len(b) {
+		return compareInt(len(a), len(b))
+	}
+	return tie
+}
+
+// NaturalLess reports whether a sorts before b in natural order, see NaturalCompare.
+func NaturalLess(a, b string, options ...NaturalOption) bool {
+	return NaturalCompare(a, b, options...) < 0
+}
+
+// naturalKey returns the key that is equal for the strings that are equal in natural order.
+func naturalKey(s string, opt NaturalOption) string {
+	if opt&NaturalIgnoreCase == 0 {
+		return s
+	}
+	return strings.Map(foldRune, s)
+}
+
+// cutDigits cuts the leading digits of s, returns the digits without leading zeros,
+// the number of leading zeros and the rest of s.
+func cutDigits(s string) (digits string, zeros int, rest string) {
+	i := 0
+	for i < len(s) && s[i] == '0' {
+		i++
+	}
+	zeros = i
+	for i < len(s) && isASCIIDigit(s[i]) {
+		i++
+	}
+	return s[zeros:i], zeros, s[i:]
+}
+
+func isASCIIDigit(c byte) bool {
+	return '0' <= c && c <= '9'
+}
+
+func foldRune(r rune) rune {
+	return unicode.ToLower(unicode.ToUpper(r))
+}
+
+func compareInt(a, b int) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	default:
+		return 0
+	}
+}
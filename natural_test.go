@@ -0,0 +1,52 @@
+package ameda
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNaturalCompare(t *testing.T) {
+	cases := []struct {
+		a, b     string
+		options  []NaturalOption
+		expected int
+	}{
+		{"item2", "item10", nil, -1},
+		{"item10", "item2", nil, 1},
+		{"a1", "a01", nil, -1},
+		{"a01", "a2", nil, -1},
+		{"a001b", "a01c", nil, -1},
+		{"v1.10.0", "v1.9.3", nil, 1},
+		{"x", "x0", nil, -1},
+		{"", "", nil, 0},
+		{"B", "a", nil, -1},
+		{"B", "a", []NaturalOption{NaturalCollate}, 1},
+		{"b", "B", []NaturalOption{NaturalCollate}, 1},
+		{"File2", "file10", []NaturalOption{NaturalIgnoreCase}, -1},
+		{"ÉTÉ", "été", []NaturalOption{NaturalIgnoreCase}, 0},
+		{"été", "étéa", []NaturalOption{NaturalIgnoreCase}, -1},
+		{"写真2", "写真10", nil, -1},
+	}
+	for _, c := range cases {
+		assert.Equal(t, c.expected, NaturalCompare(c.a, c.b, c.options...), c)
+	}
+}
+
+func TestStringsNaturalSort(t *testing.T) {
+	slice := []string{"img12.png", "img10.png", "IMG2.png", "img1.png", "img02.png"}
+	StringsNaturalSort(slice)
+	assert.Equal(t, []string{"IMG2.png", "img1.png", "img02.png", "img10.png", "img12.png"}, slice)
+	StringsNaturalSort(slice, NaturalCollate)
+	assert.Equal(t, []string{"img1.png", "IMG2.png", "img02.png", "img10.png", "img12.png"}, slice)
+	assert.True(t, StringsNaturalIsSorted(slice, NaturalCollate))
+	assert.False(t, StringsNaturalIsSorted(slice))
+	assert.Equal(t, 3, StringsNaturalSearch(slice, "img3.png", NaturalCollate))
+	assert.Equal(t, 5, StringsNaturalSearch(slice, "img30.png", NaturalCollate))
+
+	slice = []string{"a", "A", "b", "a"}
+	assert.Equal(t, map[string]int{"a": 3, "b": 1}, StringsNaturalDistinct(&slice, false, NaturalIgnoreCase))
+	assert.Equal(t, []string{"a", "A", "b", "a"}, slice)
+	assert.Equal(t, map[string]int{"a": 2, "A": 1, "b": 1}, StringsNaturalDistinct(&slice, true))
+	assert.Equal(t, []string{"a", "A", "b"}, slice)
+}
@@ -0,0 +1,94 @@
+package ameda
+
+import (
+	"errors"
+	"math"
+	"math/rand"
+)
+
+// Randomizer is the source of random numbers used by the shuffle and sampling functions.
+// NOTE:
+//
+//	*rand.Rand implements it, e.g. rand.New(rand.NewSource(seed)) makes the results reproducible.
+//	If nil is passed, the global source of package math/rand is used.
+type Randomizer interface {
+	// Intn returns a non-negative pseudo-random number in [0,n). It panics if n <= 0.
+	Intn(n int) int
+	// Float64 returns a pseudo-random number in [0.0,1.0).
+	Float64() float64
+}
+
+type globalRandomizer struct{}
+
+func (globalRandomizer) Intn(n int) int {
+	return rand.Intn(n)
+}
+
+func (globalRandomizer) Float64() float64 {
+	return rand.Float64()
+}
+
+func getRandomizer(r Randomizer) Randomizer {
+	if r == nil {
+		return globalRandomizer{}
+	}
+	return r
+}
+
+// shuffleIndex shuffles the first k positions of n elements with the Fisher-Yates algorithm.
+func shuffleIndex(n, k int, r Randomizer, swap func(i, j int)) {
+	r = getRandomizer(r)
+	if k > n-1 {
+		k = n - 1
+	}
+	for i := 0; i < k; i++ {
+		swap(i, i+r.Intn(n-i))
+	}
+}
+
+// weightedIndex returns the index chosen with the probability proportional to its weight.
+func weightedIndex(n int, weights []float64, r Randomizer) (int, error) {
+	if n == 0 {
+		return -1, errors.New("empty slice")
+	}
+	if len(weights) != n {
+		return -1, errors.New("the number of weights does not match the length of the slice")
+	}
+	var total float64
+	for _, w := range weights {
+		if w < 0 || math.IsNaN(w) || math.IsInf(w, 0) {
+			return -1, errors.New("weights must be finite and non-negative")
+		}
+		total += w
+	}
+	if total <= 0 {
+		return -1, errors.New("the sum of weights must be positive")
+	}
+	x := getRandomizer(r).Float64() * total
+	last := -1
+	for k, w := range weights {
+		if w == 0 {
+			continue
+		}
+		last = k
+		if x < w {
+			return k, nil
+		}
+		x -= w
+	}
+	// float rounding
+	return last, nil
+}
+
+// reservoirIndex is the Algorithm R of reservoir sampling, returns the slot in the reservoir
+// to be replaced by the element seen at index seen (zero-based), or -1 if it is skipped.
+func reservoirIndex(seen, k int, r Randomizer) int {
+	if seen < k {
+		return seen
+	}
+	j := r.Intn(seen + 1)
+	if j < k {
+		return j
+	}
+	return -1
+}
@@ -0,0 +1,73 @@
+package ameda
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIntsShuffle(t *testing.T) {
+	a := []int{1, 2, 3, 4, 5, 6, 7, 8}
+	b := IntsCopy(a)
+	IntsShuffle(a, rand.New(rand.NewSource(7)))
+	IntsShuffle(b, rand.New(rand.NewSource(7)))
+	assert.Equal(t, a, b)
+	IntsShuffle(b, nil)
+	assert.ElementsMatch(t, []int{1, 2, 3, 4, 5, 6, 7, 8}, b)
+}
+
+func TestIntsSample(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	a := []int{1, 2, 3, 4, 5, 6, 7, 8}
+	s := IntsSample(a, 3, r)
+	assert.Len(t, s, 3)
+	m := IntsDistinct(&s, false)
+	assert.Len(t, m, 3)
+	for v := range m {
+		assert.True(t, IntsIncludes(a, v))
+	}
+	assert.ElementsMatch(t, a, IntsSample(a, 20, r))
+	assert.Equal(t, []int{}, IntsSample(a, 0, r))
+	assert.Equal(t, []int{1, 2, 3, 4, 5, 6, 7, 8}, a)
+
+	s = IntsSampleWithReplacement([]int{9}, 4, r)
+	assert.Equal(t, []int{9, 9, 9, 9}, s)
+	assert.Equal(t, []int{}, IntsSampleWithReplacement(nil, 4, r))
+}
+
+func TestStringsWeightedChoice(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	a := []string{"a", "b", "c"}
+	counts := map[string]int{}
+	for n := 0; n < 1000; n++ {
+		k, v, err := StringsWeightedChoice(a, []float64{1, 0, 3}, r)
+		assert.NoError(t, err)
+		assert.Equal(t, a[k], v)
+		counts[v]++
+	}
+	assert.Equal(t, 0, counts["b"])
+	assert.InDelta(t, 750, counts["c"], 60)
+
+	_, _, err := StringsWeightedChoice(a, []float64{1, 2}, r)
+	assert.Error(t, err)
+	_, _, err = StringsWeightedChoice(a, []float64{0, 0, 0}, r)
+	assert.Error(t, err)
+	k, _, err := StringsWeightedChoice(nil, nil, r)
+	assert.Error(t, err)
+	assert.Equal(t, -1, k)
+}
+
+func TestIntsReservoirSample(t *testing.T) {
+	stream := func(n int) func() (int, bool) {
+		i := 0
+		return func() (int, bool) {
+			i++
+			return i, i <= n
+		}
+	}
+	assert.Equal(t, []int{1, 2, 3}, IntsReservoirSample(stream(3), 5, nil))
+	s := IntsReservoirSample(stream(100), 5, rand.New(rand.NewSource(1)))
+	assert.Len(t, s, 5)
+	assert.Equal(t, s, IntsReservoirSample(stream(100), 5, rand.New(rand.NewSource(1))))
+}
@@ -0,0 +1,161 @@
+package ameda
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"unicode/utf8"
+)
+
+// RuneCodec is like Codec but the digits are runes, e.g. emoji or CJK characters,
+// so the strings are UTF-8 encoded and a digit may take up to 4 bytes.
+// NOTE:
+//
+//	It is safe for concurrent use.
+type RuneCodec struct {
+	dict  []rune
+	base  uint64
+	ascii [utf8.RuneSelf]int16 // ASCII character -> digit, -1 if not in the dict
+	index map[rune]int         // non-ASCII rune -> digit
+}
+
+// NewRuneCodec creates a RuneCodec with the dict, that must be valid UTF-8 of at least 2 unique runes.
+func NewRuneCodec(dict string) (*RuneCodec, error) {
+	if !utf8.ValidString(dict) {
+		return nil, fmt.Errorf("dict is %w", ErrInvalidUTF8)
+	}
+	return NewRuneCodecFromRunes([]rune(dict))
+}
+
+// NewRuneCodecFromRunes creates a RuneCodec with the dict, that must contain at least 2 unique valid runes.
+func NewRuneCodecFromRunes(dict []rune) (*RuneCodec, error) {
+	if len(dict) < 2 {
+		return nil, fmt.Errorf("dict must contain at least 2 runes, got %d", len(dict))
+	}
+	c := &RuneCodec{dict: append([]rune(nil), dict...), base: uint64(len(dict)), index: make(map[rune]int)}
+	for k := range c.ascii {
+		c.ascii[k] = -1
+	}
+	for k, r := range dict {
+		if !utf8.ValidRune(r) {
+			return nil, fmt.Errorf("invalid rune %U in dict", r)
+		}
+		if c.digit(r) >= 0 {
+			return nil, fmt.Errorf("duplicate rune %q in dict", r)
+		}
+		if r < utf8.RuneSelf {
+			c.ascii[r] = int16(k)
+		} else {
+			c.index[r] = k
+		}
+	}
+	return c, nil
+}
+
+// MustNewRuneCodec is like NewRuneCodec but panics if the dict is invalid.
+func MustNewRuneCodec(dict string) *RuneCodec {
+	c, err := NewRuneCodec(dict)
+	if err != nil {
+		panic(err)
+	}
+	return c
+}
+
+// Base returns the number of the digits.
+func (c *RuneCodec) Base() int {
+	return len(c.dict)
+}
+
+// Dict returns a copy of the dict.
+func (c *RuneCodec) Dict() []rune {
+	return append([]rune(nil), c.dict...)
+}
+
+// Digit returns the digit of the rune r, or -1 if r is not in the dict.
+func (c *RuneCodec) Digit(r rune) int {
+	return c.digit(r)
+}
+
+func (c *RuneCodec) digit(r rune) int {
+	if r >= 0 && r < utf8.RuneSelf {
+		return int(c.ascii[r])
+	}
+	if d, ok := c.index[r]; ok {
+		return d
+	}
+	return -1
+}
+
+// FormatUint returns the string representation of u.
+func (c *RuneCodec) FormatUint(u uint64) string {
+	return string(c.AppendUint(nil, u))
+}
+
+// AppendUint appends the string form of u, as generated by FormatUint, to dst and returns the extended buffer.
+func (c *RuneCodec) AppendUint(dst []byte, u uint64) []byte {
+	return appendUintByRunes(dst, c.dict, u)
+}
+
+// FormatInt returns the ZigZag encoded string representation of i, like Codec.FormatInt.
+func (c *RuneCodec) FormatInt(i int64) string {
+	return string(c.AppendInt(nil, i))
+}
+
+// AppendInt appends the string form of i, as generated by FormatInt, to dst and returns the extended buffer.
+func (c *RuneCodec) AppendInt(dst []byte, i int64) []byte {
+	return c.AppendUint(dst, zigzagEncode(i))
+}
+
+// ParseUint interprets a string s, as generated by FormatUint, and returns the corresponding value.
+// NOTE:
+//
+//	The errors have concrete type *strconv.NumError.
+//	If s is not valid UTF-8, err.Err = ErrInvalidUTF8 and the returned value is 0;
+//	the other errors are the same as Codec.ParseUint.
+func (c *RuneCodec) ParseUint(s string) (uint64, error) {
+	return c.parseUint("ParseUint", s)
+}
+
+// ParseInt interprets a string s, as generated by FormatInt, and returns the corresponding value.
+// NOTE:
+//
+//	The errors are the same as ParseUint.
+func (c *RuneCodec) ParseInt(s string) (int64, error) {
+	u, err := c.parseUint("ParseInt", s)
+	if err != nil {
+		return 0, err
+	}
+	return zigzagDecode(u), nil
+}
+
+func (c *RuneCodec) parseUint(fn, s string) (uint64, error) {
+	if s == "" {
+		return 0, syntaxError(fn, s)
+	}
+	cutoff := math.MaxUint64 / c.base
+	var n uint64
+	for k := 0; k < len(s); {
+		r, size := rune(s[k]), 1
+		if r >= utf8.RuneSelf {
+			r, size = utf8.DecodeRuneInString(s[k:])
+			if r == utf8.RuneError && size == 1 {
+				return 0, &strconv.NumError{Func: fn, Num: s, Err: ErrInvalidUTF8}
+			}
+		}
+		k += size
+		d := c.digit(r)
+		if d < 0 {
+			return 0, syntaxError(fn, s)
+		}
+		if n > cutoff {
+			return math.MaxUint64, rangeError(fn, s)
+		}
+		n *= c.base
+		n1 := n + uint64(d)
+		if n1 < n {
+			return math.MaxUint64, rangeError(fn, s)
+		}
+		n = n1
+	}
+	return n, nil
+}
@@ -0,0 +1,67 @@
+package ameda
+
+import (
+	"errors"
+	"math"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRuneCodec(t *testing.T) {
+	_, err := NewRuneCodec("a")
+	assert.EqualError(t, err, "dict must contain at least 2 runes, got 1")
+	_, err = NewRuneCodec("a😀b😀")
+	assert.EqualError(t, err, `duplicate rune '😀' in dict`)
+	_, err = NewRuneCodec("ab\xff")
+	assert.EqualError(t, err, "dict is invalid UTF-8")
+	assert.True(t, errors.Is(err, ErrInvalidUTF8))
+	_, err = NewRuneCodecFromRunes([]rune{'a', 0xD800})
+	assert.EqualError(t, err, "invalid rune U+D800 in dict")
+	assert.Panics(t, func() { MustNewRuneCodec("") })
+
+	c := MustNewRuneCodec("0123456789αβγδ😀🚀")
+	assert.Equal(t, 16, c.Base())
+	assert.Equal(t, 15, c.Digit('🚀'))
+	assert.Equal(t, 3, c.Digit('3'))
+	assert.Equal(t, -1, c.Digit('x'))
+	assert.Equal(t, []rune("0123456789αβγδ😀🚀"), c.Dict())
+	assert.Equal(t, "🚀🚀", c.FormatUint(255))
+	assert.Equal(t, "x:1α", string(c.AppendUint([]byte("x:"), 26)))
+	assert.Equal(t, "1", c.FormatInt(-1))
+	for _, u := range []uint64{0, 1, 15, 16, 1 << 53, math.MaxUint64} {
+		s := c.FormatUint(u)
+		assert.Equal(t, FormatUint(u, 16), hexRunes.Replace(s))
+		u2, err := c.ParseUint(s)
+		assert.NoError(t, err)
+		assert.Equal(t, u, u2)
+	}
+	for _, i := range []int64{0, -1, 1, math.MinInt64, math.MaxInt64} {
+		i2, err := c.ParseInt(c.FormatInt(i))
+		assert.NoError(t, err)
+		assert.Equal(t, i, i2)
+	}
+
+	_, err = c.ParseUint("")
+	assert.Equal(t, strconv.ErrSyntax, err.(*strconv.NumError).Err)
+	_, err = c.ParseUint("1x")
+	assert.Equal(t, strconv.ErrSyntax, err.(*strconv.NumError).Err)
+	_, err = c.ParseUint("1\xf0\x9f\x98")
+	assert.Equal(t, ErrInvalidUTF8, err.(*strconv.NumError).Err)
+	assert.EqualError(t, err, `strconv.ParseUint: parsing "1\xf0\x9f\x98": invalid UTF-8`)
+	_, err = c.ParseInt("\xff")
+	assert.Equal(t, "ParseInt", err.(*strconv.NumError).Func)
+	u, err := c.ParseUint(c.FormatUint(math.MaxUint64) + "0")
+	assert.Equal(t, strconv.ErrRange, err.(*strconv.NumError).Err)
+	assert.Equal(t, uint64(math.MaxUint64), u)
+	// U+FFFD is a valid digit, unlike an invalid byte
+	c = MustNewRuneCodec("0�")
+	u, err = c.ParseUint("�0")
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(2), u)
+}
+
+// hexRunes maps the non-ASCII digits of the test codec to hex letters.
+var hexRunes = strings.NewReplacer("α", "a", "β", "b", "γ", "c", "δ", "d", "😀", "e", "🚀", "f")
@@ -0,0 +1,162 @@
+package ameda
+
+// LevenshteinDistance returns the minimum number of single-character insertions, deletions
+// and substitutions required to change a into b.
+// NOTE:
+//
+//	The strings are compared by Unicode code points.
+func LevenshteinDistance(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+	if len(ra) < len(rb) {
+		ra, rb = rb, ra
+	}
+	// keep only one row of the matrix, with the shorter length
+	row := make([]int, len(rb)+1)
+	for j := range row {
+		row[j] = j
+	}
+	for i := 1; i <= len(ra); i++ {
+		prev := row[0]
+		row[0] = i
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			cur := minInt(row[j]+1, row[j-1]+1, prev+cost)
+			prev = row[j]
+			row[j] = cur
+		}
+	}
+	return row[len(rb)]
+}
+
+// DamerauLevenshteinDistance is like LevenshteinDistance,
+// but also counts the transposition of two characters as a single edit.
+// NOTE:
+//
+//	It is the unrestricted distance, so the substrings can be edited more than once,
+//	e.g. the distance between "CA" and "ABC" is 2.
+//	The strings are compared by Unicode code points.
+func DamerauLevenshteinDistance(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+	n, m := len(ra), len(rb)
+	inf := n + m
+	// d is the (n+2)*(m+2) matrix, d[i+1][j+1] is the distance between ra[:i] and rb[:j]
+	d := make([][]int, n+2)
+	for i := range d {
+		d[i] = make([]int, m+2)
+	}
+	d[0][0] = inf
+	for i := 0; i <= n; i++ {
+		d[i+1][0] = inf
+		d[i+1][1] = i
+	}
+	for j := 0; j <= m; j++ {
+		d[0][j+1] = inf
+		d[1][j+1] = j
+	}
+	lastRow := make(map[rune]int) // the last row where the character appears in a
+	for i := 1; i <= n; i++ {
+		lastCol := 0 // the last column where ra[i-1] matches in b
+		for j := 1; j <= m; j++ {
+			i1 := lastRow[rb[j-1]]
+			j1 := lastCol
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+				lastCol = j
+			}
+			d[i+1][j+1] = minInt(
+				d[i][j]+cost,
+				d[i+1][j]+1,
+				d[i][j+1]+1,
+				d[i1][j1]+(i-i1-1)+1+(j-j1-1),
+			)
+		}
+		lastRow[ra[i-1]] = i
+	}
+	return d[n+1][m+1]
+}
+
+// JaroSimilarity returns the Jaro similarity between a and b, from 0 (no similarity) to 1 (exact match).
+// NOTE:
+//
+//	The strings are compared by Unicode code points.
+func JaroSimilarity(a, b string) float64 {
+	ra, rb := []rune(a), []rune(b)
+	if len(ra) == 0 && len(rb) == 0 {
+		return 1
+	}
+	if len(ra) == 0 || len(rb) == 0 {
+		return 0
+	}
+	window := maxInt(len(ra), len(rb))/2 - 1
+	if window < 0 {
+		window = 0
+	}
+	matchedA := make([]bool, len(ra))
+	matchedB := make([]bool, len(rb))
+	var matches int
+	for i, c := range ra {
+		lo := maxInt(0, i-window)
+		hi := minInt(len(rb), i+window+1)
+		for j := lo; j < hi; j++ {
+			if !matchedB[j] && rb[j] == c {
+				matchedA[i], matchedB[j] = true, true
+				matches++
+				break
+			}
+		}
+	}
+	if matches == 0 {
+		return 0
+	}
+	var transpositions int
+	j := 0
+	for i, c := range ra {
+		if !matchedA[i] {
+			continue
+		}
+		for !matchedB[j] {
+			j++
+		}
+		if c != rb[j] {
+			transpositions++
+		}
+		j++
+	}
+	m := float64(matches)
+	return (m/float64(len(ra)) + m/float64(len(rb)) + (m-float64(transpositions)/2)/m) / 3
+}
+
+// JaroWinklerSimilarity is like JaroSimilarity,
+// but gives more favorable ratings to strings that match from the beginning (up to 4 characters).
+func JaroWinklerSimilarity(a, b string) float64 {
+	sim := JaroSimilarity(a, b)
+	var prefix int
+	for ra, rb := []rune(a), []rune(b); prefix < 4 && prefix < len(ra) && prefix < len(rb); prefix++ {
+		if ra[prefix] != rb[prefix] {
+			break
+		}
+	}
+	return sim + float64(prefix)*0.1*(1-sim)
+}
+
+func minInt(a int, others ...int) int {
+	for _, v := range others {
+		if v < a {
+			a = v
+		}
+	}
+	return a
+}
+
+func maxInt(a int, others ...int) int {
+	for _, v := range others {
+		if v > a {
+			a = v
+		}
+	}
+	return a
+}
@@ -0,0 +1,33 @@
+package ameda
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLevenshteinDistance(t *testing.T) {
+	assert.Equal(t, 3, LevenshteinDistance("kitten", "sitting"))
+	assert.Equal(t, 3, LevenshteinDistance("", "abc"))
+	assert.Equal(t, 0, LevenshteinDistance("日本", "日本"))
+	assert.Equal(t, 1, LevenshteinDistance("日本", "本"))
+	assert.Equal(t, 2, LevenshteinDistance("ab", "ba"))
+}
+
+func TestDamerauLevenshteinDistance(t *testing.T) {
+	assert.Equal(t, 1, DamerauLevenshteinDistance("ab", "ba"))
+	assert.Equal(t, 2, DamerauLevenshteinDistance("CA", "ABC"))
+	assert.Equal(t, 3, DamerauLevenshteinDistance("kitten", "sitting"))
+	assert.Equal(t, 0, DamerauLevenshteinDistance("", ""))
+	assert.Equal(t, 2, DamerauLevenshteinDistance("", "ab"))
+}
+
+func TestJaroWinklerSimilarity(t *testing.T) {
+	assert.InDelta(t, 0.944, JaroSimilarity("MARTHA", "MARHTA"), 0.001)
+	assert.InDelta(t, 0.961, JaroWinklerSimilarity("MARTHA", "MARHTA"), 0.001)
+	assert.InDelta(t, 0.767, JaroSimilarity("DIXON", "DICKSONX"), 0.001)
+	assert.InDelta(t, 0.813, JaroWinklerSimilarity("DIXON", "DICKSONX"), 0.001)
+	assert.Equal(t, 1.0, JaroWinklerSimilarity("", ""))
+	assert.Equal(t, 0.0, JaroWinklerSimilarity("abc", ""))
+	assert.Equal(t, 0.0, JaroWinklerSimilarity("abc", "xyz"))
+}
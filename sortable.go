@@ -0,0 +1,272 @@
+package ameda
+
+import (
+	"errors"
+	"math"
+)
+
+// The ASCII-ordered alphabets of the sortable encodings.
+const (
+	SortableBase62Alphabet = "0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz"
+	SortableHexAlphabet    = "0123456789abcdef"
+)
+
+var errUnsortedAlphabet = errors.New("alphabet must be in ascending byte order")
+
+// SortableEncoding encodes integers and floats into fixed-width strings,
+// whose lexicographic (byte-wise) order is the same as the numeric order, e.g. for the keys of ordered KV stores.
+// NOTE:
+//
+//	Int64 values are offset by 2^63, so negative numbers sort before positive ones.
+//	Float64 values are sorted as -NaN < -Inf < ... < -0 < +0 < ... < +Inf < +NaN.
+//	It is safe for concurrent use.
+type SortableEncoding struct {
+	codec *Codec
+	width int
+}
+
+var (
+	// SortableBase62 is the sortable encoding of 11 characters in 0-9A-Za-z.
+	SortableBase62 = newSortableEncoding(SortableBase62Alphabet)
+	// SortableBase32 is the sortable encoding of 13 characters in Crockford's base32 alphabet.
+	SortableBase32 = newSortableEncoding(Crockford32Alphabet)
+	// SortableHex is the sortable encoding of 16 characters in lower-case hex.
+	SortableHex = newSortableEncoding(SortableHexAlphabet)
+)
+
+// NewSortableEncoding creates a SortableEncoding with the alphabet,
+// that must contain 2 to 256 unique characters in ascending byte order.
+func NewSortableEncoding(alphabet string) (*SortableEncoding, error) {
+	codec, err := NewCodec([]byte(alphabet))
+	if err != nil {
+		return nil, err
+	}
+	for k := 1; k < len(alphabet); k++ {
+		if alphabet[k-1] >= alphabet[k] {
+			return nil, errUnsortedAlphabet
+		}
+	}
+	e := &SortableEncoding{codec: codec}
+	for u := uint64(math.MaxUint64); u > 0; u /= codec.base {
+		e.width++
+	}
+	return e, nil
+}
+
+func newSortableEncoding(alphabet string) *SortableEncoding {
+	e, err := NewSortableEncoding(alphabet)
+	if err != nil {
+		panic(err)
+	}
+	return e
+}
+
+// Width returns the length of the encoded strings.
+func (e *SortableEncoding) Width() int {
+	return e.width
+}
+
+// EncodeUint64 returns the fixed-width string of u.
+func (e *SortableEncoding) EncodeUint64(u uint64) string {
+	return string(e.AppendUint64(nil, u))
+}
+
+// AppendUint64 appends the fixed-width string of u to dst and returns the extended buffer.
+func (e *SortableEncoding) AppendUint64(dst []byte, u uint64) []byte {
+	start := len(dst)
+	dst = e.codec.AppendUint(dst, u)
+	pad := e.width - (len(dst) - start)
+	if pad == 0 {
+		return dst
+	}
+	for k := 0; k < pad; k++ {
+		dst = append(dst, 0)
+	}
+	copy(dst[start+pad:], dst[start:len(dst)-pad])
+	for k := start; k < start+pad; k++ {
+		dst[k] = e.codec.dict[0]
+	}
+	return dst
+}
+
+// DecodeUint64 returns the value of the fixed-width string s.
+// NOTE:
+//
+//	The errors have concrete type *strconv.NumError.
+func (e *SortableEncoding) DecodeUint64(s string) (uint64, error) {
+	return e.decode("DecodeUint64", s)
+}
+
+// EncodeInt64 returns the fixed-width string of i.
+func (e *SortableEncoding) EncodeInt64(i int64) string {
+	return string(e.AppendInt64(nil, i))
+}
+
+// AppendInt64 appends the fixed-width string of i to dst and returns the extended buffer.
+func (e *SortableEncoding) AppendInt64(dst []byte, i int64) []byte {
+	return e.AppendUint64(dst, uint64(i)^1<<63)
+}
+
+// DecodeInt64 returns the value of the fixed-width string s.
+func (e *SortableEncoding) DecodeInt64(s string) (int64, error) {
+	u, err := e.decode("DecodeInt64", s)
+	if err != nil {
+		return 0, err
+	}
+	return int64(u ^ 1<<63), nil
+}
+
+// EncodeFloat64 returns the fixed-width string of f.
+func (e *SortableEncoding) EncodeFloat64(f float64) string {
+	return string(e.AppendFloat64(nil, f))
+}
+
+// AppendFloat64 appends the fixed-width string of f to dst and returns the extended buffer.
+func (e *SortableEncoding) AppendFloat64(dst []byte, f float64) []byte {
+	return e.AppendUint64(dst, sortableFloat64Bits(f))
+}
+
+// DecodeFloat64 returns the value of the fixed-width string s.
+func (e *SortableEncoding) DecodeFloat64(s string) (float64, error) {
+	u, err := e.decode("DecodeFloat64", s)
+	if err != nil {
+		return 0, err
+	}
+	if u&(1<<63) != 0 {
+		return math.Float64frombits(u &^ (1 << 63)), nil
+	}
+	return math.Float64frombits(^u), nil
+}
+
+func (e *SortableEncoding) decode(fn, s string) (uint64, error) {
+	if len(s) != e.width {
+		return 0, syntaxError(fn, s)
+	}
+	return e.codec.parseUint(fn, s)
+}
+
+// sortableFloat64Bits maps f to an unsigned integer in the same order.
+func sortableFloat64Bits(f float64) uint64 {
+	u := math.Float64bits(f)
+	if u&(1<<63) != 0 {
+		return ^u
+	}
+	return u | 1<<63
+}
+
+// AppendSortableUvarint appends the order-preserving variable-length bytes of u to dst and returns the extended buffer.
+// NOTE:
+//
+//	The byte-wise order of the results is the same as the numeric order, and no result is a prefix of another.
+//	It takes 1 byte for u <= 240, 2 bytes for u <= 2287, 3 bytes for u <= 67823, and up to 9 bytes (SQLite4 varint).
+func AppendSortableUvarint(dst []byte, u uint64) []byte {
+	switch {
+	case u <= 240:
+		return append(dst, byte(u))
+	case u <= 2287:
+		u -= 240
+		return append(dst, byte(u>>8+241), byte(u))
+	case u <= 67823:
+		u -= 2288
+		return append(dst, 249, byte(u>>8), byte(u))
+	}
+	n := 3
+	for n < 8 && u>>(uint(n)*8) != 0 {
+		n++
+	}
+	dst = append(dst, byte(247+n))
+	for k := n - 1; k >= 0; k-- {
+		dst = append(dst, byte(u>>(uint(k)*8)))
+	}
+	return dst
+}
+
+// SortableUvarint decodes an uint64 from b, as generated by AppendSortableUvarint,
+// and returns that value and the number of bytes read (> 0).
+// If an error occurred, the value is 0 and the number of bytes n is <= 0 meaning:
+//
+//	n == 0: buf too small
+//	n  < 0: non-canonical encoding
+func SortableUvarint(b []byte) (u uint64, n int) {
+	return sortableUvarint(b, 0)
+}
+
+// AppendSortableVarint appends the order-preserving variable-length bytes of i to dst and returns the extended buffer.
+// NOTE:
+//
+//	It takes a leading sign byte to sort negative numbers first, and the bytes of i (or -i-1 if negative) like AppendSortableUvarint.
+func AppendSortableVarint(dst []byte, i int64) []byte {
+	if i >= 0 {
+		return AppendSortableUvarint(append(dst, 1), uint64(i))
+	}
+	start := len(dst)
+	dst = AppendSortableUvarint(append(dst, 0), uint64(^i))
+	for k := start + 1; k < len(dst); k++ {
+		dst[k] = ^dst[k]
+	}
+	return dst
+}
+
+// SortableVarint decodes an int64 from b, as generated by AppendSortableVarint,
+// and returns that value and the number of bytes read (> 0).
+// The errors are the same as SortableUvarint, and n < 0 also if the sign byte is invalid.
+func SortableVarint(b []byte) (i int64, n int) {
+	if len(b) == 0 {
+		return 0, 0
+	}
+	var u uint64
+	switch b[0] {
+	case 1:
+		u, n = sortableUvarint(b[1:], 0)
+		if n > 0 && u > math.MaxInt64 {
+			return 0, -1
+		}
+		i = int64(u)
+	case 0:
+		u, n = sortableUvarint(b[1:], 0xff)
+		if n > 0 && u > math.MaxInt64 {
+			return 0, -1
+		}
+		i = ^int64(u)
+	default:
+		return 0, -1
+	}
+	if n <= 0 {
+		return 0, n
+	}
+	return i, n + 1
+}
+
+// sortableUvarint decodes the bytes, each of which is XORed with mask.
+func sortableUvarint(b []byte, mask byte) (uint64, int) {
+	if len(b) == 0 {
+		return 0, 0
+	}
+	a0 := b[0] ^ mask
+	switch {
+	case a0 <= 240:
+		return uint64(a0), 1
+	case a0 <= 248:
+		if len(b) < 2 {
+			return 0, 0
+		}
+		return 240 + uint64(a0-241)<<8 + uint64(b[1]^mask), 2
+	case a0 == 249:
+		if len(b) < 3 {
+			return 0, 0
+		}
+		return 2288 + uint64(b[1]^mask)<<8 + uint64(b[2]^mask), 3
+	}
+	n := int(a0) - 247
+	if len(b) < n+1 {
+		return 0, 0
+	}
+	var u uint64
+	for _, c := range b[1 : n+1] {
+		u = u<<8 | uint64(c^mask)
+	}
+	if u <= 67823 || n > 3 && u>>(uint(n-1)*8) == 0 {
+		return 0, -1
+	}
+	return u, n + 1
+}
@@ -0,0 +1,111 @@
+package ameda
+
+import (
+	"bytes"
+	"math"
+	"math/rand"
+	"strconv"
+	"strings"
+	"testing"
+	"testing/quick"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSortableEncoding(t *testing.T) {
+	assert.Equal(t, 11, SortableBase62.Width())
+	assert.Equal(t, 13, SortableBase32.Width())
+	assert.Equal(t, 16, SortableHex.Width())
+	assert.Equal(t, "0000000000000001", SortableHex.EncodeUint64(1))
+	assert.Equal(t, "8000000000000000", SortableHex.EncodeInt64(0))
+	assert.Equal(t, "7fffffffffffffff", SortableHex.EncodeInt64(-1))
+	assert.Equal(t, "x:0000000000z", string(SortableBase62.AppendUint64([]byte("x:"), 61)))
+	_, err := NewSortableEncoding("0a9")
+	assert.Error(t, err)
+
+	encodings := []*SortableEncoding{SortableBase62, SortableBase32, SortableHex}
+	for _, e := range encodings {
+		assert.NoError(t, quick.Check(func(a, b uint64) bool {
+			x, y := e.EncodeUint64(a), e.EncodeUint64(b)
+			a2, err := e.DecodeUint64(x)
+			return len(x) == e.Width() && err == nil && a2 == a &&
+				strings.Compare(x, y) == compareUint64(a, b)
+		}, nil))
+		assert.NoError(t, quick.Check(func(a, b int64) bool {
+			x, y := e.EncodeInt64(a), e.EncodeInt64(b)
+			a2, err := e.DecodeInt64(x)
+			return err == nil && a2 == a && strings.Compare(x, y) == compareInt64(a, b)
+		}, nil))
+		assert.NoError(t, quick.Check(func(a, b float64) bool {
+			x, y := e.EncodeFloat64(a), e.EncodeFloat64(b)
+			a2, err := e.DecodeFloat64(x)
+			return err == nil && a2 == a && strings.Compare(x, y) == compareFloat64(a, b)
+		}, nil))
+		for _, u := range []uint64{0, math.MaxUint64} {
+			u2, err := e.DecodeUint64(e.EncodeUint64(u))
+			assert.NoError(t, err)
+			assert.Equal(t, u, u2)
+		}
+		_, err := e.DecodeUint64("0")
+		assert.Equal(t, strconv.ErrSyntax, err.(*strconv.NumError).Err)
+	}
+	_, err = SortableBase62.DecodeUint64("zzzzzzzzzzz")
+	assert.Equal(t, strconv.ErrRange, err.(*strconv.NumError).Err)
+
+	floats := []float64{math.Inf(-1), -math.MaxFloat64, -1, -math.SmallestNonzeroFloat64, math.Copysign(0, -1), 0,
+		math.SmallestNonzeroFloat64, 1, math.MaxFloat64, math.Inf(1)}
+	for k := 1; k < len(floats); k++ {
+		assert.True(t, SortableBase62.EncodeFloat64(floats[k-1]) < SortableBase62.EncodeFloat64(floats[k]))
+	}
+	f, err := SortableHex.DecodeFloat64(SortableHex.EncodeFloat64(math.Copysign(0, -1)))
+	assert.NoError(t, err)
+	assert.True(t, math.Signbit(f))
+	f, _ = SortableHex.DecodeFloat64(SortableHex.EncodeFloat64(math.NaN()))
+	assert.True(t, math.IsNaN(f))
+}
+
+func TestSortableVarint(t *testing.T) {
+	for _, c := range []struct {
+		u uint64
+		n int
+	}{{0, 1}, {240, 1}, {241, 2}, {2287, 2}, {2288, 3}, {67823, 3}, {67824, 4}, {1<<24 - 1, 4}, {1 << 24, 5}, {math.MaxUint64, 9}} {
+		b := AppendSortableUvarint(nil, c.u)
+		assert.Equal(t, c.n, len(b), c.u)
+		u, n := SortableUvarint(b)
+		assert.Equal(t, c.u, u)
+		assert.Equal(t, c.n, n)
+		_, n = SortableUvarint(b[:len(b)-1])
+		assert.Equal(t, 0, n)
+	}
+	_, n := SortableUvarint([]byte{250, 0, 0, 1})
+	assert.Equal(t, -1, n)
+	_, n = SortableUvarint([]byte{251, 0, 1, 2, 3})
+	assert.Equal(t, -1, n)
+	_, n = SortableVarint([]byte{2, 0})
+	assert.Equal(t, -1, n)
+
+	// values near the length boundaries are more likely to break the order
+	r := rand.New(rand.NewSource(1))
+	gen := func() uint64 {
+		if r.Intn(2) == 0 {
+			return uint64(r.Int63n(70000))
+		}
+		return r.Uint64() >> uint(r.Intn(64))
+	}
+	for k := 0; k < 10000; k++ {
+		a, b := gen(), gen()
+		x, y := AppendSortableUvarint(nil, a), AppendSortableUvarint(nil, b)
+		assert.Equal(t, compareUint64(a, b), bytes.Compare(x, y), "%d %d", a, b)
+		i, j := int64(a), -int64(b)
+		x, y = AppendSortableVarint(nil, i), AppendSortableVarint(nil, j)
+		assert.Equal(t, compareInt64(i, j), bytes.Compare(x, y), "%d %d", i, j)
+		i2, n := SortableVarint(append(y, 0xff))
+		assert.Equal(t, j, i2)
+		assert.Equal(t, len(y), n)
+	}
+	assert.NoError(t, quick.Check(func(a, b int64) bool {
+		x, y := AppendSortableVarint(nil, a), AppendSortableVarint(nil, b)
+		a2, n := SortableVarint(x)
+		return a2 == a && n == len(x) && bytes.Compare(x, y) == compareInt64(a, b)
+	}, nil))
+}
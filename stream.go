@@ -0,0 +1,252 @@
+package ameda
+
+import (
+	"errors"
+	"io"
+	"math"
+	"math/bits"
+	"strconv"
+)
+
+// CorruptInputError is returned by the stream decoder if the input is invalid,
+// and the value is the offset of the invalid input character.
+type CorruptInputError int64
+
+func (e CorruptInputError) Error() string {
+	return "illegal base-N data at input byte " + strconv.FormatInt(int64(e), 10)
+}
+
+// streamBlock is the block size of the stream encoding:
+// every blockBytes bytes are converted to blockDigits digits.
+type streamBlock struct {
+	codec       *Codec
+	blockBytes  int
+	blockDigits int
+}
+
+// newStreamBlock chooses the block size of at most 8 bytes with the least overhead.
+func newStreamBlock(c *Codec) streamBlock {
+	b := streamBlock{codec: c, blockBytes: 1, blockDigits: radixWidth(1, c.Base())}
+	for k := 2; k <= 8; k++ {
+		m := radixWidth(k, c.Base())
+		if k*b.blockDigits > b.blockBytes*m {
+			b.blockBytes, b.blockDigits = k, m
+		}
+	}
+	return b
+}
+
+// encode appends the digits of the block src, whose length is at most blockBytes.
+func (b streamBlock) encode(dst []byte, src []byte) []byte {
+	var v uint64
+	for _, c := range src {
+		v = v<<8 | uint64(c)
+	}
+	m := radixWidth(len(src), b.codec.Base())
+	start := len(dst)
+	for k := 0; k < m; k++ {
+		dst = append(dst, 0)
+	}
+	for k := start + m - 1; k >= start; k-- {
+		dst[k] = b.codec.dict[v%b.codec.base]
+		v /= b.codec.base
+	}
+	return dst
+}
+
+// decode appends the bytes of the digit values src, and reports false if src is invalid.
+func (b streamBlock) decode(dst []byte, src []byte) ([]byte, bool) {
+	n := len(src)
+	if n < b.blockDigits {
+		// the length of the final partial block must be the width of some bytes
+		n = 0
+		for r := 1; r < b.blockBytes; r++ {
+			if radixWidth(r, b.codec.Base()) == len(src) {
+				n = r
+				break
+			}
+		}
+		if n == 0 {
+			return dst, false
+		}
+	} else {
+		n = b.blockBytes
+	}
+	var v uint64
+	for _, d := range src {
+		hi, lo := bits.Mul64(v, b.codec.base)
+		v = lo + uint64(d)
+		if hi != 0 || v < lo {
+			return dst, false
+		}
+	}
+	if n < 8 && v>>(uint(n)*8) != 0 {
+		return dst, false
+	}
+	for k := n - 1; k >= 0; k-- {
+		dst = append(dst, byte(v>>(uint(k)*8)))
+	}
+	return dst, true
+}
+
+var errClosedStream = errors.New("write to closed stream encoder")
+
+type streamEncoder struct {
+	streamBlock
+	w   io.Writer
+	buf []byte // the pending bytes of a partial block
+	out []byte
+	err error
+}
+
+// NewStreamEncoder returns a new base-N stream encoder with the digits of the codec.
+// Data written to the returned writer will be encoded and then written to w.
+// NOTE:
+//
+//	The input is encoded in blocks of up to 8 bytes, e.g. 8 bytes to 11 digits for base 62,
+//	so it is not the same as FormatBytes, and it is decoded by NewStreamDecoder.
+//	The caller must Close the returned encoder to flush any partially written blocks.
+func NewStreamEncoder(c *Codec, w io.Writer) io.WriteCloser {
+	b := newStreamBlock(c)
+	return &streamEncoder{
+		streamBlock: b,
+		w:           w,
+		buf:         make([]byte, 0, b.blockBytes),
+		out:         make([]byte, 0, 1024/b.blockBytes*b.blockDigits),
+	}
+}
+
+func (e *streamEncoder) Write(p []byte) (n int, err error) {
+	if e.err != nil {
+		return 0, e.err
+	}
+	for len(p) > 0 {
+		if len(e.buf) > 0 || len(p) < e.blockBytes {
+			k := copy(e.buf[len(e.buf):e.blockBytes], p)
+			e.buf = e.buf[:len(e.buf)+k]
+			n += k
+			p = p[k:]
+			if len(e.buf) < e.blockBytes {
+				return n, nil
+			}
+			e.out = e.encode(e.out, e.buf)
+			e.buf = e.buf[:0]
+		}
+		for len(p) >= e.blockBytes && len(e.out)+e.blockDigits <= cap(e.out) {
+			e.out = e.encode(e.out, p[:e.blockBytes])
+			n += e.blockBytes
+			p = p[e.blockBytes:]
+		}
+		if e.err = e.flush(); e.err != nil {
+			return n, e.err
+		}
+	}
+	return n, nil
+}
+
+// Close flushes any pending output from the encoder.
+// It is an error to call Write after calling Close.
+func (e *streamEncoder) Close() error {
+	if e.err == nil {
+		if len(e.buf) > 0 {
+			e.out = e.encode(e.out, e.buf)
+			e.buf = e.buf[:0]
+		}
+		if e.err = e.flush(); e.err == nil {
+			e.err = errClosedStream
+		}
+	}
+	if e.err == errClosedStream {
+		return nil
+	}
+	return e.err
+}
+
+func (e *streamEncoder) flush() error {
+	if len(e.out) == 0 {
+		return nil
+	}
+	_, err := e.w.Write(e.out)
+	e.out = e.out[:0]
+	return err
+}
+
+type streamDecoder struct {
+	streamBlock
+	r      io.Reader
+	in     []byte // the raw input
+	digits []byte // the digit values of a partial block
+	out    []byte // the decoded bytes not returned yet
+	outBuf []byte
+	offset int64 // the offset of in[0] in the input
+	err    error
+}
+
+// NewStreamDecoder constructs a new base-N stream decoder with the digits of the codec,
+// that decodes the data written by NewStreamEncoder.
+// NOTE:
+//
+//	'\r' and '\n' are ignored if they are not digits, and so are the characters ignored by the codec,
+//	e.g. hyphens of Crockford32Codec.
+//	An invalid input is reported as CorruptInputError.
+func NewStreamDecoder(c *Codec, r io.Reader) io.Reader {
+	b := newStreamBlock(c)
+	return &streamDecoder{
+		streamBlock: b,
+		r:           r,
+		in:          make([]byte, 1024/b.blockDigits*b.blockDigits),
+		digits:      make([]byte, 0, b.blockDigits),
+	}
+}
+
+func (d *streamDecoder) Read(p []byte) (n int, err error) {
+	for len(d.out) == 0 && d.err == nil {
+		d.out = d.outBuf[:0]
+		var nr int
+		nr, d.err = d.r.Read(d.in)
+		for k, c := range d.in[:nr] {
+			v := d.codec.index[c]
+			if v < 0 {
+				if v == -2 || c == '\r' || c == '\n' {
+					continue
+				}
+				d.err = CorruptInputError(d.offset + int64(k))
+				break
+			}
+			d.digits = append(d.digits, byte(v))
+			if len(d.digits) == d.blockDigits {
+				var ok bool
+				if d.out, ok = d.decode(d.out, d.digits); !ok {
+					d.err = CorruptInputError(d.offset + int64(k))
+					break
+				}
+				d.digits = d.digits[:0]
+			}
+		}
+		d.offset += int64(nr)
+		if d.err == io.EOF && len(d.digits) > 0 {
+			var ok bool
+			if d.out, ok = d.decode(d.out, d.digits); !ok {
+				d.err = CorruptInputError(d.offset - 1)
+			}
+			d.digits = d.digits[:0]
+		}
+		d.outBuf = d.out[:0]
+	}
+	n = copy(p, d.out)
+	d.out = d.out[n:]
+	if len(d.out) > 0 {
+		return n, nil
+	}
+	return n, d.err
+}
+
+// radixWidth returns the minimum number of digits in the base that can represent every n-byte number.
+func radixWidth(n int, base int) int {
+	if isPowerOfTwo(base) {
+		shift := bits.TrailingZeros(uint(base))
+		return (n*8 + shift - 1) / shift
+	}
+	// base^w == 256^n is impossible if the base is not a power of 2.
+	return int(math.Ceil(float64(n*8) / math.Log2(float64(base))))
+}
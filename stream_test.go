@@ -0,0 +1,97 @@
+package ameda
+
+import (
+	"bytes"
+	"io"
+	"io/ioutil"
+	"math/rand"
+	"strings"
+	"testing"
+	"testing/iotest"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStreamBlock(t *testing.T) {
+	for _, c := range []struct {
+		codec         *Codec
+		bytes, digits int
+	}{
+		{Base62Codec, 8, 11},
+		{Base58BitcoinCodec, 8, 11},
+		{Base36Codec, 7, 11},
+		{Crockford32Codec, 5, 8},
+		{Base64URLCodec, 3, 4},
+		{MustNewCodec([]byte("01")), 1, 8},
+	} {
+		b := newStreamBlock(c.codec)
+		assert.Equal(t, c.bytes, b.blockBytes, c.codec.Base())
+		assert.Equal(t, c.digits, b.blockDigits, c.codec.Base())
+	}
+}
+
+func TestStream(t *testing.T) {
+	var out bytes.Buffer
+	enc := NewStreamEncoder(Base64URLCodec, &out)
+	_, err := enc.Write([]byte("hello"))
+	assert.NoError(t, err)
+	assert.NoError(t, enc.Close())
+	assert.Equal(t, "aGVsGxv", out.String()) // full blocks are the same as base64.RawURLEncoding
+	_, err = enc.Write([]byte("x"))
+	assert.Error(t, err)
+	assert.NoError(t, enc.Close())
+
+	r := rand.New(rand.NewSource(1))
+	for _, codec := range []*Codec{Base62Codec, Base58FlickrCodec, Base36Codec, Crockford32Codec, Base64URLCodec, MustNewCodec([]byte("abc"))} {
+		for _, size := range []int{0, 1, 7, 8, 9, 1000, 5003} {
+			data := make([]byte, size)
+			r.Read(data)
+			out.Reset()
+			enc := NewStreamEncoder(codec, &out)
+			// write in random pieces
+			for p := data; len(p) > 0; {
+				k := 1 + r.Intn(len(p))
+				n, err := enc.Write(p[:k])
+				assert.NoError(t, err)
+				assert.Equal(t, k, n)
+				p = p[k:]
+			}
+			assert.NoError(t, enc.Close())
+			encoded := out.String()
+			for _, ch := range []byte(encoded) {
+				assert.True(t, codec.Digit(ch) >= 0)
+			}
+
+			got, err := ioutil.ReadAll(NewStreamDecoder(codec, iotest.OneByteReader(strings.NewReader(encoded))))
+			assert.NoError(t, err)
+			assert.Equal(t, data, got, "base %d size %d", codec.Base(), size)
+			got, err = ioutil.ReadAll(NewStreamDecoder(codec, strings.NewReader(encoded)))
+			assert.NoError(t, err)
+			assert.Equal(t, len(data), len(got))
+		}
+	}
+}
+
+func TestStreamDecoderErrors(t *testing.T) {
+	got, err := ioutil.ReadAll(NewStreamDecoder(Base62Codec, strings.NewReader("00000000001\r\n0000000000-")))
+	assert.Equal(t, []byte{0, 0, 0, 0, 0, 0, 0, 1}, got)
+	assert.Equal(t, CorruptInputError(23), err)
+	assert.EqualError(t, err, "illegal base-N data at input byte 23")
+	// the value of a block overflows
+	_, err = ioutil.ReadAll(NewStreamDecoder(Base62Codec, strings.NewReader("zzzzzzzzzzz")))
+	assert.Equal(t, CorruptInputError(10), err)
+	// no bytes have the width of 1 digit
+	_, err = ioutil.ReadAll(NewStreamDecoder(Base62Codec, strings.NewReader("00000000000a")))
+	assert.Equal(t, CorruptInputError(11), err)
+	// crockford hyphens are ignored
+	var out bytes.Buffer
+	enc := NewStreamEncoder(Crockford32Codec, &out)
+	enc.Write([]byte("crockford"))
+	enc.Close()
+	s := strings.ToLower(out.String()[:4]) + "-" + out.String()[4:]
+	got, err = ioutil.ReadAll(NewStreamDecoder(Crockford32Codec, strings.NewReader(s)))
+	assert.NoError(t, err)
+	assert.Equal(t, "crockford", string(got))
+	_, err = io.Copy(ioutil.Discard, NewStreamDecoder(Base36Codec, strings.NewReader("zzzzzzzzzzzzz")))
+	assert.Error(t, err)
+}
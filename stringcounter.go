@@ -0,0 +1,164 @@
+package ameda
+
+import "sort"
+
+// StringCounter is a multiset that maps each string element to its count.
+// NOTE:
+//
+//	Only positive counts are kept.
+//	It has the same underlying type as the result of StringsDistinct and StringsIntersect,
+//	so they can be converted to each other directly.
+type StringCounter map[string]int
+
+// StringCounterItem is an element with its count.
+type StringCounterItem struct {
+	Element string
+	Count   int
+}
+
+// NewStringCounter creates a counter that counts the elements.
+func NewStringCounter(elements ...string) StringCounter {
+	c := make(StringCounter, len(elements))
+	c.Add(elements...)
+	return c
+}
+
+// StringsDistinctCounter is like StringsDistinct, but returns a counter.
+func StringsDistinctCounter(s *[]string, changeSlice bool) StringCounter {
+	return StringCounter(StringsDistinct(s, changeSlice))
+}
+
+// StringsIntersectCounter is like StringsIntersect, but returns a counter, which is empty but not nil if there is no common element.
+func StringsIntersectCounter(s ...[]string) StringCounter {
+	if c := StringsIntersect(s...); c != nil {
+		return StringCounter(c)
+	}
+	return make(StringCounter)
+}
+
+// Add increases the count of each element by 1.
+func (c StringCounter) Add(elements ...string) {
+	for _, v := range elements {
+		c[v]++
+	}
+}
+
+// AddN increases the count of the element by n, and returns the new count.
+// NOTE:
+//
+//	If the count is no longer positive, the element is removed.
+func (c StringCounter) AddN(element string, n int) int {
+	n += c[element]
+	if n <= 0 {
+		delete(c, element)
+		return 0
+	}
+	c[element] = n
+	return n
+}
+
+// Subtract decreases the count of each element by 1.
+// NOTE:
+//
+//	If the count is no longer positive, the element is removed.
+func (c StringCounter) Subtract(elements ...string) {
+	for _, v := range elements {
+		c.AddN(v, -1)
+	}
+}
+
+// Get returns the count of the element.
+func (c StringCounter) Get(element string) int {
+	return c[element]
+}
+
+// Total returns the sum of all counts.
+func (c StringCounter) Total() int {
+	var n int
+	for _, v := range c {
+		n += v
+	}
+	return n
+}
+
+// Clone creates a copy of the counter.
+func (c StringCounter) Clone() StringCounter {
+	r := make(StringCounter, len(c))
+	for k, v := range c {
+		r[k] = v
+	}
+	return r
+}
+
+// Union returns a new counter with the maximum count of each element: c ∪ others...
+func (c StringCounter) Union(others ...StringCounter) StringCounter {
+	r := c.Clone()
+	for _, o := range others {
+		for k, v := range o {
+			if v > r[k] {
+				r[k] = v
+			}
+		}
+	}
+	return r
+}
+
+// Intersect returns a new counter with the minimum count of each element: c ∩ others...
+func (c StringCounter) Intersect(others ...StringCounter) StringCounter {
+	r := make(StringCounter, len(c))
+L:
+	for k, v := range c {
+		for _, o := range others {
+			v2 := o[k]
+			if v2 <= 0 {
+				continue L
+			}
+			if v > v2 {
+				v = v2
+			}
+		}
+		r[k] = v
+	}
+	return r
+}
+
+// MostCommon returns the k most common elements from the most to the least common,
+// elements with equal counts are in ascending order.
+// NOTE:
+//
+//	If k < 0, returns all elements.
+func (c StringCounter) MostCommon(k int) []StringCounterItem {
+	items := make([]StringCounterItem, 0, len(c))
+	for e, n := range c {
+		items = append(items, StringCounterItem{Element: e, Count: n})
+	}
+	sort.Slice(items, func(i, j int) bool {
+		if items[i].Count != items[j].Count {
+			return items[i].Count > items[j].Count
+		}
+		return items[i].Element < items[j].Element
+	})
+	if k >= 0 && k < len(items) {
+		items = items[:k]
+	}
+	return items
+}
+
+// SortedElements returns the distinct elements in ascending order.
+func (c StringCounter) SortedElements() []string {
+	r := make([]string, 0, len(c))
+	for k := range c {
+		r = append(r, k)
+	}
+	sort.Slice(r, func(i, j int) bool { return r[i] < r[j] })
+	return r
+}
+
+// RangeSorted calls fn for each element in ascending order; if fn returns false, range stops the iteration.
+func (c StringCounter) RangeSorted(fn func(element string, count int) bool) {
+	for _, k := range c.SortedElements() {
+		if !fn(k, c[k]) {
+			return
+		}
+	}
+}
@@ -0,0 +1,48 @@
+package ameda
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStringCounter(t *testing.T) {
+	c := NewStringCounter("a", "b", "a", "c", "a", "b")
+	assert.Equal(t, 3, c.Get("a"))
+	assert.Equal(t, 6, c.Total())
+	assert.Equal(t, []StringCounterItem{{"a", 3}, {"b", 2}}, c.MostCommon(2))
+	assert.Equal(t, 3, len(c.MostCommon(-1)))
+
+	c.Subtract("c", "b", "x")
+	assert.Equal(t, StringCounter{"a": 3, "b": 1}, c)
+	assert.Equal(t, 5, c.AddN("b", 4))
+	assert.Equal(t, 0, c.AddN("b", -5))
+	assert.Equal(t, []string{"a"}, c.SortedElements())
+
+	var keys []string
+	NewStringCounter("z", "x", "y").RangeSorted(func(element string, count int) bool {
+		keys = append(keys, element)
+		return element < "y"
+	})
+	assert.Equal(t, []string{"x", "y"}, keys)
+}
+
+func TestStringCounterAlgebra(t *testing.T) {
+	c1 := NewStringCounter("a", "a", "b", "c")
+	c2 := NewStringCounter("a", "b", "b", "d")
+	assert.Equal(t, StringCounter{"a": 2, "b": 2, "c": 1, "d": 1}, c1.Union(c2))
+	assert.Equal(t, StringCounter{"a": 1, "b": 1}, c1.Intersect(c2))
+	assert.Equal(t, StringCounter{"a": 2, "b": 1, "c": 1}, c1)
+
+	slice := []string{"a", "b", "a"}
+	assert.Equal(t, StringCounter{"a": 2, "b": 1}, StringsDistinctCounter(&slice, false))
+	assert.Equal(t, StringCounter{"a": 1}, StringsIntersectCounter(slice, []string{"a", "c"}))
+
+	c := StringsIntersectCounter(slice, nil)
+	assert.Equal(t, StringCounter{}, c)
+	c.Add("a")
+	assert.Equal(t, 1, c.Get("a"))
+	c = StringsIntersectCounter()
+	c.Add("a")
+	assert.Equal(t, 1, c.Get("a"))
+}
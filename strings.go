@@ -1,7 +1,11 @@
 package ameda
 
 import (
+	"fmt"
+	"sort"
 	"strings"
+	"unicode"
+	"unicode/utf8"
 )
 
 // OneString try to return the first element, otherwise return zero value.
@@ -482,26 +486,20 @@ func StringsSplice(s *[]string, start, deleteCount int, items ...string) {
 	if deleteCount < 0 {
 		deleteCount = 0
 	}
-	start, end, _ := fixRange(len(a), start, start+1+deleteCount)
-	deleteCount = end - start - 1
-	for i := 0; i < len(items); i++ {
-		if deleteCount > 0 {
-			// replace
-			a[start] = items[i]
-			deleteCount--
-			start++
-		} else {
-			// insert
-			lastSlice := StringsCopy(a[start:])
-			items = items[i:]
-			a = append(a[:start], items...)
-			a = append(a[:start+len(items)], lastSlice...)
-			*s = a[:len(a):len(a)]
-			return
-		}
+	start = fixIndex(len(a), start, true)
+	if rest := len(a) - start; deleteCount > rest {
+		deleteCount = rest
 	}
-	if deleteCount > 0 {
-		a = append(a[:start], a[start+1+deleteCount:]...)
+	// replace
+	n := copy(a[start:start+deleteCount], items)
+	if n == len(items) {
+		// delete the rest
+		a = append(a[:start+n], a[start+deleteCount:]...)
+	} else {
+		// insert the rest
+		lastSlice := StringsCopy(a[start+deleteCount:])
+		a = append(a[:start+deleteCount], items[n:]...)
+		a = append(a, lastSlice...)
 	}
 	*s = a[:len(a):len(a)]
 }
@@ -730,3 +728,877 @@ func StringSetDifference(set1, set2 []string, others ...[]string) []string {
 	}
 	return r
 }
+
+// StringsIsSorted reports whether the slice is sorted in ascending order.
+func StringsIsSorted(s []string) bool {
+	for k := 1; k < len(s); k++ {
+		if s[k] < s[k-1] {
+			return false
+		}
+	}
+	return true
+}
+
+// StringSortedSetUnion calculates between multiple sorted collections: set1 ∪ set2 ∪ others...
+// NOTE:
+//
+//	Every set must be sorted in ascending order (see StringsIsSorted), and the result is sorted too.
+//	It merges the sets in O(n+m) without building any map.
+//	This method does not change the existing slices, but instead returns a new slice.
+func StringSortedSetUnion(set1, set2 []string, others ...[]string) []string {
+	r := stringSortedSetUnion(set1, set2)
+	for _, set := range others {
+		r = stringSortedSetUnion(r, set)
+	}
+	return r
+}
+
+// StringSortedSetIntersect calculates between multiple sorted collections: set1 ∩ set2 ∩ others...
+// NOTE:
+//
+//	Every set must be sorted in ascending order (see StringsIsSorted), and the result is sorted too.
+//	It merges the sets in O(n+m) without building any map.
+//	This method does not change the existing slices, but instead returns a new slice.
+func StringSortedSetIntersect(set1, set2 []string, others ...[]string) []string {
+	r := stringSortedSetIntersect(set1, set2)
+	for _, set := range others {
+		r = stringSortedSetIntersect(r, set)
+	}
+	return r
+}
+
+// StringSortedSetDifference calculates between multiple sorted collections: set1 - set2 - others...
+// NOTE:
+//
+//	Every set must be sorted in ascending order (see StringsIsSorted), and the result is sorted too.
+//	It merges the sets in O(n+m) without building any map.
+//	This method does not change the existing slices, but instead returns a new slice.
+func StringSortedSetDifference(set1, set2 []string, others ...[]string) []string {
+	r := stringSortedSetDifference(set1, set2)
+	for _, set := range others {
+		r = stringSortedSetDifference(r, set)
+	}
+	return r
+}
+
+// StringSortedSetSymmetricDifference calculates between multiple sorted collections: set1 ∆ set2 ∆ others...
+// NOTE:
+//
+//	Every set must be sorted in ascending order (see StringsIsSorted), and the result is sorted too.
+//	It merges the sets in O(n+m) without building any map.
+//	This method does not change the existing slices, but instead returns a new slice.
+func StringSortedSetSymmetricDifference(set1, set2 []string, others ...[]string) []string {
+	r := stringSortedSetSymmetricDifference(set1, set2)
+	for _, set := range others {
+		r = stringSortedSetSymmetricDifference(r, set)
+	}
+	return r
+}
+
+// StringSortedSetIsSubset reports whether every element of sub is also in set: sub ⊆ set.
+// NOTE:
+//
+//	Both sets must be sorted in ascending order (see StringsIsSorted).
+func StringSortedSetIsSubset(sub, set []string) bool {
+	j := 0
+	for k := 0; k < len(sub); {
+		if j >= len(set) || sub[k] < set[j] {
+			return false
+		}
+		if set[j] < sub[k] {
+			j++
+			continue
+		}
+		k++
+	}
+	return true
+}
+
+func stringSortedSetUnion(a, b []string) []string {
+	r := make([]string, 0, len(a)+len(b))
+	i, j := 0, 0
+	for i < len(a) || j < len(b) {
+		var v string
+		switch {
+		case j >= len(b) || i < len(a) && a[i] < b[j]:
+			v = a[i]
+			i++
+		case i >= len(a) || b[j] < a[i]:
+			v = b[j]
+			j++
+		default:
+			v = a[i]
+			i++
+			j++
+		}
+		if n := len(r); n == 0 || r[n-1] != v {
+			r = append(r, v)
+		}
+	}
+	return r
+}
+
+func stringSortedSetIntersect(a, b []string) []string {
+	n := len(a)
+	if len(b) < n {
+		n = len(b)
+	}
+	r := make([]string, 0, n)
+	i, j := 0, 0
+	for i < len(a) && j < len(b) {
+		switch {
+		case a[i] < b[j]:
+			i++
+		case b[j] < a[i]:
+			j++
+		default:
+			v := a[i]
+			if n := len(r); n == 0 || r[n-1] != v {
+				r = append(r, v)
+			}
+			i++
+			j++
+		}
+	}
+	return r
+}
+
+func stringSortedSetDifference(a, b []string) []string {
+	r := make([]string, 0, len(a))
+	j := 0
+	for _, v := range a {
+		for j < len(b) && b[j] < v {
+			j++
+		}
+		if j < len(b) && b[j] == v {
+			continue
+		}
+		if n := len(r); n == 0 || r[n-1] != v {
+			r = append(r, v)
+		}
+	}
+	return r
+}
+
+func stringSortedSetSymmetricDifference(a, b []string) []string {
+	r := make([]string, 0, len(a)+len(b))
+	i, j := 0, 0
+	for i < len(a) || j < len(b) {
+		var v string
+		switch {
+		case j >= len(b) || i < len(a) && a[i] < b[j]:
+			v = a[i]
+			i++
+		case i >= len(a) || b[j] < a[i]:
+			v = b[j]
+			j++
+		default:
+			v = a[i]
+			for i < len(a) && a[i] == v {
+				i++
+			}
+			for j < len(b) && b[j] == v {
+				j++
+			}
+			continue
+		}
+		if n := len(r); n == 0 || r[n-1] != v {
+			r = append(r, v)
+		}
+	}
+	return r
+}
+
+// StringsEdit is an edit run of the edit script produced by StringsDiff.
+type StringsEdit struct {
+	Kind   DiffKind
+	Values []string
+}
+
+// StringsDiff computes the shortest edit script that turns slice a into slice b, see also DiffFunc.
+// This method does not change the existing slices, but instead returns the runs of edits.
+func StringsDiff(a, b []string) []StringsEdit {
+	spans := DiffFunc(len(a), len(b), func(i, j int) bool { return a[i] == b[j] })
+	r := make([]StringsEdit, len(spans))
+	for k, span := range spans {
+		r[k].Kind = span.Kind
+		if span.Kind == DiffInsert {
+			r[k].Values = StringsCopy(b[span.B : span.B+span.Len])
+		} else {
+			r[k].Values = StringsCopy(a[span.A : span.A+span.Len])
+		}
+	}
+	return r
+}
+
+// StringsApplyPatch changes the contents of an slice in place by applying the edit script produced by StringsDiff.
+// NOTE:
+//
+//	If the slice does not match the equal and delete runs of the patch, it returns an error
+//	and the slice will not be modified.
+func StringsApplyPatch(p *[]string, patch []StringsEdit) error {
+	a := *p
+	r := make([]string, 0, len(a))
+	pos := 0
+	for _, e := range patch {
+		switch e.Kind {
+		case DiffInsert:
+			r = append(r, e.Values...)
+		case DiffEqual, DiffDelete:
+			if pos+len(e.Values) > len(a) {
+				return fmt.Errorf("patch mismatch: %s run exceeds the slice length %d", e.Kind, len(a))
+			}
+			for k, v := range e.Values {
+				if a[pos+k] != v {
+					return fmt.Errorf("patch mismatch: %s run at index %d", e.Kind, pos+k)
+				}
+			}
+			if e.Kind == DiffEqual {
+				r = append(r, e.Values...)
+			}
+			pos += len(e.Values)
+		default:
+			return fmt.Errorf("patch mismatch: unknown edit kind %d", e.Kind)
+		}
+	}
+	if pos != len(a) {
+		return fmt.Errorf("patch mismatch: the slice has %d unpatched elements", len(a)-pos)
+	}
+	n := len(r)
+	*p = r[:n:n]
+	return nil
+}
+
+// StringsUnifiedDiff renders the difference between the lines a and b in the unified diff format.
+// @fromFile, @toFile
+//
+//	The file names in the header lines; if both are empty, the header lines are omitted.
+//
+// @context
+//
+//	The number of unchanged lines shown around each change, usually 3.
+//
+// NOTE:
+//
+//	The lines should not contain the line break.
+//	If a and b are equal, it returns an empty string.
+func StringsUnifiedDiff(a, b []string, fromFile, toFile string, context int) string {
+	if context < 0 {
+		context = 0
+	}
+	spans := DiffFunc(len(a), len(b), func(i, j int) bool { return a[i] == b[j] })
+	if len(spans) == 0 || len(spans) == 1 && spans[0].Kind == DiffEqual {
+		return ""
+	}
+	var sb strings.Builder
+	if fromFile != "" || toFile != "" {
+		sb.WriteString("--- " + fromFile + "\n")
+		sb.WriteString("+++ " + toFile + "\n")
+	}
+	for start := 0; start < len(spans); {
+		// skip the leading equal run, and collect the changes that are no more than 2*context apart
+		if spans[start].Kind == DiffEqual {
+			start++
+			continue
+		}
+		end := start + 1
+		for end < len(spans) {
+			if spans[end].Kind != DiffEqual {
+				end++
+			} else if end+1 < len(spans) && spans[end].Len <= 2*context {
+				end += 2
+			} else {
+				break
+			}
+		}
+		first, last := spans[start], spans[end-1]
+		aStart, bStart := first.A, first.B
+		aEnd, bEnd := last.A, last.B
+		if last.Kind != DiffInsert {
+			aEnd += last.Len
+		}
+		if last.Kind != DiffDelete {
+			bEnd += last.Len
+		}
+		pre := context
+		if start > 0 && spans[start-1].Len < pre {
+			pre = spans[start-1].Len
+		} else if start == 0 {
+			pre = 0
+		}
+		post := 0
+		if end < len(spans) {
+			post = context
+			if spans[end].Len < post {
+				post = spans[end].Len
+			}
+		}
+		aStart, bStart = aStart-pre, bStart-pre
+		aEnd, bEnd = aEnd+post, bEnd+post
+		sb.WriteString("@@ -" + unifiedRange(aStart, aEnd-aStart) + " +" + unifiedRange(bStart, bEnd-bStart) + " @@\n")
+		for _, line := range a[aStart : aStart+pre] {
+			sb.WriteString(" " + line + "\n")
+		}
+		for _, span := range spans[start:end] {
+			switch span.Kind {
+			case DiffEqual:
+				for _, line := range a[span.A : span.A+span.Len] {
+					sb.WriteString(" " + line + "\n")
+				}
+			case DiffDelete:
+				for _, line := range a[span.A : span.A+span.Len] {
+					sb.WriteString("-" + line + "\n")
+				}
+			case DiffInsert:
+				for _, line := range b[span.B : span.B+span.Len] {
+					sb.WriteString("+" + line + "\n")
+				}
+			}
+		}
+		for _, line := range a[aEnd-post : aEnd] {
+			sb.WriteString(" " + line + "\n")
+		}
+		start = end
+	}
+	return sb.String()
+}
+
+// unifiedRange formats the line range of a hunk header, the start is zero-based.
+func unifiedRange(start, length int) string {
+	if length == 1 {
+		return Itoa(start + 1)
+	}
+	if length == 0 {
+		return Itoa(start) + ",0"
+	}
+	return Itoa(start+1) + "," + Itoa(length)
+}
+
+// StringsShuffle randomizes the order of elements in place.
+// @r
+//
+//	The source of random numbers, nil means the global source of package math/rand.
+func StringsShuffle(s []string, r Randomizer) {
+	shuffleIndex(len(s), len(s), r, func(x, y int) { s[x], s[y] = s[y], s[x] })
+}
+
+// StringsSample returns k elements randomly selected from the slice without replacement.
+// If k is greater than the length of the slice, all the elements are returned in random order.
+// This method does not change the existing slice, but instead returns a new slice.
+// @r
+//
+//	The source of random numbers, nil means the global source of package math/rand.
+func StringsSample(s []string, k int, r Randomizer) []string {
+	if k <= 0 {
+		return []string{}
+	}
+	a := StringsCopy(s)
+	shuffleIndex(len(a), k, r, func(x, y int) { a[x], a[y] = a[y], a[x] })
+	if k > len(a) {
+		k = len(a)
+	}
+	return a[:k:k]
+}
+
+// StringsSampleWithReplacement returns k elements randomly selected from the slice with replacement.
+// This method does not change the existing slice, but instead returns a new slice.
+// @r
+//
+//	The source of random numbers, nil means the global source of package math/rand.
+func StringsSampleWithReplacement(s []string, k int, r Randomizer) []string {
+	if k <= 0 || len(s) == 0 {
+		return []string{}
+	}
+	r = getRandomizer(r)
+	ret := make([]string, k)
+	for n := range ret {
+		ret[n] = s[r.Intn(len(s))]
+	}
+	return ret
+}
+
+// StringsWeightedChoice returns the key-value of an element randomly selected
+// with the probability proportional to its weight.
+// @weights
+//
+//	The weights of the elements with the same indexes, must be finite and non-negative.
+//
+// @r
+//
+//	The source of random numbers, nil means the global source of package math/rand.
+//
+// NOTE:
+//
+//	If the slice is empty, or the weights are invalid, returns an error and k = -1
+func StringsWeightedChoice(s []string, weights []float64, r Randomizer) (k int, v string, err error) {
+	k, err = weightedIndex(len(s), weights, r)
+	if err != nil {
+		return k, v, err
+	}
+	return k, s[k], nil
+}
+
+// StringsReservoirSample returns k elements randomly selected from a stream of unknown length,
+// in a single pass and with O(k) memory.
+// @next
+//
+//	Returns the next element of the stream, or ok = false at the end of the stream.
+//
+// @r
+//
+//	The source of random numbers, nil means the global source of package math/rand.
+func StringsReservoirSample(next func() (v string, ok bool), k int, r Randomizer) []string {
+	if k <= 0 {
+		return []string{}
+	}
+	r = getRandomizer(r)
+	ret := make([]string, 0, k)
+	for seen := 0; ; seen++ {
+		v, ok := next()
+		if !ok {
+			return ret
+		}
+		switch slot := reservoirIndex(seen, k, r); {
+		case slot == len(ret):
+			ret = append(ret, v)
+		case slot >= 0:
+			ret[slot] = v
+		}
+	}
+}
+
+// StringsAt returns the element at the given index.
+// @index
+//
+//	Zero-based index of the element. If negative, index will be counted from the end.
+//
+// NOTE:
+//
+//	If the index is out of range, ok = false
+func StringsAt(s []string, index int) (v string, ok bool) {
+	index, ok = atIndex(len(s), index)
+	if !ok {
+		return v, false
+	}
+	return s[index], true
+}
+
+// StringsWith returns a copy of the slice with the element at the given index replaced with the value.
+// @index
+//
+//	Zero-based index of the element. If negative, index will be counted from the end.
+//
+// NOTE:
+//
+//	If the index is out of range, ok = false
+func StringsWith(s []string, index int, value string) (ret []string, ok bool) {
+	ret = StringsCopy(s)
+	index, ok = atIndex(len(s), index)
+	if ok {
+		ret[index] = value
+	}
+	return ret, ok
+}
+
+// StringsFindIndex returns the index of the first element in the provided slice that satisfies the provided testing function.
+// NOTE:
+//
+//	If not found, returns -1
+func StringsFindIndex(s []string, fn func(s []string, k int, v string) bool) int {
+	k, _ := StringsFind(s, fn)
+	return k
+}
+
+// StringsFindLast returns the key-value of the last element in the provided slice that satisfies the provided testing function.
+// NOTE:
+//
+//	If not found, k = -1
+func StringsFindLast(s []string, fn func(s []string, k int, v string) bool) (k int, v string) {
+	for k := len(s) - 1; k >= 0; k-- {
+		if fn(s, k, s[k]) {
+			return k, s[k]
+		}
+	}
+	return -1, v
+}
+
+// StringsFindLastIndex returns the index of the last element in the provided slice that satisfies the provided testing function.
+// NOTE:
+//
+//	If not found, returns -1
+func StringsFindLastIndex(s []string, fn func(s []string, k int, v string) bool) int {
+	k, _ := StringsFindLast(s, fn)
+	return k
+}
+
+// StringsFlat creates a new slice with all sub-slice elements concatenated into it.
+func StringsFlat(s [][]string) []string {
+	return StringsConcat(s...)
+}
+
+// StringsFlatMap creates a new slice populated with the results of calling a provided function
+// on every element in the calling slice, and then flattening the results.
+func StringsFlatMap(s []string, fn func(s []string, k int, v string) []string) []string {
+	ret := make([]string, 0, len(s))
+	for k, v := range s {
+		ret = append(ret, fn(s, k, v)...)
+	}
+	return ret
+}
+
+// StringsToSorted returns a copy of the slice sorted stably.
+// @less
+//
+//	Reports whether the element a must sort before the element b. If nil, sorts in ascending order.
+func StringsToSorted(s []string, less func(a, b string) bool) []string {
+	ret := StringsCopy(s)
+	if less == nil {
+		less = func(a, b string) bool { return a < b }
+	}
+	sort.SliceStable(ret, func(x, y int) bool { return less(ret[x], ret[y]) })
+	return ret
+}
+
+// StringsToReversed returns a copy of the slice in reversed order.
+func StringsToReversed(s []string) []string {
+	ret := StringsCopy(s)
+	StringsReverse(ret)
+	return ret
+}
+
+// StringsToSpliced returns a copy of the slice with some elements removed and/or replaced at a given index,
+// see also StringsSplice.
+func StringsToSpliced(s []string, start, deleteCount int, items ...string) []string {
+	ret := StringsCopy(s)
+	StringsSplice(&ret, start, deleteCount, items...)
+	return ret
+}
+
+// StringsKeys returns an iterator of the indexes of the slice.
+// NOTE:
+//
+//	The iterator returns ok = false when it is exhausted.
+func StringsKeys(s []string) (next func() (k int, ok bool)) {
+	k := -1
+	return func() (int, bool) {
+		if k+1 >= len(s) {
+			return -1, false
+		}
+		k++
+		return k, true
+	}
+}
+
+// StringsValues returns an iterator of the elements of the slice.
+// NOTE:
+//
+//	The iterator returns ok = false when it is exhausted.
+func StringsValues(s []string) (next func() (v string, ok bool)) {
+	k := -1
+	return func() (v string, ok bool) {
+		if k+1 >= len(s) {
+			return v, false
+		}
+		k++
+		return s[k], true
+	}
+}
+
+// StringsEntries returns an iterator of the key-value pairs of the slice.
+// NOTE:
+//
+//	The iterator returns ok = false when it is exhausted.
+func StringsEntries(s []string) (next func() (k int, v string, ok bool)) {
+	k := -1
+	return func() (int, string, bool) {
+		if k+1 >= len(s) {
+			var v string
+			return -1, v, false
+		}
+		k++
+		return k, s[k], true
+	}
+}
+
+// StringsGroup groups the elements of the slice according to the string keys returned by the provided function.
+func StringsGroup(s []string, fn func(s []string, k int, v string) string) map[string][]string {
+	ret := make(map[string][]string)
+	for k, v := range s {
+		key := fn(s, k, v)
+		ret[key] = append(ret[key], v)
+	}
+	return ret
+}
+
+// StringsGroupToMap groups the elements of the slice according to the keys returned by the provided function.
+// NOTE:
+//
+//	The keys must be comparable.
+func StringsGroupToMap(s []string, fn func(s []string, k int, v string) interface{}) map[interface{}][]string {
+	ret := make(map[interface{}][]string)
+	for k, v := range s {
+		key := fn(s, k, v)
+		ret[key] = append(ret[key], v)
+	}
+	return ret
+}
+
+// StringsTrimSpace returns a new slice with all leading and trailing white space removed from every element,
+// see strings.TrimSpace.
+func StringsTrimSpace(s []string) []string {
+	return StringsMap(s, func(s []string, k int, v string) string {
+		return strings.TrimSpace(v)
+	})
+}
+
+// StringsToLower returns a new slice with every element mapped to their lower case.
+func StringsToLower(s []string) []string {
+	return StringsMap(s, func(s []string, k int, v string) string {
+		return strings.ToLower(v)
+	})
+}
+
+// StringsToUpper returns a new slice with every element mapped to their upper case.
+func StringsToUpper(s []string) []string {
+	return StringsMap(s, func(s []string, k int, v string) string {
+		return strings.ToUpper(v)
+	})
+}
+
+// StringsIndexFold is like StringsIndexOf, but under Unicode case-folding, see strings.EqualFold.
+// @fromIndex
+//
+//	The index to start the search at. Defaults to 0.
+func StringsIndexFold(s []string, searchElement string, fromIndex ...int) int {
+	idx := getFromIndex(len(s), fromIndex...)
+	for k, v := range s[idx:] {
+		if strings.EqualFold(searchElement, v) {
+			return k + idx
+		}
+	}
+	return -1
+}
+
+// StringsFilterPrefix creates a new slice with all elements that begin with the prefix.
+// @ignoreCase
+//
+//	If true, compares under simple Unicode case-folding.
+func StringsFilterPrefix(s []string, prefix string, ignoreCase bool) []string {
+	return StringsFilter(s, func(s []string, k int, v string) bool {
+		if ignoreCase {
+			return hasPrefixFold(v, prefix)
+		}
+		return strings.HasPrefix(v, prefix)
+	})
+}
+
+// StringsFilterSuffix creates a new slice with all elements that end with the suffix.
+// @ignoreCase
+//
+//	If true, compares under simple Unicode case-folding.
+func StringsFilterSuffix(s []string, suffix string, ignoreCase bool) []string {
+	return StringsFilter(s, func(s []string, k int, v string) bool {
+		if ignoreCase {
+			return hasSuffixFold(v, suffix)
+		}
+		return strings.HasSuffix(v, suffix)
+	})
+}
+
+// StringsFilterContains creates a new slice with all elements that contain the substr.
+// @ignoreCase
+//
+//	If true, compares under simple Unicode case-folding.
+func StringsFilterContains(s []string, substr string, ignoreCase bool) []string {
+	return StringsFilter(s, func(s []string, k int, v string) bool {
+		if ignoreCase {
+			return containsFold(v, substr)
+		}
+		return strings.Contains(v, substr)
+	})
+}
+
+// StringsClosestMatches returns up to n elements that are the most similar to the word,
+// ranked from the most to the least similar, e.g. to make "did you mean" suggestions.
+// @cutoff
+//
+//	The elements whose similarity is less than cutoff are ignored, usually 0.8 for Jaro-Winkler.
+//
+// @similarity
+//
+//	Returns the similarity of two strings between 0 and 1, nil means JaroWinklerSimilarity.
+//
+// NOTE:
+//
+//	If n < 0, returns all matched elements.
+func StringsClosestMatches(s []string, word string, n int, cutoff float64, similarity func(a, b string) float64) []string {
+	if similarity == nil {
+		similarity = JaroWinklerSimilarity
+	}
+	type match struct {
+		value string
+		score float64
+	}
+	var matches []match
+	for _, v := range s {
+		if score := similarity(word, v); score >= cutoff {
+			matches = append(matches, match{value: v, score: score})
+		}
+	}
+	sort.SliceStable(matches, func(i, j int) bool { return matches[i].score > matches[j].score })
+	if n >= 0 && n < len(matches) {
+		matches = matches[:n]
+	}
+	ret := make([]string, len(matches))
+	for k, m := range matches {
+		ret[k] = m.value
+	}
+	return ret
+}
+
+func hasPrefixFold(s, prefix string) bool {
+	for _, p := range prefix {
+		r, size := utf8.DecodeRuneInString(s)
+		if size == 0 || !runeEqualFold(r, p) {
+			return false
+		}
+		s = s[size:]
+	}
+	return true
+}
+
+func hasSuffixFold(s, suffix string) bool {
+	for len(suffix) > 0 {
+		p, psize := utf8.DecodeLastRuneInString(suffix)
+		r, size := utf8.DecodeLastRuneInString(s)
+		if size == 0 || !runeEqualFold(r, p) {
+			return false
+		}
+		s, suffix = s[:len(s)-size], suffix[:len(suffix)-psize]
+	}
+	return true
+}
+
+func containsFold(s, substr string) bool {
+	for {
+		if hasPrefixFold(s, substr) {
+			return true
+		}
+		_, size := utf8.DecodeRuneInString(s)
+		if size == 0 {
+			return false
+		}
+		s = s[size:]
+	}
+}
+
+func runeEqualFold(a, b rune) bool {
+	if a == b {
+		return true
+	}
+	for r := unicode.SimpleFold(a); r != a; r = unicode.SimpleFold(r) {
+		if r == b {
+			return true
+		}
+	}
+	return false
+}
+
+// StringsNaturalSort sorts the slice stably in natural order in place, see NaturalCompare.
+func StringsNaturalSort(s []string, options ...NaturalOption) {
+	opt := mergeNaturalOptions(options)
+	sort.SliceStable(s, func(i, j int) bool { return NaturalCompare(s[i], s[j], opt) < 0 })
+}
+
+// StringsNaturalIsSorted reports whether the slice is sorted in natural order, see NaturalCompare.
+func StringsNaturalIsSorted(s []string, options ...NaturalOption) bool {
+	opt := mergeNaturalOptions(options)
+	for k := 1; k < len(s); k++ {
+		if NaturalCompare(s[k], s[k-1], opt) < 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// StringsNaturalSearch searches for x in a slice sorted in natural order with binary search,
+// and returns the index to insert x if x is not present (it could be len(s)).
+// NOTE:
+//
+//	The slice must be sorted by StringsNaturalSort with the same options.
+func StringsNaturalSearch(s []string, x string, options ...NaturalOption) int {
+	opt := mergeNaturalOptions(options)
+	return sort.Search(len(s), func(i int) bool { return NaturalCompare(s[i], x, opt) >= 0 })
+}
+
+// StringsNaturalDistinct is like StringsDistinct, but the elements that are equal in natural order
+// (see NaturalCompare) are regarded as the same element, and counted by the first one.
+func StringsNaturalDistinct(s *[]string, changeSlice bool, options ...NaturalOption) (distinctCount map[string]int) {
+	opt := mergeNaturalOptions(options)
+	first := make(map[string]string, len(*s))
+	distinctCount = make(map[string]int, len(*s))
+	a := (*s)[:0]
+	for _, v := range *s {
+		key := naturalKey(v, opt)
+		f, ok := first[key]
+		if !ok {
+			f = v
+			first[key] = v
+			if changeSlice {
+				a = append(a, v)
+			}
+		}
+		distinctCount[f]++
+	}
+	if changeSlice {
+		n := len(a)
+		*s = a[:n:n]
+	}
+	return distinctCount
+}
+
+// StringsEqual reports whether a and b have the same length and the same elements in the same order.
+func StringsEqual(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for k, v := range a {
+		if v != b[k] {
+			return false
+		}
+	}
+	return true
+}
+
+// StringsCompare compares a and b lexicographically, and returns -1 if a < b, 0 if a == b, or +1 if a > b.
+// NOTE:
+//
+//	A slice that is a prefix of another slice is less than it.
+func StringsCompare(a, b []string) int {
+	for k := 0; k < len(a) && k < len(b); k++ {
+		if c := compareString(a[k], b[k]); c != 0 {
+			return c
+		}
+	}
+	return compareInt(len(a), len(b))
+}
+
+// StringsHasPrefix reports whether the slice s begins with prefix.
+func StringsHasPrefix(s, prefix []string) bool {
+	return len(s) >= len(prefix) && StringsEqual(s[:len(prefix)], prefix)
+}
+
+// StringsHasSuffix reports whether the slice s ends with suffix.
+func StringsHasSuffix(s, suffix []string) bool {
+	return len(s) >= len(suffix) && StringsEqual(s[len(s)-len(suffix):], suffix)
+}
+
+func compareString(a, b string) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+	return 0
+}
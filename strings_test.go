@@ -258,3 +258,59 @@ func TestStringSet(t *testing.T) {
 	di := StringSetDifference(set1, set2, set3)
 	assert.Equal(t, []string{"1", "8"}, di)
 }
+
+func TestStringSortedSet(t *testing.T) {
+	set1 := []string{"a", "b", "c", "f", "h"}
+	set2 := []string{"0", "b", "c", "e"}
+	set3 := []string{"b", "f", "g"}
+	assert.Equal(t, []string{"0", "a", "b", "c", "e", "f", "g", "h"}, StringSortedSetUnion(set1, set2, set3))
+	assert.Equal(t, []string{"b"}, StringSortedSetIntersect(set1, set2, set3))
+	assert.Equal(t, []string{"a", "h"}, StringSortedSetDifference(set1, set2, set3))
+	assert.Equal(t, []string{"0", "a", "e", "f", "h"}, StringSortedSetSymmetricDifference(set1, set2))
+	assert.True(t, StringSortedSetIsSubset([]string{"b", "h"}, set1))
+}
+
+func TestStringsSpliceNegative(t *testing.T) {
+	slice := []string{"0", "1", "2"}
+	StringsSplice(&slice, -2, 1, "a")
+	assert.Equal(t, []string{"0", "a", "2"}, slice)
+	StringsSplice(&slice, 3, 0, "b")
+	assert.Equal(t, []string{"0", "a", "2", "b"}, slice)
+	assert.Equal(t, []string{"0", "a", "c"}, StringsToSpliced(slice, -2, 5, "c"))
+}
+
+func TestStringsText(t *testing.T) {
+	slice := []string{" Apple ", "apricot\n", "Banana", "ÉCLAIR"}
+	assert.Equal(t, []string{"Apple", "apricot", "Banana", "ÉCLAIR"}, StringsTrimSpace(slice))
+	assert.Equal(t, []string{" apple ", "apricot\n", "banana", "éclair"}, StringsToLower(slice))
+	assert.Equal(t, 3, StringsIndexFold(slice, "éclair"))
+
+	slice = StringsTrimSpace(slice)
+	assert.Equal(t, []string{"apricot"}, StringsFilterPrefix(slice, "ap", false))
+	assert.Equal(t, []string{"Apple", "apricot"}, StringsFilterPrefix(slice, "AP", true))
+	assert.Equal(t, []string{"ÉCLAIR"}, StringsFilterPrefix(slice, "éc", true))
+	assert.Equal(t, []string{"Banana"}, StringsFilterSuffix(slice, "NA", true))
+	assert.Equal(t, []string{}, StringsFilterSuffix(slice, "NA", false))
+	assert.Equal(t, []string{"Banana"}, StringsFilterContains(slice, "NAN", true))
+	assert.Equal(t, slice, StringsFilterContains(slice, "", true))
+	assert.Equal(t, []string{}, StringsFilterContains(slice, "nap", true))
+
+	// the long s (U+017F) folds to s, but strings.ToLower keeps it
+	slice = []string{"ſtraſſe", "Strasse"}
+	assert.Equal(t, slice, StringsFilterPrefix(slice, "STR", true))
+	assert.Equal(t, slice, StringsFilterSuffix(slice, "SSE", true))
+	assert.Equal(t, slice, StringsFilterContains(slice, "ASS", true))
+	assert.Equal(t, []string{"Strasse"}, StringsFilterContains(slice, "ass", false))
+}
+
+func TestStringsClosestMatches(t *testing.T) {
+	commands := []string{"commit", "checkout", "cherry-pick", "clone", "status"}
+	assert.Equal(t, []string{"commit"}, StringsClosestMatches(commands, "comit", 1, 0.8, nil))
+	assert.Equal(t, []string{"checkout"}, StringsClosestMatches(commands, "chekout", -1, 0.8, nil))
+	assert.Len(t, StringsClosestMatches(commands, "chekout", -1, 0, nil), len(commands))
+	assert.Equal(t, []string{}, StringsClosestMatches(commands, "zzz", 3, 0.8, nil))
+	byDistance := func(a, b string) float64 {
+		return 1 / float64(1+LevenshteinDistance(a, b))
+	}
+	assert.Equal(t, []string{"clone", "commit"}, StringsClosestMatches(commands, "clon", 2, 0, byDistance))
+}
@@ -0,0 +1,373 @@
+package ameda
+
+import (
+	"sync"
+	"sync/atomic"
+)
+
+// SyncStrings is a string slice that is safe for concurrent use.
+// NOTE:
+//
+//	The zero value is an empty slice ready to use.
+//	Point reads such as Len and At use the read lock without copying the elements.
+//	Callbacks are run on a snapshot, so they can call the methods of the same SyncStrings.
+type SyncStrings struct {
+	mu   sync.RWMutex
+	data []string
+	snap atomic.Value // []string: read-only copy of data, nil after modification
+}
+
+// NewSyncStrings creates a SyncStrings with a copy of the elements.
+func NewSyncStrings(elements ...string) *SyncStrings {
+	return &SyncStrings{data: StringsCopy(elements)}
+}
+
+// Snapshot returns a read-only copy of the current elements.
+// NOTE:
+//
+//	The result is shared with other callers until the next modification, so it must not be changed.
+//	While there is no modification, it is an atomic read without locking.
+func (s *SyncStrings) Snapshot() []string {
+	if snap, _ := s.snap.Load().([]string); snap != nil {
+		return snap
+	}
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	snap := StringsCopy(s.data)
+	s.snap.Store(snap)
+	return snap
+}
+
+// Slice returns a copy of the current elements that can be changed.
+func (s *SyncStrings) Slice() []string {
+	return StringsCopy(s.Snapshot())
+}
+
+// Len returns the number of elements.
+func (s *SyncStrings) Len() int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return len(s.data)
+}
+
+// At returns the element at the index, which can be negative and counted from the end.
+func (s *SyncStrings) At(index int) (v string, ok bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return StringsAt(s.data, index)
+}
+
+// Includes determines whether the slice includes a certain element.
+func (s *SyncStrings) Includes(valueToFind string, fromIndex ...int) bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return StringsIncludes(s.data, valueToFind, fromIndex...)
+}
+
+// IndexOf returns the first index at which a given element can be found, or -1 if it is not present.
+func (s *SyncStrings) IndexOf(searchElement string, fromIndex ...int) int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return StringsIndexOf(s.data, searchElement, fromIndex...)
+}
+
+// LastIndexOf returns the last index at which a given element can be found, or -1 if it is not present.
+func (s *SyncStrings) LastIndexOf(searchElement string, fromIndex ...int) int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return StringsLastIndexOf(s.data, searchElement, fromIndex...)
+}
+
+// Range calls fn for each element of a snapshot in order, until fn returns false.
+func (s *SyncStrings) Range(fn func(k int, v string) bool) {
+	for k, v := range s.Snapshot() {
+		if !fn(k, v) {
+			return
+		}
+	}
+}
+
+// Every tests whether all elements of a snapshot pass the test implemented by fn.
+func (s *SyncStrings) Every(fn func(s []string, k int, v string) bool) bool {
+	return StringsEvery(s.Snapshot(), fn)
+}
+
+// Some tests whether at least one element of a snapshot passes the test implemented by fn.
+func (s *SyncStrings) Some(fn func(s []string, k int, v string) bool) bool {
+	return StringsSome(s.Snapshot(), fn)
+}
+
+// Find returns the key-value of the first element of a snapshot that satisfies fn.
+// If no element satisfies fn, -1 is returned.
+func (s *SyncStrings) Find(fn func(s []string, k int, v string) bool) (k int, v string) {
+	return StringsFind(s.Snapshot(), fn)
+}
+
+// Filter creates a new slice with the elements of a snapshot that pass the test implemented by fn.
+func (s *SyncStrings) Filter(fn func(s []string, k int, v string) bool) []string {
+	return StringsFilter(s.Snapshot(), fn)
+}
+
+// Map creates a new slice with the results of calling fn on every element of a snapshot.
+func (s *SyncStrings) Map(fn func(s []string, k int, v string) string) []string {
+	return StringsMap(s.Snapshot(), fn)
+}
+
+// Reduce executes fn on each element of a snapshot, resulting in a single output value.
+func (s *SyncStrings) Reduce(fn func(s []string, k int, v, accumulator string) string, initialValue ...string) string {
+	return StringsReduce(s.Snapshot(), fn, initialValue...)
+}
+
+// Set changes the element at the index, which can be negative and counted from the end,
+// and reports whether the index is in range.
+func (s *SyncStrings) Set(index int, value string) bool {
+	s.mu.Lock()
+	defer s.unlock()
+	k, ok := atIndex(len(s.data), index)
+	if ok {
+		s.data[k] = value
+	}
+	return ok
+}
+
+// Push adds one or more elements to the end, and returns the new length.
+func (s *SyncStrings) Push(element ...string) int {
+	s.mu.Lock()
+	defer s.unlock()
+	return StringsPush(&s.data, element...)
+}
+
+// PushDistinct adds one or more new elements that do not exist at the end, and returns the new length.
+func (s *SyncStrings) PushDistinct(element ...string) int {
+	s.mu.Lock()
+	defer s.unlock()
+	s.data = StringsPushDistinct(s.data, element...)
+	return len(s.data)
+}
+
+// Pop removes the last element and returns that element.
+func (s *SyncStrings) Pop() (string, bool) {
+	s.mu.Lock()
+	defer s.unlock()
+	return StringsPop(&s.data)
+}
+
+// Shift removes the first element and returns that element.
+func (s *SyncStrings) Shift() (string, bool) {
+	s.mu.Lock()
+	defer s.unlock()
+	return StringsShift(&s.data)
+}
+
+// Unshift adds one or more elements to the beginning, and returns the new length.
+func (s *SyncStrings) Unshift(element ...string) int {
+	s.mu.Lock()
+	defer s.unlock()
+	return StringsUnshift(&s.data, element...)
+}
+
+// UnshiftDistinct adds one or more new elements that do not exist to the beginning, and returns the new length.
+func (s *SyncStrings) UnshiftDistinct(element ...string) int {
+	s.mu.Lock()
+	defer s.unlock()
+	return StringsUnshiftDistinct(&s.data, element...)
+}
+
+// Splice removes deleteCount elements from start and adds the items in their place.
+func (s *SyncStrings) Splice(start, deleteCount int, items ...string) {
+	s.mu.Lock()
+	defer s.unlock()
+	StringsSplice(&s.data, start, deleteCount, items...)
+}
+
+// RemoveFirst removes the first matched elements, and returns the new length.
+func (s *SyncStrings) RemoveFirst(elements ...string) int {
+	s.mu.Lock()
+	defer s.unlock()
+	return StringsRemoveFirst(&s.data, elements...)
+}
+
+// RemoveEvery removes all the elements, and returns the new length.
+func (s *SyncStrings) RemoveEvery(elements ...string) int {
+	s.mu.Lock()
+	defer s.unlock()
+	return StringsRemoveEvery(&s.data, elements...)
+}
+
+// Distinct removes the duplicate elements, and returns the count of each element.
+func (s *SyncStrings) Distinct() (distinctCount map[string]int) {
+	s.mu.Lock()
+	defer s.unlock()
+	return StringsDistinct(&s.data, true)
+}
+
+// Fill changes all elements from start to end to a static value.
+func (s *SyncStrings) Fill(value string, start int, end ...int) {
+	s.mu.Lock()
+	defer s.unlock()
+	StringsFill(s.data, value, start, end...)
+}
+
+// CopyWithin copies part of the slice to another location in the same slice.
+func (s *SyncStrings) CopyWithin(target, start int, end ...int) {
+	s.mu.Lock()
+	defer s.unlock()
+	StringsCopyWithin(s.data, target, start, end...)
+}
+
+// Reverse reverses the elements in place.
+func (s *SyncStrings) Reverse() {
+	s.mu.Lock()
+	defer s.unlock()
+	StringsReverse(s.data)
+}
+
+// Shuffle randomizes the order of the elements in place.
+func (s *SyncStrings) Shuffle(r Randomizer) {
+	s.mu.Lock()
+	defer s.unlock()
+	StringsShuffle(s.data, r)
+}
+
+// ApplyPatch applies the edit script to the elements.
+func (s *SyncStrings) ApplyPatch(patch []StringsEdit) error {
+	s.mu.Lock()
+	defer s.unlock()
+	return StringsApplyPatch(&s.data, patch)
+}
+
+// Update calls fn with the pointer of the elements under the write lock.
+// NOTE:
+//
+//	fn must not call the methods of the same SyncStrings, and must not retain the slice.
+func (s *SyncStrings) Update(fn func(p *[]string)) {
+	s.mu.Lock()
+	defer s.unlock()
+	fn(&s.data)
+}
+
+// Clear removes all elements.
+func (s *SyncStrings) Clear() {
+	s.mu.Lock()
+	defer s.unlock()
+	s.data = nil
+}
+
+// unlock invalidates the snapshot and releases the write lock.
+func (s *SyncStrings) unlock() {
+	s.snap.Store([]string(nil))
+	s.mu.Unlock()
+}
+
+// SyncStringSet is a string set that is safe for concurrent use.
+// NOTE:
+//
+//	The zero value is an empty set ready to use.
+//	Len and Has use the read lock without copying the elements.
+//	Callbacks are run on a snapshot, so they can call the methods of the same SyncStringSet.
+type SyncStringSet struct {
+	mu   sync.RWMutex
+	set  *StringSet
+	snap atomic.Value // []string: read-only elements in insertion order, nil after modification
+}
+
+// NewSyncStringSet creates a SyncStringSet with the elements.
+func NewSyncStringSet(elements ...string) *SyncStringSet {
+	return &SyncStringSet{set: NewStringSet(elements...)}
+}
+
+// Snapshot returns the read-only elements in insertion order.
+// NOTE:
+//
+//	The result is shared with other callers until the next modification, so it must not be changed.
+//	While there is no modification, it is an atomic read without locking.
+func (s *SyncStringSet) Snapshot() []string {
+	if snap, _ := s.snap.Load().([]string); snap != nil {
+		return snap
+	}
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	var snap []string
+	if s.set != nil {
+		snap = s.set.Slice()
+	}
+	if snap == nil {
+		snap = []string{}
+	}
+	s.snap.Store(snap)
+	return snap
+}
+
+// Add adds the elements that are not yet in the set, and returns the new length of the set.
+func (s *SyncStringSet) Add(elements ...string) int {
+	s.mu.Lock()
+	defer s.unlock()
+	if s.set == nil {
+		s.set = NewStringSet()
+	}
+	return s.set.Add(elements...)
+}
+
+// Remove removes the elements from the set, and returns the new length of the set.
+func (s *SyncStringSet) Remove(elements ...string) int {
+	s.mu.Lock()
+	defer s.unlock()
+	if s.set == nil {
+		return 0
+	}
+	return s.set.Remove(elements...)
+}
+
+// Has reports whether the element is in the set.
+func (s *SyncStringSet) Has(element string) bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.set != nil && s.set.Has(element)
+}
+
+// Len returns the number of elements in the set.
+func (s *SyncStringSet) Len() int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	if s.set == nil {
+		return 0
+	}
+	return s.set.Len()
+}
+
+// Clear removes all elements.
+func (s *SyncStringSet) Clear() {
+	s.mu.Lock()
+	defer s.unlock()
+	s.set = nil
+}
+
+// Range calls fn for each element of a snapshot in insertion order, until fn returns false.
+func (s *SyncStringSet) Range(fn func(v string) bool) {
+	for _, v := range s.Snapshot() {
+		if !fn(v) {
+			return
+		}
+	}
+}
+
+// Slice returns a copy of the elements in insertion order.
+func (s *SyncStringSet) Slice() []string {
+	return StringsCopy(s.Snapshot())
+}
+
+// SortedSlice returns a copy of the elements in ascending order.
+func (s *SyncStringSet) SortedSlice() []string {
+	return NewStringSet(s.Snapshot()...).SortedSlice()
+}
+
+// Clone returns a StringSet with the current elements.
+func (s *SyncStringSet) Clone() *StringSet {
+	return NewStringSet(s.Snapshot()...)
+}
+
+// unlock invalidates the snapshot and releases the write lock.
+func (s *SyncStringSet) unlock() {
+	s.snap.Store([]string(nil))
+	s.mu.Unlock()
+}
@@ -0,0 +1,164 @@
+package ameda
+
+import "sort"
+
+// Uint16Counter is a multiset that maps each uint16 element to its count.
+// NOTE:
+//
+//	Only positive counts are kept.
+//	It has the same underlying type as the result of Uint16sDistinct and Uint16sIntersect,
+//	so they can be converted to each other directly.
+type Uint16Counter map[uint16]int
+
+// Uint16CounterItem is an element with its count.
+type Uint16CounterItem struct {
+	Element uint16
+	Count   int
+}
+
+// NewUint16Counter creates a counter that counts the elements.
+func NewUint16Counter(elements ...uint16) Uint16Counter {
+	c := make(Uint16Counter, len(elements))
+	c.Add(elements...)
+	return c
+}
+
+// Uint16sDistinctCounter is like Uint16sDistinct, but returns a counter.
+func Uint16sDistinctCounter(u *[]uint16, changeSlice bool) Uint16Counter {
+	return Uint16Counter(Uint16sDistinct(u, changeSlice))
+}
+
+// Uint16sIntersectCounter is like Uint16sIntersect, but returns a counter, which is empty but not nil if there is no common element.
+func Uint16sIntersectCounter(u ...[]uint16) Uint16Counter {
+	if c := Uint16sIntersect(u...); c != nil {
+		return Uint16Counter(c)
+	}
+	return make(Uint16Counter)
+}
+
+// Add increases the count of each element by 1.
+func (c Uint16Counter) Add(elements ...uint16) {
+	for _, v := range elements {
+		c[v]++
+	}
+}
+
+// AddN increases the count of the element by n, and returns the new count.
+// NOTE:
+//
+//	If the count is no longer positive, the element is removed.
+func (c Uint16Counter) AddN(element uint16, n int) int {
+	n += c[element]
+	if n <= 0 {
+		delete(c, element)
+		return 0
+	}
+	c[element] = n
+	return n
+}
+
+// Subtract decreases the count of each element by 1.
+// NOTE:
+//
+//	If the count is no longer positive, the element is removed.
+func (c Uint16Counter) Subtract(elements ...uint16) {
+	for _, v := range elements {
+		c.AddN(v, -1)
+	}
+}
+
+// Get returns the count of the element.
+func (c Uint16Counter) Get(element uint16) int {
+	return c[element]
+}
+
+// Total returns the sum of all counts.
+func (c Uint16Counter) Total() int {
+	var n int
+	for _, v := range c {
+		n += v
+	}
+	return n
+}
+
+// Clone creates a copy of the counter.
+func (c Uint16Counter) Clone() Uint16Counter {
+	r := make(Uint16Counter, len(c))
+	for k, v := range c {
+		r[k] = v
+	}
+	return r
+}
+
+// Union returns a new counter with the maximum count of each element: c ∪ others...
+func (c Uint16Counter) Union(others ...Uint16Counter) Uint16Counter {
+	r := c.Clone()
+	for _, o := range others {
+		for k, v := range o {
+			if v > r[k] {
+				r[k] = v
+			}
+		}
+	}
+	return r
+}
+
+// Intersect returns a new counter with the minimum count of each element: c ∩ others...
+func (c Uint16Counter) Intersect(others ...Uint16Counter) Uint16Counter {
+	r := make(Uint16Counter, len(c))
+L:
+	for k, v := range c {
+		for _, o := range others {
+			v2 := o[k]
+			if v2 <= 0 {
+				continue L
+			}
+			if v > v2 {
+				v = v2
+			}
+		}
+		r[k] = v
+	}
+	return r
+}
+
+// MostCommon returns the k most common elements from the most to the least common,
+// elements with equal counts are in ascending order.
+// NOTE:
+//
+//	If k < 0, returns all elements.
+func (c Uint16Counter) MostCommon(k int) []Uint16CounterItem {
+	items := make([]Uint16CounterItem, 0, len(c))
+	for e, n := range c {
+		items = append(items, Uint16CounterItem{Element: e, Count: n})
+	}
+	sort.Slice(items, func(i, j int) bool {
+		if items[i].Count != items[j].Count {
+			return items[i].Count > items[j].Count
+		}
+		return items[i].Element < items[j].Element
+	})
+	if k >= 0 && k < len(items) {
+		items = items[:k]
+	}
+	return items
+}
+
+// SortedElements returns the distinct elements in ascending order.
+func (c Uint16Counter) SortedElements() []uint16 {
+	r := make([]uint16, 0, len(c))
+	for k := range c {
+		r = append(r, k)
+	}
+	sort.Slice(r, func(i, j int) bool { return r[i] < r[j] })
+	return r
+}
+
+// RangeSorted calls fn for each element in ascending order; if fn returns false, range stops the iteration.
+func (c Uint16Counter) RangeSorted(fn func(element uint16, count int) bool) {
+	for _, k := range c.SortedElements() {
+		if !fn(k, c[k]) {
+			return
+		}
+	}
+}
@@ -1,5 +1,10 @@
 package ameda
 
+import (
+	"fmt"
+	"sort"
+)
+
 // OneUint16 try to return the first element, otherwise return zero value.
 func OneUint16(u []uint16) uint16 {
 	if len(u) > 0 {
@@ -441,26 +446,20 @@ func Uint16sSplice(u *[]uint16, start, deleteCount int, items ...uint16) {
 	if deleteCount < 0 {
 		deleteCount = 0
 	}
-	start, end, _ := fixRange(len(a), start, start+1+deleteCount)
-	deleteCount = end - start - 1
-	for k := 0; k < len(items); k++ {
-		if deleteCount > 0 {
-			// replace
-			a[start] = items[k]
-			deleteCount--
-			start++
-		} else {
-			// insert
-			lastSlice := Uint16sCopy(a[start:])
-			items = items[k:]
-			a = append(a[:start], items...)
-			a = append(a[:start+len(items)], lastSlice...)
-			*u = a[:len(a):len(a)]
-			return
-		}
+	start = fixIndex(len(a), start, true)
+	if rest := len(a) - start; deleteCount > rest {
+		deleteCount = rest
 	}
-	if deleteCount > 0 {
-		a = append(a[:start], a[start+1+deleteCount:]...)
+	// replace
+	n := copy(a[start:start+deleteCount], items)
+	if n == len(items) {
+		// delete the rest
+		a = append(a[:start+n], a[start+deleteCount:]...)
+	} else {
+		// insert the rest
+		lastSlice := Uint16sCopy(a[start+deleteCount:])
+		a = append(a[:start+deleteCount], items[n:]...)
+		a = append(a, lastSlice...)
 	}
 	*u = a[:len(a):len(a)]
 }
@@ -689,3 +688,558 @@ func Uint16SetDifference(set1, set2 []uint16, others ...[]uint16) []uint16 {
 	}
 	return r
 }
+
+// Uint16sIsSorted reports whether the slice is sorted in ascending order.
+func Uint16sIsSorted(u []uint16) bool {
+	for k := 1; k < len(u); k++ {
+		if u[k] < u[k-1] {
+			return false
+		}
+	}
+	return true
+}
+
+// Uint16SortedSetUnion calculates between multiple sorted collections: set1 ∪ set2 ∪ others...
+// NOTE:
+//
+//	Every set must be sorted in ascending order (see Uint16sIsSorted), and the result is sorted too.
+//	It merges the sets in O(n+m) without building any map.
+//	This method does not change the existing slices, but instead returns a new slice.
+func Uint16SortedSetUnion(set1, set2 []uint16, others ...[]uint16) []uint16 {
+	r := uint16SortedSetUnion(set1, set2)
+	for _, set := range others {
+		r = uint16SortedSetUnion(r, set)
+	}
+	return r
+}
+
+// Uint16SortedSetIntersect calculates between multiple sorted collections: set1 ∩ set2 ∩ others...
+// NOTE:
+//
+//	Every set must be sorted in ascending order (see Uint16sIsSorted), and the result is sorted too.
+//	It merges the sets in O(n+m) without building any map.
+//	This method does not change the existing slices, but instead returns a new slice.
+func Uint16SortedSetIntersect(set1, set2 []uint16, others ...[]uint16) []uint16 {
+	r := uint16SortedSetIntersect(set1, set2)
+	for _, set := range others {
+		r = uint16SortedSetIntersect(r, set)
+	}
+	return r
+}
+
+// Uint16SortedSetDifference calculates between multiple sorted collections: set1 - set2 - others...
+// NOTE:
+//
+//	Every set must be sorted in ascending order (see Uint16sIsSorted), and the result is sorted too.
+//	It merges the sets in O(n+m) without building any map.
+//	This method does not change the existing slices, but instead returns a new slice.
+func Uint16SortedSetDifference(set1, set2 []uint16, others ...[]uint16) []uint16 {
+	r := uint16SortedSetDifference(set1, set2)
+	for _, set := range others {
+		r = uint16SortedSetDifference(r, set)
+	}
+	return r
+}
+
+// Uint16SortedSetSymmetricDifference calculates between multiple sorted collections: set1 ∆ set2 ∆ others...
+// NOTE:
+//
+//	Every set must be sorted in ascending order (see Uint16sIsSorted), and the result is sorted too.
+//	It merges the sets in O(n+m) without building any map.
+//	This method does not change the existing slices, but instead returns a new slice.
+func Uint16SortedSetSymmetricDifference(set1, set2 []uint16, others ...[]uint16) []uint16 {
+	r := uint16SortedSetSymmetricDifference(set1, set2)
+	for _, set := range others {
+		r = uint16SortedSetSymmetricDifference(r, set)
+	}
+	return r
+}
+
+// Uint16SortedSetIsSubset reports whether every element of sub is also in set: sub ⊆ set.
+// NOTE:
+//
+//	Both sets must be sorted in ascending order (see Uint16sIsSorted).
+func Uint16SortedSetIsSubset(sub, set []uint16) bool {
+	j := 0
+	for k := 0; k < len(sub); {
+		if j >= len(set) || sub[k] < set[j] {
+			return false
+		}
+		if set[j] < sub[k] {
+			j++
+			continue
+		}
+		k++
+	}
+	return true
+}
+
+func uint16SortedSetUnion(a, b []uint16) []uint16 {
+	r := make([]uint16, 0, len(a)+len(b))
+	i, j := 0, 0
+	for i < len(a) || j < len(b) {
+		var v uint16
+		switch {
+		case j >= len(b) || i < len(a) && a[i] < b[j]:
+			v = a[i]
+			i++
+		case i >= len(a) || b[j] < a[i]:
+			v = b[j]
+			j++
+		default:
+			v = a[i]
+			i++
+			j++
+		}
+		if n := len(r); n == 0 || r[n-1] != v {
+			r = append(r, v)
+		}
+	}
+	return r
+}
+
+func uint16SortedSetIntersect(a, b []uint16) []uint16 {
+	n := len(a)
+	if len(b) < n {
+		n = len(b)
+	}
+	r := make([]uint16, 0, n)
+	i, j := 0, 0
+	for i < len(a) && j < len(b) {
+		switch {
+		case a[i] < b[j]:
+			i++
+		case b[j] < a[i]:
+			j++
+		default:
+			v := a[i]
+			if n := len(r); n == 0 || r[n-1] != v {
+				r = append(r, v)
+			}
+			i++
+			j++
+		}
+	}
+	return r
+}
+
+func uint16SortedSetDifference(a, b []uint16) []uint16 {
+	r := make([]uint16, 0, len(a))
+	j := 0
+	for _, v := range a {
+		for j < len(b) && b[j] < v {
+			j++
+		}
+		if j < len(b) && b[j] == v {
+			continue
+		}
+		if n := len(r); n == 0 || r[n-1] != v {
+			r = append(r, v)
+		}
+	}
+	return r
+}
+
+func uint16SortedSetSymmetricDifference(a, b []uint16) []uint16 {
+	r := make([]uint16, 0, len(a)+len(b))
+	i, j := 0, 0
+	for i < len(a) || j < len(b) {
+		var v uint16
+		switch {
+		case j >= len(b) || i < len(a) && a[i] < b[j]:
+			v = a[i]
+			i++
+		case i >= len(a) || b[j] < a[i]:
+			v = b[j]
+			j++
+		default:
+			v = a[i]
+			for i < len(a) && a[i] == v {
+				i++
+			}
+			for j < len(b) && b[j] == v {
+				j++
+			}
+			continue
+		}
+		if n := len(r); n == 0 || r[n-1] != v {
+			r = append(r, v)
+		}
+	}
+	return r
+}
+
+// Uint16sEdit is an edit run of the edit script produced by Uint16sDiff.
+type Uint16sEdit struct {
+	Kind   DiffKind
+	Values []uint16
+}
+
+// Uint16sDiff computes the shortest edit script that turns slice a into slice b, see also DiffFunc.
+// This method does not change the existing slices, but instead returns the runs of edits.
+func Uint16sDiff(a, b []uint16) []Uint16sEdit {
+	spans := DiffFunc(len(a), len(b), func(i, j int) bool { return a[i] == b[j] })
+	r := make([]Uint16sEdit, len(spans))
+	for k, span := range spans {
+		r[k].Kind = span.Kind
+		if span.Kind == DiffInsert {
+			r[k].Values = Uint16sCopy(b[span.B : span.B+span.Len])
+		} else {
+			r[k].Values = Uint16sCopy(a[span.A : span.A+span.Len])
+		}
+	}
+	return r
+}
+
+// Uint16sApplyPatch changes the contents of an slice in place by applying the edit script produced by Uint16sDiff.
+// NOTE:
+//
+//	If the slice does not match the equal and delete runs of the patch, it returns an error
+//	and the slice will not be modified.
+func Uint16sApplyPatch(p *[]uint16, patch []Uint16sEdit) error {
+	a := *p
+	r := make([]uint16, 0, len(a))
+	pos := 0
+	for _, e := range patch {
+		switch e.Kind {
+		case DiffInsert:
+			r = append(r, e.Values...)
+		case DiffEqual, DiffDelete:
+			if pos+len(e.Values) > len(a) {
+				return fmt.Errorf("patch mismatch: %s run exceeds the slice length %d", e.Kind, len(a))
+			}
+			for k, v := range e.Values {
+				if a[pos+k] != v {
+					return fmt.Errorf("patch mismatch: %s run at index %d", e.Kind, pos+k)
+				}
+			}
+			if e.Kind == DiffEqual {
+				r = append(r, e.Values...)
+			}
+			pos += len(e.Values)
+		default:
+			return fmt.Errorf("patch mismatch: unknown edit kind %d", e.Kind)
+		}
+	}
+	if pos != len(a) {
+		return fmt.Errorf("patch mismatch: the slice has %d unpatched elements", len(a)-pos)
+	}
+	n := len(r)
+	*p = r[:n:n]
+	return nil
+}
+
+// Uint16sShuffle randomizes the order of elements in place.
+// @r
+//
+//	The source of random numbers, nil means the global source of package math/rand.
+func Uint16sShuffle(u []uint16, r Randomizer) {
+	shuffleIndex(len(u), len(u), r, func(x, y int) { u[x], u[y] = u[y], u[x] })
+}
+
+// Uint16sSample returns k elements randomly selected from the slice without replacement.
+// If k is greater than the length of the slice, all the elements are returned in random order.
+// This method does not change the existing slice, but instead returns a new slice.
+// @r
+//
+//	The source of random numbers, nil means the global source of package math/rand.
+func Uint16sSample(u []uint16, k int, r Randomizer) []uint16 {
+	if k <= 0 {
+		return []uint16{}
+	}
+	a := Uint16sCopy(u)
+	shuffleIndex(len(a), k, r, func(x, y int) { a[x], a[y] = a[y], a[x] })
+	if k > len(a) {
+		k = len(a)
+	}
+	return a[:k:k]
+}
+
+// Uint16sSampleWithReplacement returns k elements randomly selected from the slice with replacement.
+// This method does not change the existing slice, but instead returns a new slice.
+// @r
+//
+//	The source of random numbers, nil means the global source of package math/rand.
+func Uint16sSampleWithReplacement(u []uint16, k int, r Randomizer) []uint16 {
+	if k <= 0 || len(u) == 0 {
+		return []uint16{}
+	}
+	r = getRandomizer(r)
+	ret := make([]uint16, k)
+	for n := range ret {
+		ret[n] = u[r.Intn(len(u))]
+	}
+	return ret
+}
+
+// Uint16sWeightedChoice returns the key-value of an element randomly selected
+// with the probability proportional to its weight.
+// @weights
+//
+//	The weights of the elements with the same indexes, must be finite and non-negative.
+//
+// @r
+//
+//	The source of random numbers, nil means the global source of package math/rand.
+//
+// NOTE:
+//
+//	If the slice is empty, or the weights are invalid, returns an error and k = -1
+func Uint16sWeightedChoice(u []uint16, weights []float64, r Randomizer) (k int, v uint16, err error) {
+	k, err = weightedIndex(len(u), weights, r)
+	if err != nil {
+		return k, v, err
+	}
+	return k, u[k], nil
+}
+
+// Uint16sReservoirSample returns k elements randomly selected from a stream of unknown length,
+// in a single pass and with O(k) memory.
+// @next
+//
+//	Returns the next element of the stream, or ok = false at the end of the stream.
+//
+// @r
+//
+//	The source of random numbers, nil means the global source of package math/rand.
+func Uint16sReservoirSample(next func() (v uint16, ok bool), k int, r Randomizer) []uint16 {
+	if k <= 0 {
+		return []uint16{}
+	}
+	r = getRandomizer(r)
+	ret := make([]uint16, 0, k)
+	for seen := 0; ; seen++ {
+		v, ok := next()
+		if !ok {
+			return ret
+		}
+		switch slot := reservoirIndex(seen, k, r); {
+		case slot == len(ret):
+			ret = append(ret, v)
+		case slot >= 0:
+			ret[slot] = v
+		}
+	}
+}
+
+// Uint16sAt returns the element at the given index.
+// @index
+//
+//	Zero-based index of the element. If negative, index will be counted from the end.
+//
+// NOTE:
+//
+//	If the index is out of range, ok = false
+func Uint16sAt(u []uint16, index int) (v uint16, ok bool) {
+	index, ok = atIndex(len(u), index)
+	if !ok {
+		return v, false
+	}
+	return u[index], true
+}
+
+// Uint16sWith returns a copy of the slice with the element at the given index replaced with the value.
+// @index
+//
+//	Zero-based index of the element. If negative, index will be counted from the end.
+//
+// NOTE:
+//
+//	If the index is out of range, ok = false
+func Uint16sWith(u []uint16, index int, value uint16) (ret []uint16, ok bool) {
+	ret = Uint16sCopy(u)
+	index, ok = atIndex(len(u), index)
+	if ok {
+		ret[index] = value
+	}
+	return ret, ok
+}
+
+// Uint16sFindIndex returns the index of the first element in the provided slice that satisfies the provided testing function.
+// NOTE:
+//
+//	If not found, returns -1
+func Uint16sFindIndex(u []uint16, fn func(u []uint16, k int, v uint16) bool) int {
+	k, _ := Uint16sFind(u, fn)
+	return k
+}
+
+// Uint16sFindLast returns the key-value of the last element in the provided slice that satisfies the provided testing function.
+// NOTE:
+//
+//	If not found, k = -1
+func Uint16sFindLast(u []uint16, fn func(u []uint16, k int, v uint16) bool) (k int, v uint16) {
+	for k := len(u) - 1; k >= 0; k-- {
+		if fn(u, k, u[k]) {
+			return k, u[k]
+		}
+	}
+	return -1, v
+}
+
+// Uint16sFindLastIndex returns the index of the last element in the provided slice that satisfies the provided testing function.
+// NOTE:
+//
+//	If not found, returns -1
+func Uint16sFindLastIndex(u []uint16, fn func(u []uint16, k int, v uint16) bool) int {
+	k, _ := Uint16sFindLast(u, fn)
+	return k
+}
+
+// Uint16sFlat creates a new slice with all sub-slice elements concatenated into it.
+func Uint16sFlat(u [][]uint16) []uint16 {
+	return Uint16sConcat(u...)
+}
+
+// Uint16sFlatMap creates a new slice populated with the results of calling a provided function
+// on every element in the calling slice, and then flattening the results.
+func Uint16sFlatMap(u []uint16, fn func(u []uint16, k int, v uint16) []uint16) []uint16 {
+	ret := make([]uint16, 0, len(u))
+	for k, v := range u {
+		ret = append(ret, fn(u, k, v)...)
+	}
+	return ret
+}
+
+// Uint16sToSorted returns a copy of the slice sorted stably.
+// @less
+//
+//	Reports whether the element a must sort before the element b. If nil, sorts in ascending order.
+func Uint16sToSorted(u []uint16, less func(a, b uint16) bool) []uint16 {
+	ret := Uint16sCopy(u)
+	if less == nil {
+		less = func(a, b uint16) bool { return a < b }
+	}
+	sort.SliceStable(ret, func(x, y int) bool { return less(ret[x], ret[y]) })
+	return ret
+}
+
+// Uint16sToReversed returns a copy of the slice in reversed order.
+func Uint16sToReversed(u []uint16) []uint16 {
+	ret := Uint16sCopy(u)
+	Uint16sReverse(ret)
+	return ret
+}
+
+// Uint16sToSpliced returns a copy of the slice with some elements removed and/or replaced at a given index,
+// see also Uint16sSplice.
+func Uint16sToSpliced(u []uint16, start, deleteCount int, items ...uint16) []uint16 {
+	ret := Uint16sCopy(u)
+	Uint16sSplice(&ret, start, deleteCount, items...)
+	return ret
+}
+
+// Uint16sKeys returns an iterator of the indexes of the slice.
+// NOTE:
+//
+//	The iterator returns ok = false when it is exhausted.
+func Uint16sKeys(u []uint16) (next func() (k int, ok bool)) {
+	k := -1
+	return func() (int, bool) {
+		if k+1 >= len(u) {
+			return -1, false
+		}
+		k++
+		return k, true
+	}
+}
+
+// Uint16sValues returns an iterator of the elements of the slice.
+// NOTE:
+//
+//	The iterator returns ok = false when it is exhausted.
+func Uint16sValues(u []uint16) (next func() (v uint16, ok bool)) {
+	k := -1
+	return func() (v uint16, ok bool) {
+		if k+1 >= len(u) {
+			return v, false
+		}
+		k++
+		return u[k], true
+	}
+}
+
+// Uint16sEntries returns an iterator of the key-value pairs of the slice.
+// NOTE:
+//
+//	The iterator returns ok = false when it is exhausted.
+func Uint16sEntries(u []uint16) (next func() (k int, v uint16, ok bool)) {
+	k := -1
+	return func() (int, uint16, bool) {
+		if k+1 >= len(u) {
+			var v uint16
+			return -1, v, false
+		}
+		k++
+		return k, u[k], true
+	}
+}
+
+// Uint16sGroup groups the elements of the slice according to the string keys returned by the provided function.
+func Uint16sGroup(u []uint16, fn func(u []uint16, k int, v uint16) string) map[string][]uint16 {
+	ret := make(map[string][]uint16)
+	for k, v := range u {
+		key := fn(u, k, v)
+		ret[key] = append(ret[key], v)
+	}
+	return ret
+}
+
+// Uint16sGroupToMap groups the elements of the slice according to the keys returned by the provided function.
+// NOTE:
+//
+//	The keys must be comparable.
+func Uint16sGroupToMap(u []uint16, fn func(u []uint16, k int, v uint16) interface{}) map[interface{}][]uint16 {
+	ret := make(map[interface{}][]uint16)
+	for k, v := range u {
+		key := fn(u, k, v)
+		ret[key] = append(ret[key], v)
+	}
+	return ret
+}
+
+// Uint16sEqual reports whether a and b have the same length and the same elements in the same order.
+func Uint16sEqual(a, b []uint16) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for k, v := range a {
+		if v != b[k] {
+			return false
+		}
+	}
+	return true
+}
+
+// Uint16sCompare compares a and b lexicographically, and returns -1 if a < b, 0 if a == b, or +1 if a > b.
+// NOTE:
+//
+//	A slice that is a prefix of another slice is less than it.
+func Uint16sCompare(a, b []uint16) int {
+	for k := 0; k < len(a) && k < len(b); k++ {
+		if c := compareUint16(a[k], b[k]); c != 0 {
+			return c
+		}
+	}
+	return compareInt(len(a), len(b))
+}
+
+// Uint16sHasPrefix reports whether the slice u begins with prefix.
+func Uint16sHasPrefix(u, prefix []uint16) bool {
+	return len(u) >= len(prefix) && Uint16sEqual(u[:len(prefix)], prefix)
+}
+
+// Uint16sHasSuffix reports whether the slice u ends with suffix.
+func Uint16sHasSuffix(u, suffix []uint16) bool {
+	return len(u) >= len(suffix) && Uint16sEqual(u[len(u)-len(suffix):], suffix)
+}
+
+func compareUint16(a, b uint16) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+	return 0
+}
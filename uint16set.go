@@ -0,0 +1,222 @@
+package ameda
+
+import "sort"
+
+// Uint16Set is a set of uint16 elements that remembers the insertion order.
+// NOTE:
+//
+//	The zero value is an empty set ready to use.
+//	It is not safe for concurrent use.
+type Uint16Set struct {
+	index   map[uint16]int // element -> position in elems
+	elems   []uint16
+	removed []bool
+	holes   int
+}
+
+// NewUint16Set creates a set from the elements, see also Uint16Set.Slice.
+func NewUint16Set(elements ...uint16) *Uint16Set {
+	s := &Uint16Set{
+		index: make(map[uint16]int, len(elements)),
+		elems: make([]uint16, 0, len(elements)),
+	}
+	s.Add(elements...)
+	return s
+}
+
+// Add adds the elements that are not yet in the set, and returns the new length of the set.
+func (s *Uint16Set) Add(elements ...uint16) int {
+	if s.index == nil {
+		s.index = make(map[uint16]int, len(elements))
+	}
+	for _, v := range elements {
+		if _, ok := s.index[v]; ok {
+			continue
+		}
+		s.index[v] = len(s.elems)
+		s.elems = append(s.elems, v)
+		if s.removed != nil {
+			s.removed = append(s.removed, false)
+		}
+	}
+	return s.Len()
+}
+
+// Remove removes the elements from the set, and returns the new length of the set.
+func (s *Uint16Set) Remove(elements ...uint16) int {
+	for _, v := range elements {
+		k, ok := s.index[v]
+		if !ok {
+			continue
+		}
+		delete(s.index, v)
+		if s.removed == nil {
+			s.removed = make([]bool, len(s.elems))
+		}
+		s.removed[k] = true
+		s.holes++
+	}
+	if s.holes > 0 && s.holes*2 >= len(s.elems) {
+		s.compact()
+	}
+	return s.Len()
+}
+
+// Has reports whether the element is in the set.
+func (s *Uint16Set) Has(element uint16) bool {
+	_, ok := s.index[element]
+	return ok
+}
+
+// Len returns the number of elements in the set.
+func (s *Uint16Set) Len() int {
+	return len(s.index)
+}
+
+// Clear removes all elements from the set.
+func (s *Uint16Set) Clear() {
+	*s = Uint16Set{}
+}
+
+// Clone creates a copy of the set.
+func (s *Uint16Set) Clone() *Uint16Set {
+	r := &Uint16Set{
+		index: make(map[uint16]int, s.Len()),
+		elems: make([]uint16, 0, s.Len()),
+	}
+	s.Range(func(v uint16) bool {
+		r.index[v] = len(r.elems)
+		r.elems = append(r.elems, v)
+		return true
+	})
+	return r
+}
+
+// Range calls fn for each element in insertion order; if fn returns false, range stops the iteration.
+func (s *Uint16Set) Range(fn func(v uint16) bool) {
+	for k, v := range s.elems {
+		if s.removed != nil && s.removed[k] {
+			continue
+		}
+		if !fn(v) {
+			return
+		}
+	}
+}
+
+// RangeSorted calls fn for each element in ascending order; if fn returns false, range stops the iteration.
+func (s *Uint16Set) RangeSorted(fn func(v uint16) bool) {
+	for _, v := range s.SortedSlice() {
+		if !fn(v) {
+			return
+		}
+	}
+}
+
+// Slice returns the elements in insertion order.
+func (s *Uint16Set) Slice() []uint16 {
+	r := make([]uint16, 0, s.Len())
+	s.Range(func(v uint16) bool {
+		r = append(r, v)
+		return true
+	})
+	return r
+}
+
+// SortedSlice returns the elements in ascending order.
+func (s *Uint16Set) SortedSlice() []uint16 {
+	r := s.Slice()
+	sort.Slice(r, func(i, j int) bool { return r[i] < r[j] })
+	return r
+}
+
+// Union returns a new set: s ∪ others...
+func (s *Uint16Set) Union(others ...*Uint16Set) *Uint16Set {
+	r := s.Clone()
+	for _, o := range others {
+		o.Range(func(v uint16) bool {
+			r.Add(v)
+			return true
+		})
+	}
+	return r
+}
+
+// Intersect returns a new set: s ∩ others...
+func (s *Uint16Set) Intersect(others ...*Uint16Set) *Uint16Set {
+	r := NewUint16Set()
+	s.Range(func(v uint16) bool {
+		for _, o := range others {
+			if !o.Has(v) {
+				return true
+			}
+		}
+		r.Add(v)
+		return true
+	})
+	return r
+}
+
+// Difference returns a new set: s - others...
+func (s *Uint16Set) Difference(others ...*Uint16Set) *Uint16Set {
+	r := NewUint16Set()
+	s.Range(func(v uint16) bool {
+		for _, o := range others {
+			if o.Has(v) {
+				return true
+			}
+		}
+		r.Add(v)
+		return true
+	})
+	return r
+}
+
+// SymmetricDifference returns a new set of the elements that are in exactly one of the two sets: s ∆ other.
+func (s *Uint16Set) SymmetricDifference(other *Uint16Set) *Uint16Set {
+	r := s.Difference(other)
+	other.Range(func(v uint16) bool {
+		if !s.Has(v) {
+			r.Add(v)
+		}
+		return true
+	})
+	return r
+}
+
+// IsSubset reports whether every element of s is also in other: s ⊆ other.
+func (s *Uint16Set) IsSubset(other *Uint16Set) bool {
+	if s.Len() > other.Len() {
+		return false
+	}
+	for v := range s.index {
+		if !other.Has(v) {
+			return false
+		}
+	}
+	return true
+}
+
+// IsSuperset reports whether every element of other is also in s: s ⊇ other.
+func (s *Uint16Set) IsSuperset(other *Uint16Set) bool {
+	return other.IsSubset(s)
+}
+
+// Equal reports whether the two sets contain the same elements, regardless of the order.
+func (s *Uint16Set) Equal(other *Uint16Set) bool {
+	return s.Len() == other.Len() && s.IsSubset(other)
+}
+
+func (s *Uint16Set) compact() {
+	elems := s.elems[:0]
+	for k, v := range s.elems {
+		if s.removed[k] {
+			continue
+		}
+		s.index[v] = len(elems)
+		elems = append(elems, v)
+	}
+	s.elems = elems
+	s.removed = nil
+	s.holes = 0
+}
@@ -0,0 +1,373 @@
+package ameda
+
+import (
+	"sync"
+	"sync/atomic"
+)
+
+// SyncUint16s is a uint16 slice that is safe for concurrent use.
+// NOTE:
+//
+//	The zero value is an empty slice ready to use.
+//	Point reads such as Len and At use the read lock without copying the elements.
+//	Callbacks are run on a snapshot, so they can call the methods of the same SyncUint16s.
+type SyncUint16s struct {
+	mu   sync.RWMutex
+	data []uint16
+	snap atomic.Value // []uint16: read-only copy of data, nil after modification
+}
+
+// NewSyncUint16s creates a SyncUint16s with a copy of the elements.
+func NewSyncUint16s(elements ...uint16) *SyncUint16s {
+	return &SyncUint16s{data: Uint16sCopy(elements)}
+}
+
+// Snapshot returns a read-only copy of the current elements.
+// NOTE:
+//
+//	The result is shared with other callers until the next modification, so it must not be changed.
+//	While there is no modification, it is an atomic read without locking.
+func (s *SyncUint16s) Snapshot() []uint16 {
+	if snap, _ := s.snap.Load().([]uint16); snap != nil {
+		return snap
+	}
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	snap := Uint16sCopy(s.data)
+	s.snap.Store(snap)
+	return snap
+}
+
+// Slice returns a copy of the current elements that can be changed.
+func (s *SyncUint16s) Slice() []uint16 {
+	return Uint16sCopy(s.Snapshot())
+}
+
+// Len returns the number of elements.
+func (s *SyncUint16s) Len() int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return len(s.data)
+}
+
+// At returns the element at the index, which can be negative and counted from the end.
+func (s *SyncUint16s) At(index int) (v uint16, ok bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return Uint16sAt(s.data, index)
+}
+
+// Includes determines whether the slice includes a certain element.
+func (s *SyncUint16s) Includes(valueToFind uint16, fromIndex ...int) bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return Uint16sIncludes(s.data, valueToFind, fromIndex...)
+}
+
+// IndexOf returns the first index at which a given element can be found, or -1 if it is not present.
+func (s *SyncUint16s) IndexOf(searchElement uint16, fromIndex ...int) int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return Uint16sIndexOf(s.data, searchElement, fromIndex...)
+}
+
+// LastIndexOf returns the last index at which a given element can be found, or -1 if it is not present.
+func (s *SyncUint16s) LastIndexOf(searchElement uint16, fromIndex ...int) int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return Uint16sLastIndexOf(s.data, searchElement, fromIndex...)
+}
+
+// Range calls fn for each element of a snapshot in order, until fn returns false.
+func (s *SyncUint16s) Range(fn func(k int, v uint16) bool) {
+	for k, v := range s.Snapshot() {
+		if !fn(k, v) {
+			return
+		}
+	}
+}
+
+// Every tests whether all elements of a snapshot pass the test implemented by fn.
+func (s *SyncUint16s) Every(fn func(u []uint16, k int, v uint16) bool) bool {
+	return Uint16sEvery(s.Snapshot(), fn)
+}
+
+// Some tests whether at least one element of a snapshot passes the test implemented by fn.
+func (s *SyncUint16s) Some(fn func(u []uint16, k int, v uint16) bool) bool {
+	return Uint16sSome(s.Snapshot(), fn)
+}
+
+// Find returns the key-value of the first element of a snapshot that satisfies fn.
+// If no element satisfies fn, -1 is returned.
+func (s *SyncUint16s) Find(fn func(u []uint16, k int, v uint16) bool) (k int, v uint16) {
+	return Uint16sFind(s.Snapshot(), fn)
+}
+
+// Filter creates a new slice with the elements of a snapshot that pass the test implemented by fn.
+func (s *SyncUint16s) Filter(fn func(u []uint16, k int, v uint16) bool) []uint16 {
+	return Uint16sFilter(s.Snapshot(), fn)
+}
+
+// Map creates a new slice with the results of calling fn on every element of a snapshot.
+func (s *SyncUint16s) Map(fn func(u []uint16, k int, v uint16) uint16) []uint16 {
+	return Uint16sMap(s.Snapshot(), fn)
+}
+
+// Reduce executes fn on each element of a snapshot, resulting in a single output value.
+func (s *SyncUint16s) Reduce(fn func(u []uint16, k int, v, accumulator uint16) uint16, initialValue ...uint16) uint16 {
+	return Uint16sReduce(s.Snapshot(), fn, initialValue...)
+}
+
+// Set changes the element at the index, which can be negative and counted from the end,
+// and reports whether the index is in range.
+func (s *SyncUint16s) Set(index int, value uint16) bool {
+	s.mu.Lock()
+	defer s.unlock()
+	k, ok := atIndex(len(s.data), index)
+	if ok {
+		s.data[k] = value
+	}
+	return ok
+}
+
+// Push adds one or more elements to the end, and returns the new length.
+func (s *SyncUint16s) Push(element ...uint16) int {
+	s.mu.Lock()
+	defer s.unlock()
+	return Uint16sPush(&s.data, element...)
+}
+
+// PushDistinct adds one or more new elements that do not exist at the end, and returns the new length.
+func (s *SyncUint16s) PushDistinct(element ...uint16) int {
+	s.mu.Lock()
+	defer s.unlock()
+	s.data = Uint16sPushDistinct(s.data, element...)
+	return len(s.data)
+}
+
+// Pop removes the last element and returns that element.
+func (s *SyncUint16s) Pop() (uint16, bool) {
+	s.mu.Lock()
+	defer s.unlock()
+	return Uint16sPop(&s.data)
+}
+
+// Shift removes the first element and returns that element.
+func (s *SyncUint16s) Shift() (uint16, bool) {
+	s.mu.Lock()
+	defer s.unlock()
+	return Uint16sShift(&s.data)
+}
+
+// Unshift adds one or more elements to the beginning, and returns the new length.
+func (s *SyncUint16s) Unshift(element ...uint16) int {
+	s.mu.Lock()
+	defer s.unlock()
+	return Uint16sUnshift(&s.data, element...)
+}
+
+// UnshiftDistinct adds one or more new elements that do not exist to the beginning, and returns the new length.
+func (s *SyncUint16s) UnshiftDistinct(element ...uint16) int {
+	s.mu.Lock()
+	defer s.unlock()
+	return Uint16sUnshiftDistinct(&s.data, element...)
+}
+
+// Splice removes deleteCount elements from start and adds the items in their place.
+func (s *SyncUint16s) Splice(start, deleteCount int, items ...uint16) {
+	s.mu.Lock()
+	defer s.unlock()
+	Uint16sSplice(&s.data, start, deleteCount, items...)
+}
+
+// RemoveFirst removes the first matched elements, and returns the new length.
+func (s *SyncUint16s) RemoveFirst(elements ...uint16) int {
+	s.mu.Lock()
+	defer s.unlock()
+	return Uint16sRemoveFirst(&s.data, elements...)
+}
+
+// RemoveEvery removes all the elements, and returns the new length.
+func (s *SyncUint16s) RemoveEvery(elements ...uint16) int {
+	s.mu.Lock()
+	defer s.unlock()
+	return Uint16sRemoveEvery(&s.data, elements...)
+}
+
+// Distinct removes the duplicate elements, and returns the count of each element.
+func (s *SyncUint16s) Distinct() (distinctCount map[uint16]int) {
+	s.mu.Lock()
+	defer s.unlock()
+	return Uint16sDistinct(&s.data, true)
+}
+
+// Fill changes all elements from start to end to a static value.
+func (s *SyncUint16s) Fill(value uint16, start int, end ...int) {
+	s.mu.Lock()
+	defer s.unlock()
+	Uint16sFill(s.data, value, start, end...)
+}
+
+// CopyWithin copies part of the slice to another location in the same slice.
+func (s *SyncUint16s) CopyWithin(target, start int, end ...int) {
+	s.mu.Lock()
+	defer s.unlock()
+	Uint16sCopyWithin(s.data, target, start, end...)
+}
+
+// Reverse reverses the elements in place.
+func (s *SyncUint16s) Reverse() {
+	s.mu.Lock()
+	defer s.unlock()
+	Uint16sReverse(s.data)
+}
+
+// Shuffle randomizes the order of the elements in place.
+func (s *SyncUint16s) Shuffle(r Randomizer) {
+	s.mu.Lock()
+	defer s.unlock()
+	Uint16sShuffle(s.data, r)
+}
+
+// ApplyPatch applies the edit script to the elements.
+func (s *SyncUint16s) ApplyPatch(patch []Uint16sEdit) error {
+	s.mu.Lock()
+	defer s.unlock()
+	return Uint16sApplyPatch(&s.data, patch)
+}
+
+// Update calls fn with the pointer of the elements under the write lock.
+// NOTE:
+//
+//	fn must not call the methods of the same SyncUint16s, and must not retain the slice.
+func (s *SyncUint16s) Update(fn func(p *[]uint16)) {
+	s.mu.Lock()
+	defer s.unlock()
+	fn(&s.data)
+}
+
+// Clear removes all elements.
+func (s *SyncUint16s) Clear() {
+	s.mu.Lock()
+	defer s.unlock()
+	s.data = nil
+}
+
+// unlock invalidates the snapshot and releases the write lock.
+func (s *SyncUint16s) unlock() {
+	s.snap.Store([]uint16(nil))
+	s.mu.Unlock()
+}
+
+// SyncUint16Set is a uint16 set that is safe for concurrent use.
+// NOTE:
+//
+//	The zero value is an empty set ready to use.
+//	Len and Has use the read lock without copying the elements.
+//	Callbacks are run on a snapshot, so they can call the methods of the same SyncUint16Set.
+type SyncUint16Set struct {
+	mu   sync.RWMutex
+	set  *Uint16Set
+	snap atomic.Value // []uint16: read-only elements in insertion order, nil after modification
+}
+
+// NewSyncUint16Set creates a SyncUint16Set with the elements.
+func NewSyncUint16Set(elements ...uint16) *SyncUint16Set {
+	return &SyncUint16Set{set: NewUint16Set(elements...)}
+}
+
+// Snapshot returns the read-only elements in insertion order.
+// NOTE:
+//
+//	The result is shared with other callers until the next modification, so it must not be changed.
+//	While there is no modification, it is an atomic read without locking.
+func (s *SyncUint16Set) Snapshot() []uint16 {
+	if snap, _ := s.snap.Load().([]uint16); snap != nil {
+		return snap
+	}
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	var snap []uint16
+	if s.set != nil {
+		snap = s.set.Slice()
+	}
+	if snap == nil {
+		snap = []uint16{}
+	}
+	s.snap.Store(snap)
+	return snap
+}
+
+// Add adds the elements that are not yet in the set, and returns the new length of the set.
+func (s *SyncUint16Set) Add(elements ...uint16) int {
+	s.mu.Lock()
+	defer s.unlock()
+	if s.set == nil {
+		s.set = NewUint16Set()
+	}
+	return s.set.Add(elements...)
+}
+
+// Remove removes the elements from the set, and returns the new length of the set.
+func (s *SyncUint16Set) Remove(elements ...uint16) int {
+	s.mu.Lock()
+	defer s.unlock()
+	if s.set == nil {
+		return 0
+	}
+	return s.set.Remove(elements...)
+}
+
+// Has reports whether the element is in the set.
+func (s *SyncUint16Set) Has(element uint16) bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.set != nil && s.set.Has(element)
+}
+
+// Len returns the number of elements in the set.
+func (s *SyncUint16Set) Len() int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	if s.set == nil {
+		return 0
+	}
+	return s.set.Len()
+}
+
+// Clear removes all elements.
+func (s *SyncUint16Set) Clear() {
+	s.mu.Lock()
+	defer s.unlock()
+	s.set = nil
+}
+
+// Range calls fn for each element of a snapshot in insertion order, until fn returns false.
+func (s *SyncUint16Set) Range(fn func(v uint16) bool) {
+	for _, v := range s.Snapshot() {
+		if !fn(v) {
+			return
+		}
+	}
+}
+
+// Slice returns a copy of the elements in insertion order.
+func (s *SyncUint16Set) Slice() []uint16 {
+	return Uint16sCopy(s.Snapshot())
+}
+
+// SortedSlice returns a copy of the elements in ascending order.
+func (s *SyncUint16Set) SortedSlice() []uint16 {
+	return NewUint16Set(s.Snapshot()...).SortedSlice()
+}
+
+// Clone returns a Uint16Set with the current elements.
+func (s *SyncUint16Set) Clone() *Uint16Set {
+	return NewUint16Set(s.Snapshot()...)
+}
+
+// unlock invalidates the snapshot and releases the write lock.
+func (s *SyncUint16Set) unlock() {
+	s.snap.Store([]uint16(nil))
+	s.mu.Unlock()
+}
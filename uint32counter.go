@@ -0,0 +1,164 @@
+package ameda
+
+import "sort"
+
+// Uint32Counter is a multiset that maps each uint32 element to its count.
+// NOTE:
+//
+//	Only positive counts are kept.
+//	It has the same underlying type as the result of Uint32sDistinct and Uint32sIntersect,
+//	so they can be converted to each other directly.
+type Uint32Counter map[uint32]int
+
+// Uint32CounterItem is an element with its count.
+type Uint32CounterItem struct {
+	Element uint32
+	Count   int
+}
+
+// NewUint32Counter creates a counter that counts the elements.
+func NewUint32Counter(elements ...uint32) Uint32Counter {
+	c := make(Uint32Counter, len(elements))
+	c.Add(elements...)
+	return c
+}
+
+// Uint32sDistinctCounter is like Uint32sDistinct, but returns a counter.
+func Uint32sDistinctCounter(u *[]uint32, changeSlice bool) Uint32Counter {
+	return Uint32Counter(Uint32sDistinct(u, changeSlice))
+}
+
+// Uint32sIntersectCounter is like Uint32sIntersect, but returns a counter, which is empty but not nil if there is no common element.
+func Uint32sIntersectCounter(u ...[]uint32) Uint32Counter {
+	if c := Uint32sIntersect(u...); c != nil {
+		return Uint32Counter(c)
+	}
+	return make(Uint32Counter)
+}
+
+// Add increases the count of each element by 1.
+func (c Uint32Counter) Add(elements ...uint32) {
+	for _, v := range elements {
+		c[v]++
+	}
+}
+
+// AddN increases the count of the element by n, and returns the new count.
+// NOTE:
+//
+//	If the count is no longer positive, the element is removed.
+func (c Uint32Counter) AddN(element uint32, n int) int {
+	n += c[element]
+	if n <= 0 {
+		delete(c, element)
+		return 0
+	}
+	c[element] = n
+	return n
+}
+
+// Subtract decreases the count of each element by 1.
+// NOTE:
+//
+//	If the count is no longer positive, the element is removed.
+func (c Uint32Counter) Subtract(elements ...uint32) {
+	for _, v := range elements {
+		c.AddN(v, -1)
+	}
+}
+
+// Get returns the count of the element.
+func (c Uint32Counter) Get(element uint32) int {
+	return c[element]
+}
+
+// Total returns the sum of all counts.
+func (c Uint32Counter) Total() int {
+	var n int
+	for _, v := range c {
+		n += v
+	}
+	return n
+}
+
+// Clone creates a copy of the counter.
+func (c Uint32Counter) Clone() Uint32Counter {
+	r := make(Uint32Counter, len(c))
+	for k, v := range c {
+		r[k] = v
+	}
+	return r
+}
+
+// Union returns a new counter with the maximum count of each element: c ∪ others...
+func (c Uint32Counter) Union(others ...Uint32Counter) Uint32Counter {
+	r := c.Clone()
+	for _, o := range others {
+		for k, v := range o {
+			if v > r[k] {
+				r[k] = v
+			}
+		}
+	}
+	return r
+}
+
+// Intersect returns a new counter with the minimum count of each element: c ∩ others...
+func (c Uint32Counter) Intersect(others ...Uint32Counter) Uint32Counter {
+	r := make(Uint32Counter, len(c))
+L:
+	for k, v := range c {
+		for _, o := range others {
+			v2 := o[k]
+			if v2 <= 0 {
+				continue L
+			}
+			if v > v2 {
+				v = v2
+			}
+		}
+		r[k] = v
+	}
+	return r
+}
+
+// MostCommon returns the k most common elements from the most to the least common,
+// elements with equal counts are in ascending order.
+// NOTE:
+//
+//	If k < 0, returns all elements.
+func (c Uint32Counter) MostCommon(k int) []Uint32CounterItem {
+	items := make([]Uint32CounterItem, 0, len(c))
+	for e, n := range c {
+		items = append(items, Uint32CounterItem{Element: e, Count: n})
+	}
+	sort.Slice(items, func(i, j int) bool {
+		if items[i].Count != items[j].Count {
+			return items[i].Count > items[j].Count
+		}
+		return items[i].Element < items[j].Element
+	})
+	if k >= 0 && k < len(items) {
+		items = items[:k]
+	}
+	return items
+}
+
+// SortedElements returns the distinct elements in ascending order.
+func (c Uint32Counter) SortedElements() []uint32 {
+	r := make([]uint32, 0, len(c))
+	for k := range c {
+		r = append(r, k)
+	}
+	sort.Slice(r, func(i, j int) bool { return r[i] < r[j] })
+	return r
+}
+
+// RangeSorted calls fn for each element in ascending order; if fn returns false, range stops the iteration.
+func (c Uint32Counter) RangeSorted(fn func(element uint32, count int) bool) {
+	for _, k := range c.SortedElements() {
+		if !fn(k, c[k]) {
+			return
+		}
+	}
+}
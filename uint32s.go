@@ -1,5 +1,10 @@
 package ameda
 
+import (
+	"fmt"
+	"sort"
+)
+
 // OneUint32 try to return the first element, otherwise return zero value.
 func OneUint32(u []uint32) uint32 {
 	if len(u) > 0 {
@@ -449,26 +454,20 @@ func Uint32sSplice(u *[]uint32, start, deleteCount int, items ...uint32) {
 	if deleteCount < 0 {
 		deleteCount = 0
 	}
-	start, end, _ := fixRange(len(a), start, start+1+deleteCount)
-	deleteCount = end - start - 1
-	for k := 0; k < len(items); k++ {
-		if deleteCount > 0 {
-			// replace
-			a[start] = items[k]
-			deleteCount--
-			start++
-		} else {
-			// insert
-			lastSlice := Uint32sCopy(a[start:])
-			items = items[k:]
-			a = append(a[:start], items...)
-			a = append(a[:start+len(items)], lastSlice...)
-			*u = a[:len(a):len(a)]
-			return
-		}
+	start = fixIndex(len(a), start, true)
+	if rest := len(a) - start; deleteCount > rest {
+		deleteCount = rest
 	}
-	if deleteCount > 0 {
-		a = append(a[:start], a[start+1+deleteCount:]...)
+	// replace
+	n := copy(a[start:start+deleteCount], items)
+	if n == len(items) {
+		// delete the rest
+		a = append(a[:start+n], a[start+deleteCount:]...)
+	} else {
+		// insert the rest
+		lastSlice := Uint32sCopy(a[start+deleteCount:])
+		a = append(a[:start+deleteCount], items[n:]...)
+		a = append(a, lastSlice...)
 	}
 	*u = a[:len(a):len(a)]
 }
@@ -697,3 +696,558 @@ func Uint32SetDifference(set1, set2 []uint32, others ...[]uint32) []uint32 {
 	}
 	return r
 }
+
+// Uint32sIsSorted reports whether the slice is sorted in ascending order.
+func Uint32sIsSorted(u []uint32) bool {
+	for k := 1; k < len(u); k++ {
+		if u[k] < u[k-1] {
+			return false
+		}
+	}
+	return true
+}
+
+// Uint32SortedSetUnion calculates between multiple sorted collections: set1 ∪ set2 ∪ others...
+// NOTE:
+//
+//	Every set must be sorted in ascending order (see Uint32sIsSorted), and the result is sorted too.
+//	It merges the sets in O(n+m) without building any map.
+//	This method does not change the existing slices, but instead returns a new slice.
+func Uint32SortedSetUnion(set1, set2 []uint32, others ...[]uint32) []uint32 {
+	r := uint32SortedSetUnion(set1, set2)
+	for _, set := range others {
+		r = uint32SortedSetUnion(r, set)
+	}
+	return r
+}
+
+// Uint32SortedSetIntersect calculates between multiple sorted collections: set1 ∩ set2 ∩ others...
+// NOTE:
+//
+//	Every set must be sorted in ascending order (see Uint32sIsSorted), and the result is sorted too.
+//	It merges the sets in O(n+m) without building any map.
+//	This method does not change the existing slices, but instead returns a new slice.
+func Uint32SortedSetIntersect(set1, set2 []uint32, others ...[]uint32) []uint32 {
+	r := uint32SortedSetIntersect(set1, set2)
+	for _, set := range others {
+		r = uint32SortedSetIntersect(r, set)
+	}
+	return r
+}
+
+// Uint32SortedSetDifference calculates between multiple sorted collections: set1 - set2 - others...
+// NOTE:
+//
+//	Every set must be sorted in ascending order (see Uint32sIsSorted), and the result is sorted too.
+//	It merges the sets in O(n+m) without building any map.
+//	This method does not change the existing slices, but instead returns a new slice.
+func Uint32SortedSetDifference(set1, set2 []uint32, others ...[]uint32) []uint32 {
+	r := uint32SortedSetDifference(set1, set2)
+	for _, set := range others {
+		r = uint32SortedSetDifference(r, set)
+	}
+	return r
+}
+
+// Uint32SortedSetSymmetricDifference calculates between multiple sorted collections: set1 ∆ set2 ∆ others...
+// NOTE:
+//
+//	Every set must be sorted in ascending order (see Uint32sIsSorted), and the result is sorted too.
+//	It merges the sets in O(n+m) without building any map.
+//	This method does not change the existing slices, but instead returns a new slice.
+func Uint32SortedSetSymmetricDifference(set1, set2 []uint32, others ...[]uint32) []uint32 {
+	r := uint32SortedSetSymmetricDifference(set1, set2)
+	for _, set := range others {
+		r = uint32SortedSetSymmetricDifference(r, set)
+	}
+	return r
+}
+
+// Uint32SortedSetIsSubset reports whether every element of sub is also in set: sub ⊆ set.
+// NOTE:
+//
+//	Both sets must be sorted in ascending order (see Uint32sIsSorted).
+func Uint32SortedSetIsSubset(sub, set []uint32) bool {
+	j := 0
+	for k := 0; k < len(sub); {
+		if j >= len(set) || sub[k] < set[j] {
+			return false
+		}
+		if set[j] < sub[k] {
+			j++
+			continue
+		}
+		k++
+	}
+	return true
+}
+
+func uint32SortedSetUnion(a, b []uint32) []uint32 {
+	r := make([]uint32, 0, len(a)+len(b))
+	i, j := 0, 0
+	for i < len(a) || j < len(b) {
+		var v uint32
+		switch {
+		case j >= len(b) || i < len(a) && a[i] < b[j]:
+			v = a[i]
+			i++
+		case i >= len(a) || b[j] < a[i]:
+			v = b[j]
+			j++
+		default:
+			v = a[i]
+			i++
+			j++
+		}
+		if n := len(r); n == 0 || r[n-1] != v {
+			r = append(r, v)
+		}
+	}
+	return r
+}
+
+func uint32SortedSetIntersect(a, b []uint32) []uint32 {
+	n := len(a)
+	if len(b) < n {
+		n = len(b)
+	}
+	r := make([]uint32, 0, n)
+	i, j := 0, 0
+	for i < len(a) && j < len(b) {
+		switch {
+		case a[i] < b[j]:
+			i++
+		case b[j] < a[i]:
+			j++
+		default:
+			v := a[i]
+			if n := len(r); n == 0 || r[n-1] != v {
+				r = append(r, v)
+			}
+			i++
+			j++
+		}
+	}
+	return r
+}
+
+func uint32SortedSetDifference(a, b []uint32) []uint32 {
+	r := make([]uint32, 0, len(a))
+	j := 0
+	for _, v := range a {
+		for j < len(b) && b[j] < v {
+			j++
+		}
+		if j < len(b) && b[j] == v {
+			continue
+		}
+		if n := len(r); n == 0 || r[n-1] != v {
+			r = append(r, v)
+		}
+	}
+	return r
+}
+
+func uint32SortedSetSymmetricDifference(a, b []uint32) []uint32 {
+	r := make([]uint32, 0, len(a)+len(b))
+	i, j := 0, 0
+	for i < len(a) || j < len(b) {
+		var v uint32
+		switch {
+		case j >= len(b) || i < len(a) && a[i] < b[j]:
+			v = a[i]
+			i++
+		case i >= len(a) || b[j] < a[i]:
+			v = b[j]
+			j++
+		default:
+			v = a[i]
+			for i < len(a) && a[i] == v {
+				i++
+			}
+			for j < len(b) && b[j] == v {
+				j++
+			}
+			continue
+		}
+		if n := len(r); n == 0 || r[n-1] != v {
+			r = append(r, v)
+		}
+	}
+	return r
+}
+
+// Uint32sEdit is an edit run of the edit script produced by Uint32sDiff.
+type Uint32sEdit struct {
+	Kind   DiffKind
+	Values []uint32
+}
+
+// Uint32sDiff computes the shortest edit script that turns slice a into slice b, see also DiffFunc.
+// This method does not change the existing slices, but instead returns the runs of edits.
+func Uint32sDiff(a, b []uint32) []Uint32sEdit {
+	spans := DiffFunc(len(a), len(b), func(i, j int) bool { return a[i] == b[j] })
+	r := make([]Uint32sEdit, len(spans))
+	for k, span := range spans {
+		r[k].Kind = span.Kind
+		if span.Kind == DiffInsert {
+			r[k].Values = Uint32sCopy(b[span.B : span.B+span.Len])
+		} else {
+			r[k].Values = Uint32sCopy(a[span.A : span.A+span.Len])
+		}
+	}
+	return r
+}
+
+// Uint32sApplyPatch changes the contents of an slice in place by applying the edit script produced by Uint32sDiff.
+// NOTE:
+//
+//	If the slice does not match the equal and delete runs of the patch, it returns an error
+//	and the slice will not be modified.
+func Uint32sApplyPatch(p *[]uint32, patch []Uint32sEdit) error {
+	a := *p
+	r := make([]uint32, 0, len(a))
+	pos := 0
+	for _, e := range patch {
+		switch e.Kind {
+		case DiffInsert:
+			r = append(r, e.Values...)
+		case DiffEqual, DiffDelete:
+			if pos+len(e.Values) > len(a) {
+				return fmt.Errorf("patch mismatch: %s run exceeds the slice length %d", e.Kind, len(a))
+			}
+			for k, v := range e.Values {
+				if a[pos+k] != v {
+					return fmt.Errorf("patch mismatch: %s run at index %d", e.Kind, pos+k)
+				}
+			}
+			if e.Kind == DiffEqual {
+				r = append(r, e.Values...)
+			}
+			pos += len(e.Values)
+		default:
+			return fmt.Errorf("patch mismatch: unknown edit kind %d", e.Kind)
+		}
+	}
+	if pos != len(a) {
+		return fmt.Errorf("patch mismatch: the slice has %d unpatched elements", len(a)-pos)
+	}
+	n := len(r)
+	*p = r[:n:n]
+	return nil
+}
+
+// Uint32sShuffle randomizes the order of elements in place.
+// @r
+//
+//	The source of random numbers, nil means the global source of package math/rand.
+func Uint32sShuffle(u []uint32, r Randomizer) {
+	shuffleIndex(len(u), len(u), r, func(x, y int) { u[x], u[y] = u[y], u[x] })
+}
+
+// Uint32sSample returns k elements randomly selected from the slice without replacement.
+// If k is greater than the length of the slice, all the elements are returned in random order.
+// This method does not change the existing slice, but instead returns a new slice.
+// @r
+//
+//	The source of random numbers, nil means the global source of package math/rand.
+func Uint32sSample(u []uint32, k int, r Randomizer) []uint32 {
+	if k <= 0 {
+		return []uint32{}
+	}
+	a := Uint32sCopy(u)
+	shuffleIndex(len(a), k, r, func(x, y int) { a[x], a[y] = a[y], a[x] })
+	if k > len(a) {
+		k = len(a)
+	}
+	return a[:k:k]
+}
+
+// Uint32sSampleWithReplacement returns k elements randomly selected from the slice with replacement.
+// This method does not change the existing slice, but instead returns a new slice.
+// @r
+//
+//	The source of random numbers, nil means the global source of package math/rand.
+func Uint32sSampleWithReplacement(u []uint32, k int, r Randomizer) []uint32 {
+	if k <= 0 || len(u) == 0 {
+		return []uint32{}
+	}
+	r = getRandomizer(r)
+	ret := make([]uint32, k)
+	for n := range ret {
+		ret[n] = u[r.Intn(len(u))]
+	}
+	return ret
+}
+
+// Uint32sWeightedChoice returns the key-value of an element randomly selected
+// with the probability proportional to its weight.
+// @weights
+//
+//	The weights of the elements with the same indexes, must be finite and non-negative.
+//
+// @r
+//
+//	The source of random numbers, nil means the global source of package math/rand.
+//
+// NOTE:
+//
+//	If the slice is empty, or the weights are invalid, returns an error and k = -1
+func Uint32sWeightedChoice(u []uint32, weights []float64, r Randomizer) (k int, v uint32, err error) {
+	k, err = weightedIndex(len(u), weights, r)
+	if err != nil {
+		return k, v, err
+	}
+	return k, u[k], nil
+}
+
+// Uint32sReservoirSample returns k elements randomly selected from a stream of unknown length,
+// in a single pass and with O(k) memory.
+// @next
+//
+//	Returns the next element of the stream, or ok = false at the end of the stream.
+//
+// @r
+//
+//	The source of random numbers, nil means the global source of package math/rand.
+func Uint32sReservoirSample(next func() (v uint32, ok bool), k int, r Randomizer) []uint32 {
+	if k <= 0 {
+		return []uint32{}
+	}
+	r = getRandomizer(r)
+	ret := make([]uint32, 0, k)
+	for seen := 0; ; seen++ {
+		v, ok := next()
+		if !ok {
+			return ret
+		}
+		switch slot := reservoirIndex(seen, k, r); {
+		case slot == len(ret):
+			ret = append(ret, v)
+		case slot >= 0:
+			ret[slot] = v
+		}
+	}
+}
+
+// Uint32sAt returns the element at the given index.
+// @index
+//
+//	Zero-based index of the element. If negative, index will be counted from the end.
+//
+// NOTE:
+//
+//	If the index is out of range, ok = false
+func Uint32sAt(u []uint32, index int) (v uint32, ok bool) {
+	index, ok = atIndex(len(u), index)
+	if !ok {
+		return v, false
+	}
+	return u[index], true
+}
+
+// Uint32sWith returns a copy of the slice with the element at the given index replaced with the value.
+// @index
+//
+//	Zero-based index of the element. If negative, index will be counted from the end.
+//
+// NOTE:
+//
+//	If the index is out of range, ok = false
+func Uint32sWith(u []uint32, index int, value uint32) (ret []uint32, ok bool) {
+	ret = Uint32sCopy(u)
+	index, ok = atIndex(len(u), index)
+	if ok {
+		ret[index] = value
+	}
+	return ret, ok
+}
+
+// Uint32sFindIndex returns the index of the first element in the provided slice that satisfies the provided testing function.
+// NOTE:
+//
+//	If not found, returns -1
+func Uint32sFindIndex(u []uint32, fn func(u []uint32, k int, v uint32) bool) int {
+	k, _ := Uint32sFind(u, fn)
+	return k
+}
+
+// Uint32sFindLast returns the key-value of the last element in the provided slice that satisfies the provided testing function.
+// NOTE:
+//
+//	If not found, k = -1
+func Uint32sFindLast(u []uint32, fn func(u []uint32, k int, v uint32) bool) (k int, v uint32) {
+	for k := len(u) - 1; k >= 0; k-- {
+		if fn(u, k, u[k]) {
+			return k, u[k]
+		}
+	}
+	return -1, v
+}
+
+// Uint32sFindLastIndex returns the index of the last element in the provided slice that satisfies the provided testing function.
+// NOTE:
+//
+//	If not found, returns -1
+func Uint32sFindLastIndex(u []uint32, fn func(u []uint32, k int, v uint32) bool) int {
+	k, _ := Uint32sFindLast(u, fn)
+	return k
+}
+
+// Uint32sFlat creates a new slice with all sub-slice elements concatenated into it.
+func Uint32sFlat(u [][]uint32) []uint32 {
+	return Uint32sConcat(u...)
+}
+
+// Uint32sFlatMap creates a new slice populated with the results of calling a provided function
+// on every element in the calling slice, and then flattening the results.
+func Uint32sFlatMap(u []uint32, fn func(u []uint32, k int, v uint32) []uint32) []uint32 {
+	ret := make([]uint32, 0, len(u))
+	for k, v := range u {
+		ret = append(ret, fn(u, k, v)...)
+	}
+	return ret
+}
+
+// Uint32sToSorted returns a copy of the slice sorted stably.
+// @less
+//
+//	Reports whether the element a must sort before the element b. If nil, sorts in ascending order.
+func Uint32sToSorted(u []uint32, less func(a, b uint32) bool) []uint32 {
+	ret := Uint32sCopy(u)
+	if less == nil {
+		less = func(a, b uint32) bool { return a < b }
+	}
+	sort.SliceStable(ret, func(x, y int) bool { return less(ret[x], ret[y]) })
+	return ret
+}
+
+// Uint32sToReversed returns a copy of the slice in reversed order.
+func Uint32sToReversed(u []uint32) []uint32 {
+	ret := Uint32sCopy(u)
+	Uint32sReverse(ret)
+	return ret
+}
+
+// Uint32sToSpliced returns a copy of the slice with some elements removed and/or replaced at a given index,
+// see also Uint32sSplice.
+func Uint32sToSpliced(u []uint32, start, deleteCount int, items ...uint32) []uint32 {
+	ret := Uint32sCopy(u)
+	Uint32sSplice(&ret, start, deleteCount, items...)
+	return ret
+}
+
+// Uint32sKeys returns an iterator of the indexes of the slice.
+// NOTE:
+//
+//	The iterator returns ok = false when it is exhausted.
+func Uint32sKeys(u []uint32) (next func() (k int, ok bool)) {
+	k := -1
+	return func() (int, bool) {
+		if k+1 >= len(u) {
+			return -1, false
+		}
+		k++
+		return k, true
+	}
+}
+
+// Uint32sValues returns an iterator of the elements of the slice.
+// NOTE:
+//
+//	The iterator returns ok = false when it is exhausted.
+func Uint32sValues(u []uint32) (next func() (v uint32, ok bool)) {
+	k := -1
+	return func() (v uint32, ok bool) {
+		if k+1 >= len(u) {
+			return v, false
+		}
+		k++
+		return u[k], true
+	}
+}
+
+// Uint32sEntries returns an iterator of the key-value pairs of the slice.
+// NOTE:
+//
+//	The iterator returns ok = false when it is exhausted.
+func Uint32sEntries(u []uint32) (next func() (k int, v uint32, ok bool)) {
+	k := -1
+	return func() (int, uint32, bool) {
+		if k+1 >= len(u) {
+			var v uint32
+			return -1, v, false
+		}
+		k++
+		return k, u[k], true
+	}
+}
+
+// Uint32sGroup groups the elements of the slice according to the string keys returned by the provided function.
+func Uint32sGroup(u []uint32, fn func(u []uint32, k int, v uint32) string) map[string][]uint32 {
+	ret := make(map[string][]uint32)
+	for k, v := range u {
+		key := fn(u, k, v)
+		ret[key] = append(ret[key], v)
+	}
+	return ret
+}
+
+// Uint32sGroupToMap groups the elements of the slice according to the keys returned by the provided function.
+// NOTE:
+//
+//	The keys must be comparable.
+func Uint32sGroupToMap(u []uint32, fn func(u []uint32, k int, v uint32) interface{}) map[interface{}][]uint32 {
+	ret := make(map[interface{}][]uint32)
+	for k, v := range u {
+		key := fn(u, k, v)
+		ret[key] = append(ret[key], v)
+	}
+	return ret
+}
+
+// Uint32sEqual reports whether a and b have the same length and the same elements in the same order.
+func Uint32sEqual(a, b []uint32) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for k, v := range a {
+		if v != b[k] {
+			return false
+		}
+	}
+	return true
+}
+
+// Uint32sCompare compares a and b lexicographically, and returns -1 if a < b, 0 if a == b, or +1 if a > b.
+// NOTE:
+//
+//	A slice that is a prefix of another slice is less than it.
+func Uint32sCompare(a, b []uint32) int {
+	for k := 0; k < len(a) && k < len(b); k++ {
+		if c := compareUint32(a[k], b[k]); c != 0 {
+			return c
+		}
+	}
+	return compareInt(len(a), len(b))
+}
+
+// Uint32sHasPrefix reports whether the slice u begins with prefix.
+func Uint32sHasPrefix(u, prefix []uint32) bool {
+	return len(u) >= len(prefix) && Uint32sEqual(u[:len(prefix)], prefix)
+}
+
+// Uint32sHasSuffix reports whether the slice u ends with suffix.
+func Uint32sHasSuffix(u, suffix []uint32) bool {
+	return len(u) >= len(suffix) && Uint32sEqual(u[len(u)-len(suffix):], suffix)
+}
+
+func compareUint32(a, b uint32) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+	return 0
+}
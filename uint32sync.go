@@ -0,0 +1,373 @@
+package ameda
+
+import (
+	"sync"
+	"sync/atomic"
+)
+
+// SyncUint32s is a uint32 slice that is safe for concurrent use.
+// NOTE:
+//
+//	The zero value is an empty slice ready to use.
+//	Point reads such as Len and At use the read lock without copying the elements.
+//	Callbacks are run on a snapshot, so they can call the methods of the same SyncUint32s.
+type SyncUint32s struct {
+	mu   sync.RWMutex
+	data []uint32
+	snap atomic.Value // []uint32: read-only copy of data, nil after modification
+}
+
+// NewSyncUint32s creates a SyncUint32s with a copy of the elements.
+func NewSyncUint32s(elements ...uint32) *SyncUint32s {
+	return &SyncUint32s{data: Uint32sCopy(elements)}
+}
+
+// Snapshot returns a read-only copy of the current elements.
+// NOTE:
+//
+//	The result is shared with other callers until the next modification, so it must not be changed.
+//	While there is no modification, it is an atomic read without locking.
+func (s *SyncUint32s) Snapshot() []uint32 {
+	if snap, _ := s.snap.Load().([]uint32); snap != nil {
+		return snap
+	}
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	snap := Uint32sCopy(s.data)
+	s.snap.Store(snap)
+	return snap
+}
+
+// Slice returns a copy of the current elements that can be changed.
+func (s *SyncUint32s) Slice() []uint32 {
+	return Uint32sCopy(s.Snapshot())
+}
+
+// Len returns the number of elements.
+func (s *SyncUint32s) Len() int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return len(s.data)
+}
+
+// At returns the element at the index, which can be negative and counted from the end.
+func (s *SyncUint32s) At(index int) (v uint32, ok bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return Uint32sAt(s.data, index)
+}
+
+// Includes determines whether the slice includes a certain element.
+func (s *SyncUint32s) Includes(valueToFind uint32, fromIndex ...int) bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return Uint32sIncludes(s.data, valueToFind, fromIndex...)
+}
+
+// IndexOf returns the first index at which a given element can be found, or -1 if it is not present.
+func (s *SyncUint32s) IndexOf(searchElement uint32, fromIndex ...int) int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return Uint32sIndexOf(s.data, searchElement, fromIndex...)
+}
+
+// LastIndexOf returns the last index at which a given element can be found, or -1 if it is not present.
+func (s *SyncUint32s) LastIndexOf(searchElement uint32, fromIndex ...int) int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return Uint32sLastIndexOf(s.data, searchElement, fromIndex...)
+}
+
+// Range calls fn for each element of a snapshot in order, until fn returns false.
+func (s *SyncUint32s) Range(fn func(k int, v uint32) bool) {
+	for k, v := range s.Snapshot() {
+		if !fn(k, v) {
+			return
+		}
+	}
+}
+
+// Every tests whether all elements of a snapshot pass the test implemented by fn.
+func (s *SyncUint32s) Every(fn func(u []uint32, k int, v uint32) bool) bool {
+	return Uint32sEvery(s.Snapshot(), fn)
+}
+
+// Some tests whether at least one element of a snapshot passes the test implemented by fn.
+func (s *SyncUint32s) Some(fn func(u []uint32, k int, v uint32) bool) bool {
+	return Uint32sSome(s.Snapshot(), fn)
+}
+
+// Find returns the key-value of the first element of a snapshot that satisfies fn.
+// If no element satisfies fn, -1 is returned.
+func (s *SyncUint32s) Find(fn func(u []uint32, k int, v uint32) bool) (k int, v uint32) {
+	return Uint32sFind(s.Snapshot(), fn)
+}
+
+// Filter creates a new slice with the elements of a snapshot that pass the test implemented by fn.
+func (s *SyncUint32s) Filter(fn func(u []uint32, k int, v uint32) bool) []uint32 {
+	return Uint32sFilter(s.Snapshot(), fn)
+}
+
+// Map creates a new slice with the results of calling fn on every element of a snapshot.
+func (s *SyncUint32s) Map(fn func(u []uint32, k int, v uint32) uint32) []uint32 {
+	return Uint32sMap(s.Snapshot(), fn)
+}
+
+// Reduce executes fn on each element of a snapshot, resulting in a single output value.
+func (s *SyncUint32s) Reduce(fn func(u []uint32, k int, v, accumulator uint32) uint32, initialValue ...uint32) uint32 {
+	return Uint32sReduce(s.Snapshot(), fn, initialValue...)
+}
+
+// Set changes the element at the index, which can be negative and counted from the end,
+// and reports whether the index is in range.
+func (s *SyncUint32s) Set(index int, value uint32) bool {
+	s.mu.Lock()
+	defer s.unlock()
+	k, ok := atIndex(len(s.data), index)
+	if ok {
+		s.data[k] = value
+	}
+	return ok
+}
+
+// Push adds one or more elements to the end, and returns the new length.
+func (s *SyncUint32s) Push(element ...uint32) int {
+	s.mu.Lock()
+	defer s.unlock()
+	return Uint32sPush(&s.data, element...)
+}
+
+// PushDistinct adds one or more new elements that do not exist at the end, and returns the new length.
+func (s *SyncUint32s) PushDistinct(element ...uint32) int {
+	s.mu.Lock()
+	defer s.unlock()
+	s.data = Uint32sPushDistinct(s.data, element...)
+	return len(s.data)
+}
+
+// Pop removes the last element and returns that element.
+func (s *SyncUint32s) Pop() (uint32, bool) {
+	s.mu.Lock()
+	defer s.unlock()
+	return Uint32sPop(&s.data)
+}
+
+// Shift removes the first element and returns that element.
+func (s *SyncUint32s) Shift() (uint32, bool) {
+	s.mu.Lock()
+	defer s.unlock()
+	return Uint32sShift(&s.data)
+}
+
+// Unshift adds one or more elements to the beginning, and returns the new length.
+func (s *SyncUint32s) Unshift(element ...uint32) int {
+	s.mu.Lock()
+	defer s.unlock()
+	return Uint32sUnshift(&s.data, element...)
+}
+
+// UnshiftDistinct adds one or more new elements that do not exist to the beginning, and returns the new length.
+func (s *SyncUint32s) UnshiftDistinct(element ...uint32) int {
+	s.mu.Lock()
+	defer s.unlock()
+	return Uint32sUnshiftDistinct(&s.data, element...)
+}
+
+// Splice removes deleteCount elements from start and adds the items in their place.
+func (s *SyncUint32s) Splice(start, deleteCount int, items ...uint32) {
+	s.mu.Lock()
+	defer s.unlock()
+	Uint32sSplice(&s.data, start, deleteCount, items...)
+}
+
+// RemoveFirst removes the first matched elements, and returns the new length.
+func (s *SyncUint32s) RemoveFirst(elements ...uint32) int {
+	s.mu.Lock()
+	defer s.unlock()
+	return Uint32sRemoveFirst(&s.data, elements...)
+}
+
+// RemoveEvery removes all the elements, and returns the new length.
+func (s *SyncUint32s) RemoveEvery(elements ...uint32) int {
+	s.mu.Lock()
+	defer s.unlock()
+	return Uint32sRemoveEvery(&s.data, elements...)
+}
+
+// Distinct removes the duplicate elements, and returns the count of each element.
+func (s *SyncUint32s) Distinct() (distinctCount map[uint32]int) {
+	s.mu.Lock()
+	defer s.unlock()
+	return Uint32sDistinct(&s.data, true)
+}
+
+// Fill changes all elements from start to end to a static value.
+func (s *SyncUint32s) Fill(value uint32, start int, end ...int) {
+	s.mu.Lock()
+	defer s.unlock()
+	Uint32sFill(s.data, value, start, end...)
+}
+
+// CopyWithin copies part of the slice to another location in the same slice.
+func (s *SyncUint32s) CopyWithin(target, start int, end ...int) {
+	s.mu.Lock()
+	defer s.unlock()
+	Uint32sCopyWithin(s.data, target, start, end...)
+}
+
+// Reverse reverses the elements in place.
+func (s *SyncUint32s) Reverse() {
+	s.mu.Lock()
+	defer s.unlock()
+	Uint32sReverse(s.data)
+}
+
+// Shuffle randomizes the order of the elements in place.
+func (s *SyncUint32s) Shuffle(r Randomizer) {
+	s.mu.Lock()
+	defer s.unlock()
+	Uint32sShuffle(s.data, r)
+}
+
+// ApplyPatch applies the edit script to the elements.
+func (s *SyncUint32s) ApplyPatch(patch []Uint32sEdit) error {
+	s.mu.Lock()
+	defer s.unlock()
+	return Uint32sApplyPatch(&s.data, patch)
+}
+
+// Update calls fn with the pointer of the elements under the write lock.
+// NOTE:
+//
+//	fn must not call the methods of the same SyncUint32s, and must not retain the slice.
+func (s *SyncUint32s) Update(fn func(p *[]uint32)) {
+	s.mu.Lock()
+	defer s.unlock()
+	fn(&s.data)
+}
+
+// Clear removes all elements.
+func (s *SyncUint32s) Clear() {
+	s.mu.Lock()
+	defer s.unlock()
+	s.data = nil
+}
+
+// unlock invalidates the snapshot and releases the write lock.
+func (s *SyncUint32s) unlock() {
+	s.snap.Store([]uint32(nil))
+	s.mu.Unlock()
+}
+
+// SyncUint32Set is a uint32 set that is safe for concurrent use.
+// NOTE:
+//
+//	The zero value is an empty set ready to use.
+//	Len and Has use the read lock without copying the elements.
+//	Callbacks are run on a snapshot, so they can call the methods of the same SyncUint32Set.
+type SyncUint32Set struct {
+	mu   sync.RWMutex
+	set  *Uint32Set
+	snap atomic.Value // []uint32: read-only elements in insertion order, nil after modification
+}
+
+// NewSyncUint32Set creates a SyncUint32Set with the elements.
+func NewSyncUint32Set(elements ...uint32) *SyncUint32Set {
+	return &SyncUint32Set{set: NewUint32Set(elements...)}
+}
+
+// Snapshot returns the read-only elements in insertion order.
+// NOTE:
+//
+//	The result is shared with other callers until the next modification, so it must not be changed.
+//	While there is no modification, it is an atomic read without locking.
+func (s *SyncUint32Set) Snapshot() []uint32 {
+	if snap, _ := s.snap.Load().([]uint32); snap != nil {
+		return snap
+	}
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	var snap []uint32
+	if s.set != nil {
+		snap = s.set.Slice()
+	}
+	if snap == nil {
+		snap = []uint32{}
+	}
+	s.snap.Store(snap)
+	return snap
+}
+
+// Add adds the elements that are not yet in the set, and returns the new length of the set.
+func (s *SyncUint32Set) Add(elements ...uint32) int {
+	s.mu.Lock()
+	defer s.unlock()
+	if s.set == nil {
+		s.set = NewUint32Set()
+	}
+	return s.set.Add(elements...)
+}
+
+// Remove removes the elements from the set, and returns the new length of the set.
+func (s *SyncUint32Set) Remove(elements ...uint32) int {
+	s.mu.Lock()
+	defer s.unlock()
+	if s.set == nil {
+		return 0
+	}
+	return s.set.Remove(elements...)
+}
+
+// Has reports whether the element is in the set.
+func (s *SyncUint32Set) Has(element uint32) bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.set != nil && s.set.Has(element)
+}
+
+// Len returns the number of elements in the set.
+func (s *SyncUint32Set) Len() int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	if s.set == nil {
+		return 0
+	}
+	return s.set.Len()
+}
+
+// Clear removes all elements.
+func (s *SyncUint32Set) Clear() {
+	s.mu.Lock()
+	defer s.unlock()
+	s.set = nil
+}
+
+// Range calls fn for each element of a snapshot in insertion order, until fn returns false.
+func (s *SyncUint32Set) Range(fn func(v uint32) bool) {
+	for _, v := range s.Snapshot() {
+		if !fn(v) {
+			return
+		}
+	}
+}
+
+// Slice returns a copy of the elements in insertion order.
+func (s *SyncUint32Set) Slice() []uint32 {
+	return Uint32sCopy(s.Snapshot())
+}
+
+// SortedSlice returns a copy of the elements in ascending order.
+func (s *SyncUint32Set) SortedSlice() []uint32 {
+	return NewUint32Set(s.Snapshot()...).SortedSlice()
+}
+
+// Clone returns a Uint32Set with the current elements.
+func (s *SyncUint32Set) Clone() *Uint32Set {
+	return NewUint32Set(s.Snapshot()...)
+}
+
+// unlock invalidates the snapshot and releases the write lock.
+func (s *SyncUint32Set) unlock() {
+	s.snap.Store([]uint32(nil))
+	s.mu.Unlock()
+}
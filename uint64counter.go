@@ -0,0 +1,164 @@
+package ameda
+
+import "sort"
+
+// Uint64Counter is a multiset that maps each uint64 element to its count.
+// NOTE:
+//
+//	Only positive counts are kept.
+//	It has the same underlying type as the result of Uint64sDistinct and Uint64sIntersect,
+//	so they can be converted to each other directly.
+type Uint64Counter map[uint64]int
+
+// Uint64CounterItem is an element with its count.
+type Uint64CounterItem struct {
+	Element uint64
+	Count   int
+}
+
+// NewUint64Counter creates a counter that counts the elements.
+func NewUint64Counter(elements ...uint64) Uint64Counter {
+	c := make(Uint64Counter, len(elements))
+	c.Add(elements...)
+	return c
+}
+
+// Uint64sDistinctCounter is like Uint64sDistinct, but returns a counter.
+func Uint64sDistinctCounter(u *[]uint64, changeSlice bool) Uint64Counter {
+	return Uint64Counter(Uint64sDistinct(u, changeSlice))
+}
+
+// Uint64sIntersectCounter is like Uint64sIntersect, but returns a counter, which is empty but not nil if there is no common element.
+func Uint64sIntersectCounter(u ...[]uint64) Uint64Counter {
+	if c := Uint64sIntersect(u...); c != nil {
+		return Uint64Counter(c)
+	}
+	return make(Uint64Counter)
+}
+
+// Add increases the count of each element by 1.
+func (c Uint64Counter) Add(elements ...uint64) {
+	for _, v := range elements {
+		c[v]++
+	}
+}
+
+// AddN increases the count of the element by n, and returns the new count.
+// NOTE:
+//
+//	If the count is no longer positive, the element is removed.
+func (c Uint64Counter) AddN(element uint64, n int) int {
+	n += c[element]
+	if n <= 0 {
+		delete(c, element)
+		return 0
+	}
+	c[element] = n
+	return n
+}
+
+// Subtract decreases the count of each element by 1.
+// NOTE:
+//
+//	If the count is no longer positive, the element is removed.
+func (c Uint64Counter) Subtract(elements ...uint64) {
+	for _, v := range elements {
+		c.AddN(v, -1)
+	}
+}
+
+// Get returns the count of the element.
+func (c Uint64Counter) Get(element uint64) int {
+	return c[element]
+}
+
+// Total returns the sum of all counts.
+func (c Uint64Counter) Total() int {
+	var n int
+	for _, v := range c {
+		n += v
+	}
+	return n
+}
+
+// Clone creates a copy of the counter.
+func (c Uint64Counter) Clone() Uint64Counter {
+	r := make(Uint64Counter, len(c))
+	for k, v := range c {
+		r[k] = v
+	}
+	return r
+}
+
+// Union returns a new counter with the maximum count of each element: c ∪ others...
+func (c Uint64Counter) Union(others ...Uint64Counter) Uint64Counter {
+	r := c.Clone()
+	for _, o := range others {
+		for k, v := range o {
+			if v > r[k] {
+				r[k] = v
+			}
+		}
+	}
+	return r
+}
+
+// Intersect returns a new counter with the minimum count of each element: c ∩ others...
+func (c Uint64Counter) Intersect(others ...Uint64Counter) Uint64Counter {
+	r := make(Uint64Counter, len(c))
+L:
+	for k, v := range c {
+		for _, o := range others {
+			v2 := o[k]
+			if v2 <= 0 {
+				continue L
+			}
+			if v > v2 {
+				v = v2
+			}
+		}
+		r[k] = v
+	}
+	return r
+}
+
+// MostCommon returns the k most common elements from the most to the least common,
+// elements with equal counts are in ascending order.
+// NOTE:
+//
+//	If k < 0, returns all elements.
+func (c Uint64Counter) MostCommon(k int) []Uint64CounterItem {
+	items := make([]Uint64CounterItem, 0, len(c))
+	for e, n := range c {
+		items = append(items, Uint64CounterItem{Element: e, Count: n})
+	}
+	sort.Slice(items, func(i, j int) bool {
+		if items[i].Count != items[j].Count {
+			return items[i].Count > items[j].Count
+		}
+		return items[i].Element < items[j].Element
+	})
+	if k >= 0 && k < len(items) {
+		items = items[:k]
+	}
+	return items
+}
+
+// SortedElements returns the distinct elements in ascending order.
+func (c Uint64Counter) SortedElements() []uint64 {
+	r := make([]uint64, 0, len(c))
+	for k := range c {
+		r = append(r, k)
+	}
+	sort.Slice(r, func(i, j int) bool { return r[i] < r[j] })
+	return r
+}
+
+// RangeSorted calls fn for each element in ascending order; if fn returns false, range stops the iteration.
+func (c Uint64Counter) RangeSorted(fn func(element uint64, count int) bool) {
+	for _, k := range c.SortedElements() {
+		if !fn(k, c[k]) {
+			return
+		}
+	}
+}
@@ -0,0 +1,373 @@
+package ameda
+
+import (
+	"sync"
+	"sync/atomic"
+)
+
+// SyncUint64s is a uint64 slice that is safe for concurrent use.
+// NOTE:
+//
+//	The zero value is an empty slice ready to use.
+//	Point reads such as Len and At use the read lock without copying the elements.
+//	Callbacks are run on a snapshot, so they can call the methods of the same SyncUint64s.
+type SyncUint64s struct {
+	mu   sync.RWMutex
+	data []uint64
+	snap atomic.Value // []uint64: read-only copy of data, nil after modification
+}
+
+// NewSyncUint64s creates a SyncUint64s with a copy of the elements.
+func NewSyncUint64s(elements ...uint64) *SyncUint64s {
+	return &SyncUint64s{data: Uint64sCopy(elements)}
+}
+
+// Snapshot returns a read-only copy of the current elements.
+// NOTE:
+//
+//	The result is shared with other callers until the next modification, so it must not be changed.
+//	While there is no modification, it is an atomic read without locking.
+func (s *SyncUint64s) Snapshot() []uint64 {
+	if snap, _ := s.snap.Load().([]uint64); snap != nil {
+		return snap
+	}
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	snap := Uint64sCopy(s.data)
+	s.snap.Store(snap)
+	return snap
+}
+
+// Slice returns a copy of the current elements that can be changed.
+func (s *SyncUint64s) Slice() []uint64 {
+	return Uint64sCopy(s.Snapshot())
+}
+
+// Len returns the number of elements.
+func (s *SyncUint64s) Len() int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return len(s.data)
+}
+
+// At returns the element at the index, which can be negative and counted from the end.
+func (s *SyncUint64s) At(index int) (v uint64, ok bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return Uint64sAt(s.data, index)
+}
+
+// Includes determines whether the slice includes a certain element.
+func (s *SyncUint64s) Includes(valueToFind uint64, fromIndex ...int) bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return Uint64sIncludes(s.data, valueToFind, fromIndex...)
+}
+
+// IndexOf returns the first index at which a given element can be found, or -1 if it is not present.
+func (s *SyncUint64s) IndexOf(searchElement uint64, fromIndex ...int) int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return Uint64sIndexOf(s.data, searchElement, fromIndex...)
+}
+
+// LastIndexOf returns the last index at which a given element can be found, or -1 if it is not present.
+func (s *SyncUint64s) LastIndexOf(searchElement uint64, fromIndex ...int) int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return Uint64sLastIndexOf(s.data, searchElement, fromIndex...)
+}
+
+// Range calls fn for each element of a snapshot in order, until fn returns false.
+func (s *SyncUint64s) Range(fn func(k int, v uint64) bool) {
+	for k, v := range s.Snapshot() {
+		if !fn(k, v) {
+			return
+		}
+	}
+}
+
+// Every tests whether all elements of a snapshot pass the test implemented by fn.
+func (s *SyncUint64s) Every(fn func(u []uint64, k int, v uint64) bool) bool {
+	return Uint64sEvery(s.Snapshot(), fn)
+}
+
+// Some tests whether at least one element of a snapshot passes the test implemented by fn.
+func (s *SyncUint64s) Some(fn func(u []uint64, k int, v uint64) bool) bool {
+	return Uint64sSome(s.Snapshot(), fn)
+}
+
+// Find returns the key-value of the first element of a snapshot that satisfies fn.
+// If no element satisfies fn, -1 is returned.
+func (s *SyncUint64s) Find(fn func(u []uint64, k int, v uint64) bool) (k int, v uint64) {
+	return Uint64sFind(s.Snapshot(), fn)
+}
+
+// Filter creates a new slice with the elements of a snapshot that pass the test implemented by fn.
+func (s *SyncUint64s) Filter(fn func(u []uint64, k int, v uint64) bool) []uint64 {
+	return Uint64sFilter(s.Snapshot(), fn)
+}
+
+// Map creates a new slice with the results of calling fn on every element of a snapshot.
+func (s *SyncUint64s) Map(fn func(u []uint64, k int, v uint64) uint64) []uint64 {
+	return Uint64sMap(s.Snapshot(), fn)
+}
+
+// Reduce executes fn on each element of a snapshot, resulting in a single output value.
+func (s *SyncUint64s) Reduce(fn func(u []uint64, k int, v, accumulator uint64) uint64, initialValue ...uint64) uint64 {
+	return Uint64sReduce(s.Snapshot(), fn, initialValue...)
+}
+
+// Set changes the element at the index, which can be negative and counted from the end,
+// and reports whether the index is in range.
+func (s *SyncUint64s) Set(index int, value uint64) bool {
+	s.mu.Lock()
+	defer s.unlock()
+	k, ok := atIndex(len(s.data), index)
+	if ok {
+		s.data[k] = value
+	}
+	return ok
+}
+
+// Push adds one or more elements to the end, and returns the new length.
+func (s *SyncUint64s) Push(element ...uint64) int {
+	s.mu.Lock()
+	defer s.unlock()
+	return Uint64sPush(&s.data, element...)
+}
+
+// PushDistinct adds one or more new elements that do not exist at the end, and returns the new length.
+func (s *SyncUint64s) PushDistinct(element ...uint64) int {
+	s.mu.Lock()
+	defer s.unlock()
+	s.data = Uint64sPushDistinct(s.data, element...)
+	return len(s.data)
+}
+
+// Pop removes the last element and returns that element.
+func (s *SyncUint64s) Pop() (uint64, bool) {
+	s.mu.Lock()
+	defer s.unlock()
+	return Uint64sPop(&s.data)
+}
+
+// Shift removes the first element and returns that element.
+func (s *SyncUint64s) Shift() (uint64, bool) {
+	s.mu.Lock()
+	defer s.unlock()
+	return Uint64sShift(&s.data)
+}
+
+// Unshift adds one or more elements to the beginning, and returns the new length.
+func (s *SyncUint64s) Unshift(element ...uint64) int {
+	s.mu.Lock()
+	defer s.unlock()
+	return Uint64sUnshift(&s.data, element...)
+}
+
+// UnshiftDistinct adds one or more new elements that do not exist to the beginning, and returns the new length.
+func (s *SyncUint64s) UnshiftDistinct(element ...uint64) int {
+	s.mu.Lock()
+	defer s.unlock()
+	return Uint64sUnshiftDistinct(&s.data, element...)
+}
+
+// Splice removes deleteCount elements from start and adds the items in their place.
+func (s *SyncUint64s) Splice(start, deleteCount int, items ...uint64) {
+	s.mu.Lock()
+	defer s.unlock()
+	Uint64sSplice(&s.data, start, deleteCount, items...)
+}
+
+// RemoveFirst removes the first matched elements, and returns the new length.
+func (s *SyncUint64s) RemoveFirst(elements ...uint64) int {
+	s.mu.Lock()
+	defer s.unlock()
+	return Uint64sRemoveFirst(&s.data, elements...)
+}
+
+// RemoveEvery removes all the elements, and returns the new length.
+func (s *SyncUint64s) RemoveEvery(elements ...uint64) int {
+	s.mu.Lock()
+	defer s.unlock()
+	return Uint64sRemoveEvery(&s.data, elements...)
+}
+
+// Distinct removes the duplicate elements, and returns the count of each element.
+func (s *SyncUint64s) Distinct() (distinctCount map[uint64]int) {
+	s.mu.Lock()
+	defer s.unlock()
+	return Uint64sDistinct(&s.data, true)
+}
+
+// Fill changes all elements from start to end to a static value.
+func (s *SyncUint64s) Fill(value uint64, start int, end ...int) {
+	s.mu.Lock()
+	defer s.unlock()
+	Uint64sFill(s.data, value, start, end...)
+}
+
+// CopyWithin copies part of the slice to another location in the same slice.
+func (s *SyncUint64s) CopyWithin(target, start int, end ...int) {
+	s.mu.Lock()
+	defer s.unlock()
+	Uint64sCopyWithin(s.data, target, start, end...)
+}
+
+// Reverse reverses the elements in place.
+func (s *SyncUint64s) Reverse() {
+	s.mu.Lock()
+	defer s.unlock()
+	Uint64sReverse(s.data)
+}
+
+// Shuffle randomizes the order of the elements in place.
+func (s *SyncUint64s) Shuffle(r Randomizer) {
+	s.mu.Lock()
+	defer s.unlock()
+	Uint64sShuffle(s.data, r)
+}
+
+// ApplyPatch applies the edit script to the elements.
+func (s *SyncUint64s) ApplyPatch(patch []Uint64sEdit) error {
+	s.mu.Lock()
+	defer s.unlock()
+	return Uint64sApplyPatch(&s.data, patch)
+}
+
+// Update calls fn with the pointer of the elements under the write lock.
+// NOTE:
+//
+//	fn must not call the methods of the same SyncUint64s, and must not retain the slice.
+func (s *SyncUint64s) Update(fn func(p *[]uint64)) {
+	s.mu.Lock()
+	defer s.unlock()
+	fn(&s.data)
+}
+
+// Clear removes all elements.
+func (s *SyncUint64s) Clear() {
+	s.mu.Lock()
+	defer s.unlock()
+	s.data = nil
+}
+
+// unlock invalidates the snapshot and releases the write lock.
+func (s *SyncUint64s) unlock() {
+	s.snap.Store([]uint64(nil))
+	s.mu.Unlock()
+}
+
+// SyncUint64Set is a uint64 set that is safe for concurrent use.
+// NOTE:
+//
+//	The zero value is an empty set ready to use.
+//	Len and Has use the read lock without copying the elements.
+//	Callbacks are run on a snapshot, so they can call the methods of the same SyncUint64Set.
+type SyncUint64Set struct {
+	mu   sync.RWMutex
+	set  *Uint64Set
+	snap atomic.Value // []uint64: read-only elements in insertion order, nil after modification
+}
+
+// NewSyncUint64Set creates a SyncUint64Set with the elements.
+func NewSyncUint64Set(elements ...uint64) *SyncUint64Set {
+	return &SyncUint64Set{set: NewUint64Set(elements...)}
+}
+
+// Snapshot returns the read-only elements in insertion order.
+// NOTE:
+//
+//	The result is shared with other callers until the next modification, so it must not be changed.
+//	While there is no modification, it is an atomic read without locking.
+func (s *SyncUint64Set) Snapshot() []uint64 {
+	if snap, _ := s.snap.Load().([]uint64); snap != nil {
+		return snap
+	}
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	var snap []uint64
+	if s.set != nil {
+		snap = s.set.Slice()
+	}
+	if snap == nil {
+		snap = []uint64{}
+	}
+	s.snap.Store(snap)
+	return snap
+}
+
+// Add adds the elements that are not yet in the set, and returns the new length of the set.
+func (s *SyncUint64Set) Add(elements ...uint64) int {
+	s.mu.Lock()
+	defer s.unlock()
+	if s.set == nil {
+		s.set = NewUint64Set()
+	}
+	return s.set.Add(elements...)
+}
+
+// Remove removes the elements from the set, and returns the new length of the set.
+func (s *SyncUint64Set) Remove(elements ...uint64) int {
+	s.mu.Lock()
+	defer s.unlock()
+	if s.set == nil {
+		return 0
+	}
+	return s.set.Remove(elements...)
+}
+
+// Has reports whether the element is in the set.
+func (s *SyncUint64Set) Has(element uint64) bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.set != nil && s.set.Has(element)
+}
+
+// Len returns the number of elements in the set.
+func (s *SyncUint64Set) Len() int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	if s.set == nil {
+		return 0
+	}
+	return s.set.Len()
+}
+
+// Clear removes all elements.
+func (s *SyncUint64Set) Clear() {
+	s.mu.Lock()
+	defer s.unlock()
+	s.set = nil
+}
+
+// Range calls fn for each element of a snapshot in insertion order, until fn returns false.
+func (s *SyncUint64Set) Range(fn func(v uint64) bool) {
+	for _, v := range s.Snapshot() {
+		if !fn(v) {
+			return
+		}
+	}
+}
+
+// Slice returns a copy of the elements in insertion order.
+func (s *SyncUint64Set) Slice() []uint64 {
+	return Uint64sCopy(s.Snapshot())
+}
+
+// SortedSlice returns a copy of the elements in ascending order.
+func (s *SyncUint64Set) SortedSlice() []uint64 {
+	return NewUint64Set(s.Snapshot()...).SortedSlice()
+}
+
+// Clone returns a Uint64Set with the current elements.
+func (s *SyncUint64Set) Clone() *Uint64Set {
+	return NewUint64Set(s.Snapshot()...)
+}
+
+// unlock invalidates the snapshot and releases the write lock.
+func (s *SyncUint64Set) unlock() {
+	s.snap.Store([]uint64(nil))
+	s.mu.Unlock()
+}
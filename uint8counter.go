@@ -0,0 +1,164 @@
+package ameda
+
+import "sort"
+
+// Uint8Counter is a multiset that maps each uint8 element to its count.
+// NOTE:
+//
+//	Only positive counts are kept.
+//	It has the same underlying type as the result of Uint8sDistinct and Uint8sIntersect,
+//	so they can be converted to each other directly.
+type Uint8Counter map[uint8]int
+
+// Uint8CounterItem is an element with its count.
+type Uint8CounterItem struct {
+	Element uint8
+	Count   int
+}
+
+// NewUint8Counter creates a counter that counts the elements.
+func NewUint8Counter(elements ...uint8) Uint8Counter {
+	c := make(Uint8Counter, len(elements))
+	c.Add(elements...)
+	return c
+}
+
+// Uint8sDistinctCounter is like Uint8sDistinct, but returns a counter.
+func Uint8sDistinctCounter(u *[]uint8, changeSlice bool) Uint8Counter {
+	return Uint8Counter(Uint8sDistinct(u, changeSlice))
+}
+
+// Uint8sIntersectCounter is like Uint8sIntersect, but returns a counter, which is empty but not nil if there is no common element.
+func Uint8sIntersectCounter(u ...[]uint8) Uint8Counter {
+	if c := Uint8sIntersect(u...); c != nil {
+		return Uint8Counter(c)
+	}
+	return make(Uint8Counter)
+}
+
+// Add increases the count of each element by 1.
+func (c Uint8Counter) Add(elements ...uint8) {
+	for _, v := range elements {
+		c[v]++
+	}
+}
+
+// AddN increases the count of the element by n, and returns the new count.
+// NOTE:
+//
+//	If the count is no longer positive, the element is removed.
+func (c Uint8Counter) AddN(element uint8, n int) int {
+	n += c[element]
+	if n <= 0 {
+		delete(c, element)
+		return 0
+	}
+	c[element] = n
+	return n
+}
+
+// Subtract decreases the count of each element by 1.
+// NOTE:
+//
+//	If the count is no longer positive, the element is removed.
+func (c Uint8Counter) Subtract(elements ...uint8) {
+	for _, v := range elements {
+		c.AddN(v, -1)
+	}
+}
+
+// Get returns the count of the element.
+func (c Uint8Counter) Get(element uint8) int {
+	return c[element]
+}
+
+// Total returns the sum of all counts.
+func (c Uint8Counter) Total() int {
+	var n int
+	for _, v := range c {
+		n += v
+	}
+	return n
+}
+
+// Clone creates a copy of the counter.
+func (c Uint8Counter) Clone() Uint8Counter {
+	r := make(Uint8Counter, len(c))
+	for k, v := range c {
+		r[k] = v
+	}
+	return r
+}
+
+// Union returns a new counter with the maximum count of each element: c ∪ others...
+func (c Uint8Counter) Union(others ...Uint8Counter) Uint8Counter {
+	r := c.Clone()
+	for _, o := range others {
+		for k, v := range o {
+			if v > r[k] {
+				r[k] = v
+			}
+		}
+	}
+	return r
+}
+
+// Intersect returns a new counter with the minimum count of each element: c ∩ others...
+func (c Uint8Counter) Intersect(others ...Uint8Counter) Uint8Counter {
+	r := make(Uint8Counter, len(c))
+L:
+	for k, v := range c {
+		for _, o := range others {
+			v2 := o[k]
+			if v2 <= 0 {
+				continue L
+			}
+			if v > v2 {
+				v = v2
+			}
+		}
+		r[k] = v
+	}
+	return r
+}
+
+// MostCommon returns the k most common elements from the most to the least common,
+// elements with equal counts are in ascending order.
+// NOTE:
+//
+//	If k < 0, returns all elements.
+func (c Uint8Counter) MostCommon(k int) []Uint8CounterItem {
+	items := make([]Uint8CounterItem, 0, len(c))
+	for e, n := range c {
+		items = append(items, Uint8CounterItem{Element: e, Count: n})
+	}
+	sort.Slice(items, func(i, j int) bool {
+		if items[i].Count != items[j].Count {
+			return items[i].Count > items[j].Count
+		}
+		return items[i].Element < items[j].Element
+	})
+	if k >= 0 && k < len(items) {
+		items = items[:k]
+	}
+	return items
+}
+
+// SortedElements returns the distinct elements in ascending order.
+func (c Uint8Counter) SortedElements() []uint8 {
+	r := make([]uint8, 0, len(c))
+	for k := range c {
+		r = append(r, k)
+	}
+	sort.Slice(r, func(i, j int) bool { return r[i] < r[j] })
+	return r
+}
+
+// RangeSorted calls fn for each element in ascending order; if fn returns false, range stops the iteration.
+func (c Uint8Counter) RangeSorted(fn func(element uint8, count int) bool) {
+	for _, k := range c.SortedElements() {
+		if !fn(k, c[k]) {
+			return
+		}
+	}
+}
@@ -1,5 +1,10 @@
 package ameda
 
+import (
+	"fmt"
+	"sort"
+)
+
 // OneUint8 try to return the first element, otherwise return zero value.
 func OneUint8(u []uint8) uint8 {
 	if len(u) > 0 {
@@ -431,26 +436,20 @@ func Uint8sSplice(u *[]uint8, start, deleteCount int, items ...uint8) {
 	if deleteCount < 0 {
 		deleteCount = 0
 	}
-	start, end, _ := fixRange(len(a), start, start+1+deleteCount)
-	deleteCount = end - start - 1
-	for k := 0; k < len(items); k++ {
-		if deleteCount > 0 {
-			// replace
-			a[start] = items[k]
-			deleteCount--
-			start++
-		} else {
-			// insert
-			lastSlice := Uint8sCopy(a[start:])
-			items = items[k:]
-			a = append(a[:start], items...)
-			a = append(a[:start+len(items)], lastSlice...)
-			*u = a[:len(a):len(a)]
-			return
-		}
+	start = fixIndex(len(a), start, true)
+	if rest := len(a) - start; deleteCount > rest {
+		deleteCount = rest
 	}
-	if deleteCount > 0 {
-		a = append(a[:start], a[start+1+deleteCount:]...)
+	// replace
+	n := copy(a[start:start+deleteCount], items)
+	if n == len(items) {
+		// delete the rest
+		a = append(a[:start+n], a[start+deleteCount:]...)
+	} else {
+		// insert the rest
+		lastSlice := Uint8sCopy(a[start+deleteCount:])
+		a = append(a[:start+deleteCount], items[n:]...)
+		a = append(a, lastSlice...)
 	}
 	*u = a[:len(a):len(a)]
 }
@@ -679,3 +678,558 @@ func Uint8SetDifference(set1, set2 []uint8, others ...[]uint8) []uint8 {
 	}
 	return r
 }
+
+// Uint8sIsSorted reports whether the slice is sorted in ascending order.
+func Uint8sIsSorted(u []uint8) bool {
+	for k := 1; k < len(u); k++ {
+		if u[k] < u[k-1] {
+			return false
+		}
+	}
+	return true
+}
+
+// Uint8SortedSetUnion calculates between multiple sorted collections: set1 ∪ set2 ∪ others...
+// NOTE:
+//
+//	Every set must be sorted in ascending order (see Uint8sIsSorted), and the result is sorted too.
+//	It merges the sets in O(n+m) without building any map.
+//	This method does not change the existing slices, but instead returns a new slice.
+func Uint8SortedSetUnion(set1, set2 []uint8, others ...[]uint8) []uint8 {
+	r := uint8SortedSetUnion(set1, set2)
+	for _, set := range others {
+		r = uint8SortedSetUnion(r, set)
+	}
+	return r
+}
+
+// Uint8SortedSetIntersect calculates between multiple sorted collections: set1 ∩ set2 ∩ others...
+// NOTE:
+//
+//	Every set must be sorted in ascending order (see Uint8sIsSorted), and the result is sorted too.
+//	It merges the sets in O(n+m) without building any map.
+//	This method does not change the existing slices, but instead returns a new slice.
+func Uint8SortedSetIntersect(set1, set2 []uint8, others ...[]uint8) []uint8 {
+	r := uint8SortedSetIntersect(set1, set2)
+	for _, set := range others {
+		r = uint8SortedSetIntersect(r, set)
+	}
+	return r
+}
+
+// Uint8SortedSetDifference calculates between multiple sorted collections: set1 - set2 - others...
+// NOTE:
+//
+//	Every set must be sorted in ascending order (see Uint8sIsSorted), and the result is sorted too.
+//	It merges the sets in O(n+m) without building any map.
+//	This method does not change the existing slices, but instead returns a new slice.
+func Uint8SortedSetDifference(set1, set2 []uint8, others ...[]uint8) []uint8 {
+	r := uint8SortedSetDifference(set1, set2)
+	for _, set := range others {
+		r = uint8SortedSetDifference(r, set)
+	}
+	return r
+}
+
+// Uint8SortedSetSymmetricDifference calculates between multiple sorted collections: set1 ∆ set2 ∆ others...
+// NOTE:
+//
+//	Every set must be sorted in ascending order (see Uint8sIsSorted), and the result is sorted too.
+//	It merges the sets in O(n+m) without building any map.
+//	This method does not change the existing slices, but instead returns a new slice.
+func Uint8SortedSetSymmetricDifference(set1, set2 []uint8, others ...[]uint8) []uint8 {
+	r := uint8SortedSetSymmetricDifference(set1, set2)
+	for _, set := range others {
+		r = uint8SortedSetSymmetricDifference(r, set)
+	}
+	return r
+}
+
+// Uint8SortedSetIsSubset reports whether every element of sub is also in set: sub ⊆ set.
+// NOTE:
+//
+//	Both sets must be sorted in ascending order (see Uint8sIsSorted).
+func Uint8SortedSetIsSubset(sub, set []uint8) bool {
+	j := 0
+	for k := 0; k < len(sub); {
+		if j >= len(set) || sub[k] < set[j] {
+			return false
+		}
+		if set[j] < sub[k] {
+			j++
+			continue
+		}
+		k++
+	}
+	return true
+}
+
+func uint8SortedSetUnion(a, b []uint8) []uint8 {
+	r := make([]uint8, 0, len(a)+len(b))
+	i, j := 0, 0
+	for i < len(a) || j < len(b) {
+		var v uint8
+		switch {
+		case j >= len(b) || i < len(a) && a[i] < b[j]:
+			v = a[i]
+			i++
+		case i >= len(a) || b[j] < a[i]:
+			v = b[j]
+			j++
+		default:
+			v = a[i]
+			i++
+			j++
+		}
+		if n := len(r); n == 0 || r[n-1] != v {
+			r = append(r, v)
+		}
+	}
+	return r
+}
+
+func uint8SortedSetIntersect(a, b []uint8) []uint8 {
+	n := len(a)
+	if len(b) < n {
+		n = len(b)
+	}
+	r := make([]uint8, 0, n)
+	i, j := 0, 0
+	for i < len(a) && j < len(b) {
+		switch {
+		case a[i] < b[j]:
+			i++
+		case b[j] < a[i]:
+			j++
+		default:
+			v := a[i]
+			if n := len(r); n == 0 || r[n-1] != v {
+				r = append(r, v)
+			}
+			i++
+			j++
+		}
+	}
+	return r
+}
+
+func uint8SortedSetDifference(a, b []uint8) []uint8 {
+	r := make([]uint8, 0, len(a))
+	j := 0
+	for _, v := range a {
+		for j < len(b) && b[j] < v {
+			j++
+		}
+		if j < len(b) && b[j] == v {
+			continue
+		}
+		if n := len(r); n == 0 || r[n-1] != v {
+			r = append(r, v)
+		}
+	}
+	return r
+}
+
+func uint8SortedSetSymmetricDifference(a, b []uint8) []uint8 {
+	r := make([]uint8, 0, len(a)+len(b))
+	i, j := 0, 0
+	for i < len(a) || j < len(b) {
+		var v uint8
+		switch {
+		case j >= len(b) || i < len(a) && a[i] < b[j]:
+			v = a[i]
+			i++
+		case i >= len(a) || b[j] < a[i]:
+			v = b[j]
+			j++
+		default:
+			v = a[i]
+			for i < len(a) && a[i] == v {
+				i++
+			}
+			for j < len(b) && b[j] == v {
+				j++
+			}
+			continue
+		}
+		if n := len(r); n == 0 || r[n-1] != v {
+			r = append(r, v)
+		}
+	}
+	return r
+}
+
+// Uint8sEdit is an edit run of the edit script produced by Uint8sDiff.
+type Uint8sEdit struct {
+	Kind   DiffKind
+	Values []uint8
+}
+
+// Uint8sDiff computes the shortest edit script that turns slice a into slice b, see also DiffFunc.
+// This method does not change the existing slices, but instead returns the runs of edits.
+func Uint8sDiff(a, b []uint8) []Uint8sEdit {
+	spans := DiffFunc(len(a), len(b), func(i, j int) bool { return a[i] == b[j] })
+	r := make([]Uint8sEdit, len(spans))
+	for k, span := range spans {
+		r[k].Kind = span.Kind
+		if span.Kind == DiffInsert {
+			r[k].Values = Uint8sCopy(b[span.B : span.B+span.Len])
+		} else {
+			r[k].Values = Uint8sCopy(a[span.A : span.A+span.Len])
+		}
+	}
+	return r
+}
+
+// Uint8sApplyPatch changes the contents of an slice in place by applying the edit script produced by Uint8sDiff.
+// NOTE:
+//
+//	If the slice does not match the equal and delete runs of the patch, it returns an error
+//	and the slice will not be modified.
+func Uint8sApplyPatch(p *[]uint8, patch []Uint8sEdit) error {
+	a := *p
+	r := make([]uint8, 0, len(a))
+	pos := 0
+	for _, e := range patch {
+		switch e.Kind {
+		case DiffInsert:
+			r = append(r, e.Values...)
+		case DiffEqual, DiffDelete:
+			if pos+len(e.Values) > len(a) {
+				return fmt.Errorf("patch mismatch: %s run exceeds the slice length %d", e.Kind, len(a))
+			}
+			for k, v := range e.Values {
+				if a[pos+k] != v {
+					return fmt.Errorf("patch mismatch: %s run at index %d", e.Kind, pos+k)
+				}
+			}
+			if e.Kind == DiffEqual {
+				r = append(r, e.Values...)
+			}
+			pos += len(e.Values)
+		default:
+			return fmt.Errorf("patch mismatch: unknown edit kind %d", e.Kind)
+		}
+	}
+	if pos != len(a) {
+		return fmt.Errorf("patch mismatch: the slice has %d unpatched elements", len(a)-pos)
+	}
+	n := len(r)
+	*p = r[:n:n]
+	return nil
+}
+
+// Uint8sShuffle randomizes the order of elements in place.
+// @r
+//
+//	The source of random numbers, nil means the global source of package math/rand.
+func Uint8sShuffle(u []uint8, r Randomizer) {
+	shuffleIndex(len(u), len(u), r, func(x, y int) { u[x], u[y] = u[y], u[x] })
+}
+
+// Uint8sSample returns k elements randomly selected from the slice without replacement.
+// If k is greater than the length of the slice, all the elements are returned in random order.
+// This method does not change the existing slice, but instead returns a new slice.
+// @r
+//
+//	The source of random numbers, nil means the global source of package math/rand.
+func Uint8sSample(u []uint8, k int, r Randomizer) []uint8 {
+	if k <= 0 {
+		return []uint8{}
+	}
+	a := Uint8sCopy(u)
+	shuffleIndex(len(a), k, r, func(x, y int) { a[x], a[y] = a[y], a[x] })
+	if k > len(a) {
+		k = len(a)
+	}
+	return a[:k:k]
+}
+
+// Uint8sSampleWithReplacement returns k elements randomly selected from the slice with replacement.
+// This method does not change the existing slice, but instead returns a new slice.
+// @r
+//
+//	The source of random numbers, nil means the global source of package math/rand.
+func Uint8sSampleWithReplacement(u []uint8, k int, r Randomizer) []uint8 {
+	if k <= 0 || len(u) == 0 {
+		return []uint8{}
+	}
+	r = getRandomizer(r)
+	ret := make([]uint8, k)
+	for n := range ret {
+		ret[n] = u[r.Intn(len(u))]
+	}
+	return ret
+}
+
+// Uint8sWeightedChoice returns the key-value of an element randomly selected
+// with the probability proportional to its weight.
+// @weights
+//
+//	The weights of the elements with the same indexes, must be finite and non-negative.
+//
+// @r
+//
+//	The source of random numbers, nil means the global source of package math/rand.
+//
+// NOTE:
+//
+//	If the slice is empty, or the weights are invalid, returns an error and k = -1
+func Uint8sWeightedChoice(u []uint8, weights []float64, r Randomizer) (k int, v uint8, err error) {
+	k, err = weightedIndex(len(u), weights, r)
+	if err != nil {
+		return k, v, err
+	}
+	return k, u[k], nil
+}
+
+// Uint8sReservoirSample returns k elements randomly selected from a stream of unknown length,
+// in a single pass and with O(k) memory.
+// @next
+//
+//	Returns the next element of the stream, or ok = false at the end of the stream.
+//
+// @r
+//
+//	The source of random numbers, nil means the global source of package math/rand.
+func Uint8sReservoirSample(next func() (v uint8, ok bool), k int, r Randomizer) []uint8 {
+	if k <= 0 {
+		return []uint8{}
+	}
+	r = getRandomizer(r)
+	ret := make([]uint8, 0, k)
+	for seen := 0; ; seen++ {
+		v, ok := next()
+		if !ok {
+			return ret
+		}
+		switch slot := reservoirIndex(seen, k, r); {
+		case slot == len(ret):
+			ret = append(ret, v)
+		case slot >= 0:
+			ret[slot] = v
+		}
+	}
+}
+
+// Uint8sAt returns the element at the given index.
+// @index
+//
+//	Zero-based index of the element. If negative, index will be counted from the end.
+//
+// NOTE:
+//
+//	If the index is out of range, ok = false
+func Uint8sAt(u []uint8, index int) (v uint8, ok bool) {
+	index, ok = atIndex(len(u), index)
+	if !ok {
+		return v, false
+	}
+	return u[index], true
+}
+
+// Uint8sWith returns a copy of the slice with the element at the given index replaced with the value.
+// @index
+//
+//	Zero-based index of the element. If negative, index will be counted from the end.
+//
+// NOTE:
+//
+//	If the index is out of range, ok = false
+func Uint8sWith(u []uint8, index int, value uint8) (ret []uint8, ok bool) {
+	ret = Uint8sCopy(u)
+	index, ok = atIndex(len(u), index)
+	if ok {
+		ret[index] = value
+	}
+	return ret, ok
+}
+
+// Uint8sFindIndex returns the index of the first element in the provided slice that satisfies the provided testing function.
+// NOTE:
+//
+//	If not found, returns -1
+func Uint8sFindIndex(u []uint8, fn func(u []uint8, k int, v uint8) bool) int {
+	k, _ := Uint8sFind(u, fn)
+	return k
+}
+
+// Uint8sFindLast returns the key-value of the last element in the provided slice that satisfies the provided testing function.
+// NOTE:
+//
+//	If not found, k = -1
+func Uint8sFindLast(u []uint8, fn func(u []uint8, k int, v uint8) bool) (k int, v uint8) {
+	for k := len(u) - 1; k >= 0; k-- {
+		if fn(u, k, u[k]) {
+			return k, u[k]
+		}
+	}
+	return -1, v
+}
+
+// Uint8sFindLastIndex returns the index of the last element in the provided slice that satisfies the provided testing function.
+// NOTE:
+//
+//	If not found, returns -1
+func Uint8sFindLastIndex(u []uint8, fn func(u []uint8, k int, v uint8) bool) int {
+	k, _ := Uint8sFindLast(u, fn)
+	return k
+}
+
+// Uint8sFlat creates a new slice with all sub-slice elements concatenated into it.
+func Uint8sFlat(u [][]uint8) []uint8 {
+	return Uint8sConcat(u...)
+}
+
+// Uint8sFlatMap creates a new slice populated with the results of calling a provided function
+// on every element in the calling slice, and then flattening the results.
+func Uint8sFlatMap(u []uint8, fn func(u []uint8, k int, v uint8) []uint8) []uint8 {
+	ret := make([]uint8, 0, len(u))
+	for k, v := range u {
+		ret = append(ret, fn(u, k, v)...)
+	}
+	return ret
+}
+
+// Uint8sToSorted returns a copy of the slice sorted stably.
+// @less
+//
+//	Reports whether the element a must sort before the element b. If nil, sorts in ascending order.
+func Uint8sToSorted(u []uint8, less func(a, b uint8) bool) []uint8 {
+	ret := Uint8sCopy(u)
+	if less == nil {
+		less = func(a, b uint8) bool { return a < b }
+	}
+	sort.SliceStable(ret, func(x, y int) bool { return less(ret[x], ret[y]) })
+	return ret
+}
+
+// Uint8sToReversed returns a copy of the slice in reversed order.
+func Uint8sToReversed(u []uint8) []uint8 {
+	ret := Uint8sCopy(u)
+	Uint8sReverse(ret)
+	return ret
+}
+
+// Uint8sToSpliced returns a copy of the slice with some elements removed and/or replaced at a given index,
+// see also Uint8sSplice.
+func Uint8sToSpliced(u []uint8, start, deleteCount int, items ...uint8) []uint8 {
+	ret := Uint8sCopy(u)
+	Uint8sSplice(&ret, start, deleteCount, items...)
+	return ret
+}
+
+// Uint8sKeys returns an iterator of the indexes of the slice.
+// NOTE:
+//
+//	The iterator returns ok = false when it is exhausted.
+func Uint8sKeys(u []uint8) (next func() (k int, ok bool)) {
+	k := -1
+	return func() (int, bool) {
+		if k+1 >= len(u) {
+			return -1, false
+		}
+		k++
+		return k, true
+	}
+}
+
+// Uint8sValues returns an iterator of the elements of the slice.
+// NOTE:
+//
+//	The iterator returns ok = false when it is exhausted.
+func Uint8sValues(u []uint8) (next func() (v uint8, ok bool)) {
+	k := -1
+	return func() (v uint8, ok bool) {
+		if k+1 >= len(u) {
+			return v, false
+		}
+		k++
+		return u[k], true
+	}
+}
+
+// Uint8sEntries returns an iterator of the key-value pairs of the slice.
+// NOTE:
+//
+//	The iterator returns ok = false when it is exhausted.
+func Uint8sEntries(u []uint8) (next func() (k int, v uint8, ok bool)) {
+	k := -1
+	return func() (int, uint8, bool) {
+		if k+1 >= len(u) {
+			var v uint8
+			return -1, v, false
+		}
+		k++
+		return k, u[k], true
+	}
+}
+
+// Uint8sGroup groups the elements of the slice according to the string keys returned by the provided function.
+func Uint8sGroup(u []uint8, fn func(u []uint8, k int, v uint8) string) map[string][]uint8 {
+	ret := make(map[string][]uint8)
+	for k, v := range u {
+		key := fn(u, k, v)
+		ret[key] = append(ret[key], v)
+	}
+	return ret
+}
+
+// Uint8sGroupToMap groups the elements of the slice according to the keys returned by the provided function.
+// NOTE:
+//
+//	The keys must be comparable.
+func Uint8sGroupToMap(u []uint8, fn func(u []uint8, k int, v uint8) interface{}) map[interface{}][]uint8 {
+	ret := make(map[interface{}][]uint8)
+	for k, v := range u {
+		key := fn(u, k, v)
+		ret[key] = append(ret[key], v)
+	}
+	return ret
+}
+
+// Uint8sEqual reports whether a and b have the same length and the same elements in the same order.
+func Uint8sEqual(a, b []uint8) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for k, v := range a {
+		if v != b[k] {
+			return false
+		}
+	}
+	return true
+}
+
+// Uint8sCompare compares a and b lexicographically, and returns -1 if a < b, 0 if a == b, or +1 if a > b.
+// NOTE:
+//
+//	A slice that is a prefix of another slice is less than it.
+func Uint8sCompare(a, b []uint8) int {
+	for k := 0; k < len(a) && k < len(b); k++ {
+		if c := compareUint8(a[k], b[k]); c != 0 {
+			return c
+		}
+	}
+	return compareInt(len(a), len(b))
+}
+
+// Uint8sHasPrefix reports whether the slice u begins with prefix.
+func Uint8sHasPrefix(u, prefix []uint8) bool {
+	return len(u) >= len(prefix) && Uint8sEqual(u[:len(prefix)], prefix)
+}
+
+// Uint8sHasSuffix reports whether the slice u ends with suffix.
+func Uint8sHasSuffix(u, suffix []uint8) bool {
+	return len(u) >= len(suffix) && Uint8sEqual(u[len(u)-len(suffix):], suffix)
+}
+
+func compareUint8(a, b uint8) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,373 @@
+package ameda
+
+import (
+	"sync"
+	"sync/atomic"
+)
+
+// SyncUint8s is a uint8 slice that is safe for concurrent use.
+// NOTE:
+//
+//	The zero value is an empty slice ready to use.
+//	Point reads such as Len and At use the read lock without copying the elements.
+//	Callbacks are run on a snapshot, so they can call the methods of the same SyncUint8s.
+type SyncUint8s struct {
+	mu   sync.RWMutex
+	data []uint8
+	snap atomic.Value // []uint8: read-only copy of data, nil after modification
+}
+
+// NewSyncUint8s creates a SyncUint8s with a copy of the elements.
+func NewSyncUint8s(elements ...uint8) *SyncUint8s {
+	return &SyncUint8s{data: Uint8sCopy(elements)}
+}
+
+// Snapshot returns a read-only copy of the current elements.
+// NOTE:
+//
+//	The result is shared with other callers until the next modification, so it must not be changed.
+//	While there is no modification, it is an atomic read without locking.
+func (s *SyncUint8s) Snapshot() []uint8 {
+	if snap, _ := s.snap.Load().([]uint8); snap != nil {
+		return snap
+	}
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	snap := Uint8sCopy(s.data)
+	s.snap.Store(snap)
+	return snap
+}
+
+// Slice returns a copy of the current elements that can be changed.
+func (s *SyncUint8s) Slice() []uint8 {
+	return Uint8sCopy(s.Snapshot())
+}
+
+// Len returns the number of elements.
+func (s *SyncUint8s) Len() int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return len(s.data)
+}
+
+// At returns the element at the index, which can be negative and counted from the end.
+func (s *SyncUint8s) At(index int) (v uint8, ok bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return Uint8sAt(s.data, index)
+}
+
+// Includes determines whether the slice includes a certain element.
+func (s *SyncUint8s) Includes(valueToFind uint8, fromIndex ...int) bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return Uint8sIncludes(s.data, valueToFind, fromIndex...)
+}
+
+// IndexOf returns the first index at which a given element can be found, or -1 if it is not present.
+func (s *SyncUint8s) IndexOf(searchElement uint8, fromIndex ...int) int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return Uint8sIndexOf(s.data, searchElement, fromIndex...)
+}
+
+// LastIndexOf returns the last index at which a given element can be found, or -1 if it is not present.
+func (s *SyncUint8s) LastIndexOf(searchElement uint8, fromIndex ...int) int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return Uint8sLastIndexOf(s.data, searchElement, fromIndex...)
+}
+
+// Range calls fn for each element of a snapshot in order, until fn returns false.
+func (s *SyncUint8s) Range(fn func(k int, v uint8) bool) {
+	for k, v := range s.Snapshot() {
+		if !fn(k, v) {
+			return
+		}
+	}
+}
+
+// Every tests whether all elements of a snapshot pass the test implemented by fn.
+func (s *SyncUint8s) Every(fn func(u []uint8, k int, v uint8) bool) bool {
+	return Uint8sEvery(s.Snapshot(), fn)
+}
+
+// Some tests whether at least one element of a snapshot passes the test implemented by fn.
+func (s *SyncUint8s) Some(fn func(u []uint8, k int, v uint8) bool) bool {
+	return Uint8sSome(s.Snapshot(), fn)
+}
+
+// Find returns the key-value of the first element of a snapshot that satisfies fn.
+// If no element satisfies fn, -1 is returned.
+func (s *SyncUint8s) Find(fn func(u []uint8, k int, v uint8) bool) (k int, v uint8) {
+	return Uint8sFind(s.Snapshot(), fn)
+}
+
+// Filter creates a new slice with the elements of a snapshot that pass the test implemented by fn.
+func (s *SyncUint8s) Filter(fn func(u []uint8, k int, v uint8) bool) []uint8 {
+	return Uint8sFilter(s.Snapshot(), fn)
+}
+
+// Map creates a new slice with the results of calling fn on every element of a snapshot.
+func (s *SyncUint8s) Map(fn func(u []uint8, k int, v uint8) uint8) []uint8 {
+	return Uint8sMap(s.Snapshot(), fn)
+}
+
+// Reduce executes fn on each element of a snapshot, resulting in a single output value.
+func (s *SyncUint8s) Reduce(fn func(u []uint8, k int, v, accumulator uint8) uint8, initialValue ...uint8) uint8 {
+	return Uint8sReduce(s.Snapshot(), fn, initialValue...)
+}
+
+// Set changes the element at the index, which can be negative and counted from the end,
+// and reports whether the index is in range.
+func (s *SyncUint8s) Set(index int, value uint8) bool {
+	s.mu.Lock()
+	defer s.unlock()
+	k, ok := atIndex(len(s.data), index)
+	if ok {
+		s.data[k] = value
+	}
+	return ok
+}
+
+// Push adds one or more elements to the end, and returns the new length.
+func (s *SyncUint8s) Push(element ...uint8) int {
+	s.mu.Lock()
+	defer s.unlock()
+	return Uint8sPush(&s.data, element...)
+}
+
+// PushDistinct adds one or more new elements that do not exist at the end, and returns the new length.
+func (s *SyncUint8s) PushDistinct(element ...uint8) int {
+	s.mu.Lock()
+	defer s.unlock()
+	s.data = Uint8sPushDistinct(s.data, element...)
+	return len(s.data)
+}
+
+// Pop removes the last element and returns that element.
+func (s *SyncUint8s) Pop() (uint8, bool) {
+	s.mu.Lock()
+	defer s.unlock()
+	return Uint8sPop(&s.data)
+}
+
+// Shift removes the first element and returns that element.
+func (s *SyncUint8s) Shift() (uint8, bool) {
+	s.mu.Lock()
+	defer s.unlock()
+	return Uint8sShift(&s.data)
+}
+
+// Unshift adds one or more elements to the beginning, and returns the new length.
+func (s *SyncUint8s) Unshift(element ...uint8) int {
+	s.mu.Lock()
+	defer s.unlock()
+	return Uint8sUnshift(&s.data, element...)
+}
+
+// UnshiftDistinct adds one or more new elements that do not exist to the beginning, and returns the new length.
+func (s *SyncUint8s) UnshiftDistinct(element ...uint8) int {
+	s.mu.Lock()
+	defer s.unlock()
+	return Uint8sUnshiftDistinct(&s.data, element...)
+}
+
+// Splice removes deleteCount elements from start and adds the items in their place.
+func (s *SyncUint8s) Splice(start, deleteCount int, items ...uint8) {
+	s.mu.Lock()
+	defer s.unlock()
+	Uint8sSplice(&s.data, start, deleteCount, items...)
+}
+
+// RemoveFirst removes the first matched elements, and returns the new length.
+func (s *SyncUint8s) RemoveFirst(elements ...uint8) int {
+	s.mu.Lock()
+	defer s.unlock()
+	return Uint8sRemoveFirst(&s.data, elements...)
+}
+
+// RemoveEvery removes all the elements, and returns the new length.
+func (s *SyncUint8s) RemoveEvery(elements ...uint8) int {
+	s.mu.Lock()
+	defer s.unlock()
+	return Uint8sRemoveEvery(&s.data, elements...)
+}
+
+// Distinct removes the duplicate elements, and returns the count of each element.
+func (s *SyncUint8s) Distinct() (distinctCount map[uint8]int) {
+	s.mu.Lock()
+	defer s.unlock()
+	return Uint8sDistinct(&s.data, true)
+}
+
+// Fill changes all elements from start to end to a static value.
+func (s *SyncUint8s) Fill(value uint8, start int, end ...int) {
+	s.mu.Lock()
+	defer s.unlock()
+	Uint8sFill(s.data, value, start, end...)
+}
+
+// CopyWithin copies part of the slice to another location in the same slice.
+func (s *SyncUint8s) CopyWithin(target, start int, end ...int) {
+	s.mu.Lock()
+	defer s.unlock()
+	Uint8sCopyWithin(s.data, target, start, end...)
+}
+
+// Reverse reverses the elements in place.
+func (s *SyncUint8s) Reverse() {
+	s.mu.Lock()
+	defer s.unlock()
+	Uint8sReverse(s.data)
+}
+
+// Shuffle randomizes the order of the elements in place.
+func (s *SyncUint8s) Shuffle(r Randomizer) {
+	s.mu.Lock()
+	defer s.unlock()
+	Uint8sShuffle(s.data, r)
+}
+
+// ApplyPatch applies the edit script to the elements.
+func (s *SyncUint8s) ApplyPatch(patch []Uint8sEdit) error {
+	s.mu.Lock()
+	defer s.unlock()
+	return Uint8sApplyPatch(&s.data, patch)
+}
+
+// Update calls fn with the pointer of the elements under the write lock.
+// NOTE:
+//
+//	fn must not call the methods of the same SyncUint8s, and must not retain the slice.
+func (s *SyncUint8s) Update(fn func(p *[]uint8)) {
+	s.mu.Lock()
+	defer s.unlock()
+	fn(&s.data)
+}
+
+// Clear removes all elements.
+func (s *SyncUint8s) Clear() {
+	s.mu.Lock()
+	defer s.unlock()
+	s.data = nil
+}
+
+// unlock invalidates the snapshot and releases the write lock.
+func (s *SyncUint8s) unlock() {
+	s.snap.Store([]uint8(nil))
+	s.mu.Unlock()
+}
+
+// SyncUint8Set is a uint8 set that is safe for concurrent use.
+// NOTE:
+//
+//	The zero value is an empty set ready to use.
+//	Len and Has use the read lock without copying the elements.
+//	Callbacks are run on a snapshot, so they can call the methods of the same SyncUint8Set.
+type SyncUint8Set struct {
+	mu   sync.RWMutex
+	set  *Uint8Set
+	snap atomic.Value // []uint8: read-only elements in insertion order, nil after modification
+}
+
+// NewSyncUint8Set creates a SyncUint8Set with the elements.
+func NewSyncUint8Set(elements ...uint8) *SyncUint8Set {
+	return &SyncUint8Set{set: NewUint8Set(elements...)}
+}
+
+// Snapshot returns the read-only elements in insertion order.
+// NOTE:
+//
+//	The result is shared with other callers until the next modification, so it must not be changed.
+//	While there is no modification, it is an atomic read without locking.
+func (s *SyncUint8Set) Snapshot() []uint8 {
+	if snap, _ := s.snap.Load().([]uint8); snap != nil {
+		return snap
+	}
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	var snap []uint8
+	if s.set != nil {
+		snap = s.set.Slice()
+	}
+	if snap == nil {
+		snap = []uint8{}
+	}
+	s.snap.Store(snap)
+	return snap
+}
+
+// Add adds the elements that are not yet in the set, and returns the new length of the set.
+func (s *SyncUint8Set) Add(elements ...uint8) int {
+	s.mu.Lock()
+	defer s.unlock()
+	if s.set == nil {
+		s.set = NewUint8Set()
+	}
+	return s.set.Add(elements...)
+}
+
+// Remove removes the elements from the set, and returns the new length of the set.
+func (s *SyncUint8Set) Remove(elements ...uint8) int {
+	s.mu.Lock()
+	defer s.unlock()
+	if s.set == nil {
+		return 0
+	}
+	return s.set.Remove(elements...)
+}
+
+// Has reports whether the element is in the set.
+func (s *SyncUint8Set) Has(element uint8) bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.set != nil && s.set.Has(element)
+}
+
+// Len returns the number of elements in the set.
+func (s *SyncUint8Set) Len() int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	if s.set == nil {
+		return 0
+	}
+	return s.set.Len()
+}
+
+// Clear removes all elements.
+func (s *SyncUint8Set) Clear() {
+	s.mu.Lock()
+	defer s.unlock()
+	s.set = nil
+}
+
+// Range calls fn for each element of a snapshot in insertion order, until fn returns false.
+func (s *SyncUint8Set) Range(fn func(v uint8) bool) {
+	for _, v := range s.Snapshot() {
+		if !fn(v) {
+			return
+		}
+	}
+}
+
+// Slice returns a copy of the elements in insertion order.
+func (s *SyncUint8Set) Slice() []uint8 {
+	return Uint8sCopy(s.Snapshot())
+}
+
+// SortedSlice returns a copy of the elements in ascending order.
+func (s *SyncUint8Set) SortedSlice() []uint8 {
+	return NewUint8Set(s.Snapshot()...).SortedSlice()
+}
+
+// Clone returns a Uint8Set with the current elements.
+func (s *SyncUint8Set) Clone() *Uint8Set {
+	return NewUint8Set(s.Snapshot()...)
+}
+
+// unlock invalidates the snapshot and releases the write lock.
+func (s *SyncUint8Set) unlock() {
+	s.snap.Store([]uint8(nil))
+	s.mu.Unlock()
+}
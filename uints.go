@@ -1,5 +1,10 @@
 package ameda
 
+import (
+	"fmt"
+	"sort"
+)
+
 // OneUint try to return the first element, otherwise return zero value.
 func OneUint(u []uint) uint {
 	if len(u) > 0 {
@@ -459,26 +464,20 @@ func UintsSplice(u *[]uint, start, deleteCount int, items ...uint) {
 	if deleteCount < 0 {
 		deleteCount = 0
 	}
-	start, end, _ := fixRange(len(a), start, start+1+deleteCount)
-	deleteCount = end - start - 1
-	for k := 0; k < len(items); k++ {
-		if deleteCount > 0 {
-			// replace
-			a[start] = items[k]
-			deleteCount--
-			start++
-		} else {
-			// insert
-			lastSlice := UintsCopy(a[start:])
-			items = items[k:]
-			a = append(a[:start], items...)
-			a = append(a[:start+len(items)], lastSlice...)
-			*u = a[:len(a):len(a)]
-			return
-		}
+	start = fixIndex(len(a), start, true)
+	if rest := len(a) - start; deleteCount > rest {
+		deleteCount = rest
 	}
-	if deleteCount > 0 {
-		a = append(a[:start], a[start+1+deleteCount:]...)
+	// replace
+	n := copy(a[start:start+deleteCount], items)
+	if n == len(items) {
+		// delete the rest
+		a = append(a[:start+n], a[start+deleteCount:]...)
+	} else {
+		// insert the rest
+		lastSlice := UintsCopy(a[start+deleteCount:])
+		a = append(a[:start+deleteCount], items[n:]...)
+		a = append(a, lastSlice...)
 	}
 	*u = a[:len(a):len(a)]
 }
@@ -707,3 +706,558 @@ func UintSetDifference(set1, set2 []uint, others ...[]uint) []uint {
 	}
 	return r
 }
+
+// UintsIsSorted reports whether the slice is sorted in ascending order.
+func UintsIsSorted(u []uint) bool {
+	for k := 1; k < len(u); k++ {
+		if u[k] < u[k-1] {
+			return false
+		}
+	}
+	return true
+}
+
+// UintSortedSetUnion calculates between multiple sorted collections: set1 ∪ set2 ∪ others...
+// NOTE:
+//
+//	Every set must be sorted in ascending order (see UintsIsSorted), and the result is sorted too.
+//	It merges the sets in O(n+m) without building any map.
+//	This method does not change the existing slices, but instead returns a new slice.
+func UintSortedSetUnion(set1, set2 []uint, others ...[]uint) []uint {
+	r := uintSortedSetUnion(set1, set2)
+	for _, set := range others {
+		r = uintSortedSetUnion(r, set)
+	}
+	return r
+}
+
+// UintSortedSetIntersect calculates between multiple sorted collections: set1 ∩ set2 ∩ others...
+// NOTE:
+//
+//	Every set must be sorted in ascending order (see UintsIsSorted), and the result is sorted too.
+//	It merges the sets in O(n+m) without building any map.
+//	This method does not change the existing slices, but instead returns a new slice.
+func UintSortedSetIntersect(set1, set2 []uint, others ...[]uint) []uint {
+	r := uintSortedSetIntersect(set1, set2)
+	for _, set := range others {
+		r = uintSortedSetIntersect(r, set)
+	}
+	return r
+}
+
+// UintSortedSetDifference calculates between multiple sorted collections: set1 - set2 - others...
+// NOTE:
+//
+//	Every set must be sorted in ascending order (see UintsIsSorted), and the result is sorted too.
+//	It merges the sets in O(n+m) without building any map.
+//	This method does not change the existing slices, but instead returns a new slice.
+func UintSortedSetDifference(set1, set2 []uint, others ...[]uint) []uint {
+	r := uintSortedSetDifference(set1, set2)
+	for _, set := range others {
+		r = uintSortedSetDifference(r, set)
+	}
+	return r
+}
+
+// UintSortedSetSymmetricDifference calculates between multiple sorted collections: set1 ∆ set2 ∆ others...
+// NOTE:
+//
+//	Every set must be sorted in ascending order (see UintsIsSorted), and the result is sorted too.
+//	It merges the sets in O(n+m) without building any map.
+//	This method does not change the existing slices, but instead returns a new slice.
+func UintSortedSetSymmetricDifference(set1, set2 []uint, others ...[]uint) []uint {
+	r := uintSortedSetSymmetricDifference(set1, set2)
+	for _, set := range others {
+		r = uintSortedSetSymmetricDifference(r, set)
+	}
+	return r
+}
+
+// UintSortedSetIsSubset reports whether every element of sub is also in set: sub ⊆ set.
+// NOTE:
+//
+//	Both sets must be sorted in ascending order (see UintsIsSorted).
+func UintSortedSetIsSubset(sub, set []uint) bool {
+	j := 0
+	for k := 0; k < len(sub); {
+		if j >= len(set) || sub[k] < set[j] {
+			return false
+		}
+		if set[j] < sub[k] {
+			j++
+			continue
+		}
+		k++
+	}
+	return true
+}
+
+func uintSortedSetUnion(a, b []uint) []uint {
+	r := make([]uint, 0, len(a)+len(b))
+	i, j := 0, 0
+	for i < len(a) || j < len(b) {
+		var v uint
+		switch {
+		case j >= len(b) || i < len(a) && a[i] < b[j]:
+			v = a[i]
+			i++
+		case i >= len(a) || b[j] < a[i]:
+			v = b[j]
+			j++
+		default:
+			v = a[i]
+			i++
+			j++
+		}
+		if n := len(r); n == 0 || r[n-1] != v {
+			r = append(r, v)
+		}
+	}
+	return r
+}
+
+func uintSortedSetIntersect(a, b []uint) []uint {
+	n := len(a)
+	if len(b) < n {
+		n = len(b)
+	}
+	r := make([]uint, 0, n)
+	i, j := 0, 0
+	for i < len(a) && j < len(b) {
+		switch {
+		case a[i] < b[j]:
+			i++
+		case b[j] < a[i]:
+			j++
+		default:
+			v := a[i]
+			if n := len(r); n == 0 || r[n-1] != v {
+				r = append(r, v)
+			}
+			i++
+			j++
+		}
+	}
+	return r
+}
+
+func uintSortedSetDifference(a, b []uint) []uint {
+	r := make([]uint, 0, len(a))
+	j := 0
+	for _, v := range a {
+		for j < len(b) && b[j] < v {
+			j++
+		}
+		if j < len(b) && b[j] == v {
+			continue
+		}
+		if n := len(r); n == 0 || r[n-1] != v {
+			r = append(r, v)
+		}
+	}
+	return r
+}
+
+func uintSortedSetSymmetricDifference(a, b []uint) []uint {
+	r := make([]uint, 0, len(a)+len(b))
+	i, j := 0, 0
+	for i < len(a) || j < len(b) {
+		var v uint
+		switch {
+		case j >= len(b) || i < len(a) && a[i] < b[j]:
+			v = a[i]
+			i++
+		case i >= len(a) || b[j] < a[i]:
+			v = b[j]
+			j++
+		default:
+			v = a[i]
+			for i < len(a) && a[i] == v {
+				i++
+			}
+			for j < len(b) && b[j] == v {
+				j++
+			}
+			continue
+		}
+		if n := len(r); n == 0 || r[n-1] != v {
+			r = append(r, v)
+		}
+	}
+	return r
+}
+
+// UintsEdit is an edit run of the edit script produced by UintsDiff.
+type UintsEdit struct {
+	Kind   DiffKind
+	Values []uint
+}
+
+// UintsDiff computes the shortest edit script that turns slice a into slice b, see also DiffFunc.
+// This method does not change the existing slices, but instead returns the runs of edits.
+func UintsDiff(a, b []uint) []UintsEdit {
+	spans := DiffFunc(len(a), len(b), func(i, j int) bool { return a[i] == b[j] })
+	r := make([]UintsEdit, len(spans))
+	for k, span := range spans {
+		r[k].Kind = span.Kind
+		if span.Kind == DiffInsert {
+			r[k].Values = UintsCopy(b[span.B : span.B+span.Len])
+		} else {
+			r[k].Values = UintsCopy(a[span.A : span.A+span.Len])
+		}
+	}
+	return r
+}
+
+// UintsApplyPatch changes the contents of an slice in place by applying the edit script produced by UintsDiff.
+// NOTE:
+//
+//	If the slice does not match the equal and delete runs of the patch, it returns an error
+//	and the slice will not be modified.
+func UintsApplyPatch(p *[]uint, patch []UintsEdit) error {
+	a := *p
+	r := make([]uint, 0, len(a))
+	pos := 0
+	for _, e := range patch {
+		switch e.Kind {
+		case DiffInsert:
+			r = append(r, e.Values...)
+		case DiffEqual, DiffDelete:
+			if pos+len(e.Values) > len(a) {
+				return fmt.Errorf("patch mismatch: %s run exceeds the slice length %d", e.Kind, len(a))
+			}
+			for k, v := range e.Values {
+				if a[pos+k] != v {
+					return fmt.Errorf("patch mismatch: %s run at index %d", e.Kind, pos+k)
+				}
+			}
+			if e.Kind == DiffEqual {
+				r = append(r, e.Values...)
+			}
+			pos += len(e.Values)
+		default:
+			return fmt.Errorf("patch mismatch: unknown edit kind %d", e.Kind)
+		}
+	}
+	if pos != len(a) {
+		return fmt.Errorf("patch mismatch: the slice has %d unpatched elements", len(a)-pos)
+	}
+	n := len(r)
+	*p = r[:n:n]
+	return nil
+}
+
+// UintsShuffle randomizes the order of elements in place.
+// @r
+//
+//	The source of random numbers, nil means the global source of package math/rand.
+func UintsShuffle(u []uint, r Randomizer) {
+	shuffleIndex(len(u), len(u), r, func(x, y int) { u[x], u[y] = u[y], u[x] })
+}
+
+// UintsSample returns k elements randomly selected from the slice without replacement.
+// If k is greater than the length of the slice, all the elements are returned in random order.
+// This method does not change the existing slice, but instead returns a new slice.
+// @r
+//
+//	The source of random numbers, nil means the global source of package math/rand.
+func UintsSample(u []uint, k int, r Randomizer) []uint {
+	if k <= 0 {
+		return []uint{}
+	}
+	a := UintsCopy(u)
+	shuffleIndex(len(a), k, r, func(x, y int) { a[x], a[y] = a[y], a[x] })
+	if k > len(a) {
+		k = len(a)
+	}
+	return a[:k:k]
+}
+
+// UintsSampleWithReplacement returns k elements randomly selected from the slice with replacement.
+// This method does not change the existing slice, but instead returns a new slice.
+// @r
+//
+//	The source of random numbers, nil means the global source of package math/rand.
+func UintsSampleWithReplacement(u []uint, k int, r Randomizer) []uint {
+	if k <= 0 || len(u) == 0 {
+		return []uint{}
+	}
+	r = getRandomizer(r)
+	ret := make([]uint, k)
+	for n := range ret {
+		ret[n] = u[r.Intn(len(u))]
+	}
+	return ret
+}
+
+// UintsWeightedChoice returns the key-value of an element randomly selected
+// with the probability proportional to its weight.
+// @weights
+//
+//	The weights of the elements with the same indexes, must be finite and non-negative.
+//
+// @r
+//
+//	The source of random numbers, nil means the global source of package math/rand.
+//
+// NOTE:
+//
+//	If the slice is empty, or the weights are invalid, returns an error and k = -1
+func UintsWeightedChoice(u []uint, weights []float64, r Randomizer) (k int, v uint, err error) {
+	k, err = weightedIndex(len(u), weights, r)
+	if err != nil {
+		return k, v, err
+	}
+	return k, u[k], nil
+}
+
+// UintsReservoirSample returns k elements randomly selected from a stream of unknown length,
+// in a single pass and with O(k) memory.
+// @next
+//
+//	Returns the next element of the stream, or ok = false at the end of the stream.
+//
+// @r
+//
+//	The source of random numbers, nil means the global source of package math/rand.
+func UintsReservoirSample(next func() (v uint, ok bool), k int, r Randomizer) []uint {
+	if k <= 0 {
+		return []uint{}
+	}
+	r = getRandomizer(r)
+	ret := make([]uint, 0, k)
+	for seen := 0; ; seen++ {
+		v, ok := next()
+		if !ok {
+			return ret
+		}
+		switch slot := reservoirIndex(seen, k, r); {
+		case slot == len(ret):
+			ret = append(ret, v)
+		case slot >= 0:
+			ret[slot] = v
+		}
+	}
+}
+
+// UintsAt returns the element at the given index.
+// @index
+//
+//	Zero-based index of the element. If negative, index will be counted from the end.
+//
+// NOTE:
+//
+//	If the index is out of range, ok = false
+func UintsAt(u []uint, index int) (v uint, ok bool) {
+	index, ok = atIndex(len(u), index)
+	if !ok {
+		return v, false
+	}
+	return u[index], true
+}
+
+// UintsWith returns a copy of the slice with the element at the given index replaced with the value.
+// @index
+//
+//	Zero-based index of the element. If negative, index will be counted from the end.
+//
+// NOTE:
+//
+//	If the index is out of range, ok = false
+func UintsWith(u []uint, index int, value uint) (ret []uint, ok bool) {
+	ret = UintsCopy(u)
+	index, ok = atIndex(len(u), index)
+	if ok {
+		ret[index] = value
+	}
+	return ret, ok
+}
+
+// UintsFindIndex returns the index of the first element in the provided slice that satisfies the provided testing function.
+// NOTE:
+//
+//	If not found, returns -1
+func UintsFindIndex(u []uint, fn func(u []uint, k int, v uint) bool) int {
+	k, _ := UintsFind(u, fn)
+	return k
+}
+
+// UintsFindLast returns the key-value of the last element in the provided slice that satisfies the provided testing function.
+// NOTE:
+//
+//	If not found, k = -1
+func UintsFindLast(u []uint, fn func(u []uint, k int, v uint) bool) (k int, v uint) {
+	for k := len(u) - 1; k >= 0; k-- {
+		if fn(u, k, u[k]) {
+			return k, u[k]
+		}
+	}
+	return -1, v
+}
+
+// UintsFindLastIndex returns the index of the last element in the provided slice that satisfies the provided testing function.
+// NOTE:
+//
+//	If not found, returns -1
+func UintsFindLastIndex(u []uint, fn func(u []uint, k int, v uint) bool) int {
+	k, _ := UintsFindLast(u, fn)
+	return k
+}
+
+// UintsFlat creates a new slice with all sub-slice elements concatenated into it.
+func UintsFlat(u [][]uint) []uint {
+	return UintsConcat(u...)
+}
+
+// UintsFlatMap creates a new slice populated with the results of calling a provided function
+// on every element in the calling slice, and then flattening the results.
+func UintsFlatMap(u []uint, fn func(u []uint, k int, v uint) []uint) []uint {
+	ret := make([]uint, 0, len(u))
+	for k, v := range u {
+		ret = append(ret, fn(u, k, v)...)
+	}
+	return ret
+}
+
+// UintsToSorted returns a copy of the slice sorted stably.
+// @less
+//
+//	Reports whether the element a must sort before the element b. If nil, sorts in ascending order.
+func UintsToSorted(u []uint, less func(a, b uint) bool) []uint {
+	ret := UintsCopy(u)
+	if less == nil {
+		less = func(a, b uint) bool { return a < b }
+	}
+	sort.SliceStable(ret, func(x, y int) bool { return less(ret[x], ret[y]) })
+	return ret
+}
+
+// UintsToReversed returns a copy of the slice in reversed order.
+func UintsToReversed(u []uint) []uint {
+	ret := UintsCopy(u)
+	UintsReverse(ret)
+	return ret
+}
+
+// UintsToSpliced returns a copy of the slice with some elements removed and/or replaced at a given index,
+// see also UintsSplice.
+func UintsToSpliced(u []uint, start, deleteCount int, items ...uint) []uint {
+	ret := UintsCopy(u)
+	UintsSplice(&ret, start, deleteCount, items...)
+	return ret
+}
+
+// UintsKeys returns an iterator of the indexes of the slice.
+// NOTE:
+//
+//	The iterator returns ok = false when it is exhausted.
+func UintsKeys(u []uint) (next func() (k int, ok bool)) {
+	k := -1
+	return func() (int, bool) {
+		if k+1 >= len(u) {
+			return -1, false
+		}
+		k++
+		return k, true
+	}
+}
+
+// UintsValues returns an iterator of the elements of the slice.
+// NOTE:
+//
+//	The iterator returns ok = false when it is exhausted.
+func UintsValues(u []uint) (next func() (v uint, ok bool)) {
+	k := -1
+	return func() (v uint, ok bool) {
+		if k+1 >= len(u) {
+			return v, false
+		}
+		k++
+		return u[k], true
+	}
+}
+
+// UintsEntries returns an iterator of the key-value pairs of the slice.
+// NOTE:
+//
+//	The iterator returns ok = false when it is exhausted.
+func UintsEntries(u []uint) (next func() (k int, v uint, ok bool)) {
+	k := -1
+	return func() (int, uint, bool) {
+		if k+1 >= len(u) {
+			var v uint
+			return -1, v, false
+		}
+		k++
+		return k, u[k], true
+	}
+}
+
+// UintsGroup groups the elements of the slice according to the string keys returned by the provided function.
+func UintsGroup(u []uint, fn func(u []uint, k int, v uint) string) map[string][]uint {
+	ret := make(map[string][]uint)
+	for k, v := range u {
+		key := fn(u, k, v)
+		ret[key] = append(ret[key], v)
+	}
+	return ret
+}
+
+// UintsGroupToMap groups the elements of the slice according to the keys returned by the provided function.
+// NOTE:
+//
+//	The keys must be comparable.
+func UintsGroupToMap(u []uint, fn func(u []uint, k int, v uint) interface{}) map[interface{}][]uint {
+	ret := make(map[interface{}][]uint)
+	for k, v := range u {
+		key := fn(u, k, v)
+		ret[key] = append(ret[key], v)
+	}
+	return ret
+}
+
+// UintsEqual reports whether a and b have the same length and the same elements in the same order.
+func UintsEqual(a, b []uint) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for k, v := range a {
+		if v != b[k] {
+			return false
+		}
+	}
+	return true
+}
+
+// UintsCompare compares a and b lexicographically, and returns -1 if a < b, 0 if a == b, or +1 if a > b.
+// NOTE:
+//
+//	A slice that is a prefix of another slice is less than it.
+func UintsCompare(a, b []uint) int {
+	for k := 0; k < len(a) && k < len(b); k++ {
+		if c := compareUint(a[k], b[k]); c != 0 {
+			return c
+		}
+	}
+	return compareInt(len(a), len(b))
+}
+
+// UintsHasPrefix reports whether the slice u begins with prefix.
+func UintsHasPrefix(u, prefix []uint) bool {
+	return len(u) >= len(prefix) && UintsEqual(u[:len(prefix)], prefix)
+}
+
+// UintsHasSuffix reports whether the slice u ends with suffix.
+func UintsHasSuffix(u, suffix []uint) bool {
+	return len(u) >= len(suffix) && UintsEqual(u[len(u)-len(suffix):], suffix)
+}
+
+func compareUint(a, b uint) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,373 @@
+package ameda
+
+import (
+	"sync"
+	"sync/atomic"
+)
+
+// SyncUints is a uint slice that is safe for concurrent use.
+// NOTE:
+//
+//	The zero value is an empty slice ready to use.
+//	Point reads such as Len and At use the read lock without copying the elements.
+//	Callbacks are run on a snapshot, so they can call the methods of the same SyncUints.
+type SyncUints struct {
+	mu   sync.RWMutex
+	data []uint
+	snap atomic.Value // []uint: read-only copy of data, nil after modification
+}
+
+// NewSyncUints creates a SyncUints with a copy of the elements.
+func NewSyncUints(elements ...uint) *SyncUints {
+	return &SyncUints{data: UintsCopy(elements)}
+}
+
+// Snapshot returns a read-only copy of the current elements.
+// NOTE:
+//
+//	The result is shared with other callers until the next modification, so it must not be changed.
+//	While there is no modification, it is an atomic read without locking.
+func (s *SyncUints) Snapshot() []uint {
+	if snap, _ := s.snap.Load().([]uint); snap != nil {
+		return snap
+	}
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	snap := UintsCopy(s.data)
+	s.snap.Store(snap)
+	return snap
+}
+
+// Slice returns a copy of the current elements that can be changed.
+func (s *SyncUints) Slice() []uint {
+	return UintsCopy(s.Snapshot())
+}
+
+// Len returns the number of elements.
+func (s *SyncUints) Len() int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return len(s.data)
+}
+
+// At returns the element at the index, which can be negative and counted from the end.
+func (s *SyncUints) At(index int) (v uint, ok bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return UintsAt(s.data, index)
+}
+
+// Includes determines whether the slice includes a certain element.
+func (s *SyncUints) Includes(valueToFind uint, fromIndex ...int) bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return UintsIncludes(s.data, valueToFind, fromIndex...)
+}
+
+// IndexOf returns the first index at which a given element can be found, or -1 if it is not present.
+func (s *SyncUints) IndexOf(searchElement uint, fromIndex ...int) int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return UintsIndexOf(s.data, searchElement, fromIndex...)
+}
+
+// LastIndexOf returns the last index at which a given element can be found, or -1 if it is not present.
+func (s *SyncUints) LastIndexOf(searchElement uint, fromIndex ...int) int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return UintsLastIndexOf(s.data, searchElement, fromIndex...)
+}
+
+// Range calls fn for each element of a snapshot in order, until fn returns false.
+func (s *SyncUints) Range(fn func(k int, v uint) bool) {
+	for k, v := range s.Snapshot() {
+		if !fn(k, v) {
+			return
+		}
+	}
+}
+
+// Every tests whether all elements of a snapshot pass the test implemented by fn.
+func (s *SyncUints) Every(fn func(u []uint, k int, v uint) bool) bool {
+	return UintsEvery(s.Snapshot(), fn)
+}
+
+// Some tests whether at least one element of a snapshot passes the test implemented by fn.
+func (s *SyncUints) Some(fn func(u []uint, k int, v uint) bool) bool {
+	return UintsSome(s.Snapshot(), fn)
+}
+
+// Find returns the key-value of the first element of a snapshot that satisfies fn.
+// If no element satisfies fn, -1 is returned.
+func (s *SyncUints) Find(fn func(u []uint, k int, v uint) bool) (k int, v uint) {
+	return UintsFind(s.Snapshot(), fn)
+}
+
+// Filter creates a new slice with the elements of a snapshot that pass the test implemented by fn.
+func (s *SyncUints) Filter(fn func(u []uint, k int, v uint) bool) []uint {
+	return UintsFilter(s.Snapshot(), fn)
+}
+
+// Map creates a new slice with the results of calling fn on every element of a snapshot.
+func (s *SyncUints) Map(fn func(u []uint, k int, v uint) uint) []uint {
+	return UintsMap(s.Snapshot(), fn)
+}
+
+// Reduce executes fn on each element of a snapshot, resulting in a single output value.
+func (s *SyncUints) Reduce(fn func(u []uint, k int, v, accumulator uint) uint, initialValue ...uint) uint {
+	return UintsReduce(s.Snapshot(), fn, initialValue...)
+}
+
+// Set changes the element at the index, which can be negative and counted from the end,
+// and reports whether the index is in range.
+func (s *SyncUints) Set(index int, value uint) bool {
+	s.mu.Lock()
+	defer s.unlock()
+	k, ok := atIndex(len(s.data), index)
+	if ok {
+		s.data[k] = value
+	}
+	return ok
+}
+
+// Push adds one or more elements to the end, and returns the new length.
+func (s *SyncUints) Push(element ...uint) int {
+	s.mu.Lock()
+	defer s.unlock()
+	return UintsPush(&s.data, element...)
+}
+
+// PushDistinct adds one or more new elements that do not exist at the end, and returns the new length.
+func (s *SyncUints) PushDistinct(element ...uint) int {
+	s.mu.Lock()
+	defer s.unlock()
+	s.data = UintsPushDistinct(s.data, element...)
+	return len(s.data)
+}
+
+// Pop removes the last element and returns that element.
+func (s *SyncUints) Pop() (uint, bool) {
+	s.mu.Lock()
+	defer s.unlock()
+	return UintsPop(&s.data)
+}
+
+// Shift removes the first element and returns that element.
+func (s *SyncUints) Shift() (uint, bool) {
+	s.mu.Lock()
+	defer s.unlock()
+	return UintsShift(&s.data)
+}
+
+// Unshift adds one or more elements to the beginning, and returns the new length.
+func (s *SyncUints) Unshift(element ...uint) int {
+	s.mu.Lock()
+	defer s.unlock()
+	return UintsUnshift(&s.data, element...)
+}
+
+// UnshiftDistinct adds one or more new elements that do not exist to the beginning, and returns the new length.
+func (s *SyncUints) UnshiftDistinct(element ...uint) int {
+	s.mu.Lock()
+	defer s.unlock()
+	return UintsUnshiftDistinct(&s.data, element...)
+}
+
+// Splice removes deleteCount elements from start and adds the items in their place.
+func (s *SyncUints) Splice(start, deleteCount int, items ...uint) {
+	s.mu.Lock()
+	defer s.unlock()
+	UintsSplice(&s.data, start, deleteCount, items...)
+}
+
+// RemoveFirst removes the first matched elements, and returns the new length.
+func (s *SyncUints) RemoveFirst(elements ...uint) int {
+	s.mu.Lock()
+	defer s.unlock()
+	return UintsRemoveFirst(&s.data, elements...)
+}
+
+// RemoveEvery removes all the elements, and returns the new length.
+func (s *SyncUints) RemoveEvery(elements ...uint) int {
+	s.mu.Lock()
+	defer s.unlock()
+	return UintsRemoveEvery(&s.data, elements...)
+}
+
+// Distinct removes the duplicate elements, and returns the count of each element.
+func (s *SyncUints) Distinct() (distinctCount map[uint]int) {
+	s.mu.Lock()
+	defer s.unlock()
+	return UintsDistinct(&s.data, true)
+}
+
+// Fill changes all elements from start to end to a static value.
+func (s *SyncUints) Fill(value uint, start int, end ...int) {
+	s.mu.Lock()
+	defer s.unlock()
+	UintsFill(s.data, value, start, end...)
+}
+
+// CopyWithin copies part of the slice to another location in the same slice.
+func (s *SyncUints) CopyWithin(target, start int, end ...int) {
+	s.mu.Lock()
+	defer s.unlock()
+	UintsCopyWithin(s.data, target, start, end...)
+}
+
+// Reverse reverses the elements in place.
+func (s *SyncUints) Reverse() {
+	s.mu.Lock()
+	defer s.unlock()
+	UintsReverse(s.data)
+}
+
+// Shuffle randomizes the order of the elements in place.
+func (s *SyncUints) Shuffle(r Randomizer) {
+	s.mu.Lock()
+	defer s.unlock()
+	UintsShuffle(s.data, r)
+}
+
+// ApplyPatch applies the edit script to the elements.
+func (s *SyncUints) ApplyPatch(patch []UintsEdit) error {
+	s.mu.Lock()
+	defer s.unlock()
+	return UintsApplyPatch(&s.data, patch)
+}
+
+// Update calls fn with the pointer of the elements under the write lock.
+// NOTE:
+//
+//	fn must not call the methods of the same SyncUints, and must not retain the slice.
+func (s *SyncUints) Update(fn func(p *[]uint)) {
+	s.mu.Lock()
+	defer s.unlock()
+	fn(&s.data)
+}
+
+// Clear removes all elements.
+func (s *SyncUints) Clear() {
+	s.mu.Lock()
+	defer s.unlock()
+	s.data = nil
+}
+
+// unlock invalidates the snapshot and releases the write lock.
+func (s *SyncUints) unlock() {
+	s.snap.Store([]uint(nil))
+	s.mu.Unlock()
+}
+
+// SyncUintSet is a uint set that is safe for concurrent use.
+// NOTE:
+//
+//	The zero value is an empty set ready to use.
+//	Len and Has use the read lock without copying the elements.
+//	Callbacks are run on a snapshot, so they can call the methods of the same SyncUintSet.
+type SyncUintSet struct {
+	mu   sync.RWMutex
+	set  *UintSet
+	snap atomic.Value // []uint: read-only elements in insertion order, nil after modification
+}
+
+// NewSyncUintSet creates a SyncUintSet with the elements.
+func NewSyncUintSet(elements ...uint) *SyncUintSet {
+	return &SyncUintSet{set: NewUintSet(elements...)}
+}
+
+// Snapshot returns the read-only elements in insertion order.
+// NOTE:
+//
+//	The result is shared with other callers until the next modification, so it must not be changed.
+//	While there is no modification, it is an atomic read without locking.
+func (s *SyncUintSet) Snapshot() []uint {
+	if snap, _ := s.snap.Load().([]uint); snap != nil {
+		return snap
+	}
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	var snap []uint
+	if s.set != nil {
+		snap = s.set.Slice()
+	}
+	if snap == nil {
+		snap = []uint{}
+	}
+	s.snap.Store(snap)
+	return snap
+}
+
+// Add adds the elements that are not yet in the set, and returns the new length of the set.
+func (s *SyncUintSet) Add(elements ...uint) int {
+	s.mu.Lock()
+	defer s.unlock()
+	if s.set == nil {
+		s.set = NewUintSet()
+	}
+	return s.set.Add(elements...)
+}
+
+// Remove removes the elements from the set, and returns the new length of the set.
+func (s *SyncUintSet) Remove(elements ...uint) int {
+	s.mu.Lock()
+	defer s.unlock()
+	if s.set == nil {
+		return 0
+	}
+	return s.set.Remove(elements...)
+}
+
+// Has reports whether the element is in the set.
+func (s *SyncUintSet) Has(element uint) bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.set != nil && s.set.Has(element)
+}
+
+// Len returns the number of elements in the set.
+func (s *SyncUintSet) Len() int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	if s.set == nil {
+		return 0
+	}
+	return s.set.Len()
+}
+
+// Clear removes all elements.
+func (s *SyncUintSet) Clear() {
+	s.mu.Lock()
+	defer s.unlock()
+	s.set = nil
+}
+
+// Range calls fn for each element of a snapshot in insertion order, until fn returns false.
+func (s *SyncUintSet) Range(fn func(v uint) bool) {
+	for _, v := range s.Snapshot() {
+		if !fn(v) {
+			return
+		}
+	}
+}
+
+// Slice returns a copy of the elements in insertion order.
+func (s *SyncUintSet) Slice() []uint {
+	return UintsCopy(s.Snapshot())
+}
+
+// SortedSlice returns a copy of the elements in ascending order.
+func (s *SyncUintSet) SortedSlice() []uint {
+	return NewUintSet(s.Snapshot()...).SortedSlice()
+}
+
+// Clone returns a UintSet with the current elements.
+func (s *SyncUintSet) Clone() *UintSet {
+	return NewUintSet(s.Snapshot()...)
+}
+
+// unlock invalidates the snapshot and releases the write lock.
+func (s *SyncUintSet) unlock() {
+	s.snap.Store([]uint(nil))
+	s.mu.Unlock()
+}
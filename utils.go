@@ -112,3 +112,16 @@ func isZero(v reflect.Value) bool {
 		panic(&reflect.ValueError{"reflect.Value.IsZero", v.Kind()})
 	}
 }
+
+// atIndex fixes the index that can be negative and counted from the end, without clamping.
+func atIndex(length int, idx int) (int, bool) {
+	if idx < 0 {
+		idx += length
+	}
+	if idx < 0 || idx >= length {
+		return -1, false
+	}
+	return idx, true
+}
+
+var errLengthMismatch = errors.New("length mismatch")